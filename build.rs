@@ -0,0 +1,26 @@
+// Expose the resolved cdk version as CDK_VERSION, so diagnostic reports name the cdk that was
+// actually linked rather than the one Cargo.toml asks for
+use std::{env, fs, path::Path};
+
+fn main() {
+    let lock = Path::new(&env::var("CARGO_MANIFEST_DIR").unwrap()).join("Cargo.lock");
+    println!("cargo:rerun-if-changed={}", lock.display());
+
+    // Built as a dependency there is no lock file of our own, the workspace one is not visible
+    let version = fs::read_to_string(&lock)
+        .ok()
+        .and_then(|lock| cdk_version(&lock))
+        .unwrap_or_else(|| "unknown".to_string());
+    println!("cargo:rustc-env=CDK_VERSION={}", version);
+}
+
+fn cdk_version(lock: &str) -> Option<String> {
+    let mut lines = lock.lines();
+    while let Some(line) = lines.next() {
+        if line == "name = \"cdk\"" {
+            let version = lines.next()?.strip_prefix("version = \"")?.strip_suffix('"')?;
+            return Some(version.to_string());
+        }
+    }
+    None
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"math"
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"unsafe"
 )
@@ -332,6 +333,11 @@ func readFloat64(reader io.Reader) float64 {
 
 func init() {
 
+	FfiConverterCallbackInterfaceBalanceObserverINSTANCE.register()
+	FfiConverterCallbackInterfaceLogObserverINSTANCE.register()
+	FfiConverterCallbackInterfaceMintQuoteObserverINSTANCE.register()
+	FfiConverterCallbackInterfaceMintedObserverINSTANCE.register()
+	FfiConverterCallbackInterfaceRestoreProgressINSTANCE.register()
 	uniffiCheckChecksums()
 }
 
@@ -346,6 +352,42 @@ func uniffiCheckChecksums() {
 		// If this happens try cleaning and rebuilding your project
 		panic("cdk_ffi: UniFFI contract version mismatch")
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_func_current_mint_time()
+		})
+		if checksum != 20458 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_current_mint_time: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_func_decode_payment_request()
+		})
+		if checksum != 28382 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_decode_payment_request: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_func_decode_token()
+		})
+		if checksum != 48978 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_decode_token: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_func_encode_payment_request()
+		})
+		if checksum != 25065 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_encode_payment_request: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_cdk_ffi_checksum_func_generate_mnemonic()
@@ -357,1613 +399,7907 @@ func uniffiCheckChecksums() {
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_balance()
+			return C.uniffi_cdk_ffi_checksum_func_generate_mnemonic_with_word_count()
 		})
-		if checksum != 40463 {
+		if checksum != 64565 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_balance: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_generate_mnemonic_with_word_count: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_get_mint_info()
+			return C.uniffi_cdk_ffi_checksum_func_normalize_mint_url()
 		})
-		if checksum != 13159 {
+		if checksum != 60931 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_get_mint_info: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_normalize_mint_url: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt()
+			return C.uniffi_cdk_ffi_checksum_func_ping_mint()
 		})
-		if checksum != 3275 {
+		if checksum != 53754 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_ping_mint: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote()
+			return C.uniffi_cdk_ffi_checksum_func_reencode_token()
 		})
-		if checksum != 39876 {
+		if checksum != 6221 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_reencode_token: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint()
+			return C.uniffi_cdk_ffi_checksum_func_set_log_callback()
 		})
-		if checksum != 58480 {
+		if checksum != 29650 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_set_log_callback: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote()
+			return C.uniffi_cdk_ffi_checksum_func_token_from_bytes()
 		})
-		if checksum != 42885 {
+		if checksum != 39285 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_token_from_bytes: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_state()
+			return C.uniffi_cdk_ffi_checksum_func_token_to_bytes()
 		})
-		if checksum != 60165 {
+		if checksum != 24702 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_state: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_token_to_bytes: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_url()
+			return C.uniffi_cdk_ffi_checksum_func_token_to_raw_bytes()
 		})
-		if checksum != 18647 {
+		if checksum != 50514 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_url: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_token_to_raw_bytes: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_prepare_send()
+			return C.uniffi_cdk_ffi_checksum_func_validate_mnemonic()
 		})
-		if checksum != 46706 {
+		if checksum != 51983 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_prepare_send: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_validate_mnemonic: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_send()
+			return C.uniffi_cdk_ffi_checksum_func_verify_token_dleq()
 		})
-		if checksum != 15473 {
+		if checksum != 4184 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_send: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_func_verify_token_dleq: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_unit()
+			return C.uniffi_cdk_ffi_checksum_method_ffilocalstore_vacuum()
 		})
-		if checksum != 4593 {
+		if checksum != 3409 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_unit: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffilocalstore_vacuum: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new()
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_add_mint()
 		})
-		if checksum != 15364 {
+		if checksum != 16912 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_add_mint: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_with_path()
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_remove_mint()
 		})
-		if checksum != 766 {
+		if checksum != 2370 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_with_path: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_remove_mint: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic()
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_total_balance()
 		})
-		if checksum != 63545 {
+		if checksum != 9359 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_total_balance: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic()
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_transfer()
 		})
-		if checksum != 38466 {
+		if checksum != 60373 {
 			// If this happens try cleaning and rebuilding your project
-			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic: UniFFI API checksum mismatch")
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_transfer: UniFFI API checksum mismatch")
 		}
 	}
-}
-
-type FfiConverterUint64 struct{}
-
-var FfiConverterUint64INSTANCE = FfiConverterUint64{}
-
-func (FfiConverterUint64) Lower(value uint64) C.uint64_t {
-	return C.uint64_t(value)
-}
-
-func (FfiConverterUint64) Write(writer io.Writer, value uint64) {
-	writeUint64(writer, value)
-}
-
-func (FfiConverterUint64) Lift(value C.uint64_t) uint64 {
-	return uint64(value)
-}
-
-func (FfiConverterUint64) Read(reader io.Reader) uint64 {
-	return readUint64(reader)
-}
-
-type FfiDestroyerUint64 struct{}
-
-func (FfiDestroyerUint64) Destroy(_ uint64) {}
-
-type FfiConverterBool struct{}
-
-var FfiConverterBoolINSTANCE = FfiConverterBool{}
-
-func (FfiConverterBool) Lower(value bool) C.int8_t {
-	if value {
-		return C.int8_t(1)
-	}
-	return C.int8_t(0)
-}
-
-func (FfiConverterBool) Write(writer io.Writer, value bool) {
-	if value {
-		writeInt8(writer, 1)
-	} else {
-		writeInt8(writer, 0)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallet()
+		})
+		if checksum != 48508 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallet: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-func (FfiConverterBool) Lift(value C.int8_t) bool {
-	return value != 0
-}
-
-func (FfiConverterBool) Read(reader io.Reader) bool {
-	return readInt8(reader) != 0
-}
-
-type FfiDestroyerBool struct{}
-
-func (FfiDestroyerBool) Destroy(_ bool) {}
-
-type FfiConverterString struct{}
-
-var FfiConverterStringINSTANCE = FfiConverterString{}
-
-func (FfiConverterString) Lift(rb RustBufferI) string {
-	defer rb.Free()
-	reader := rb.AsReader()
-	b, err := io.ReadAll(reader)
-	if err != nil {
-		panic(fmt.Errorf("reading reader: %w", err))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallets()
+		})
+		if checksum != 16653 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallets: UniFFI API checksum mismatch")
+		}
 	}
-	return string(b)
-}
-
-func (FfiConverterString) Read(reader io.Reader) string {
-	length := readInt32(reader)
-	buffer := make([]byte, length)
-	read_length, err := reader.Read(buffer)
-	if err != nil && err != io.EOF {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffisubscription_unsubscribe()
+		})
+		if checksum != 22557 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffisubscription_unsubscribe: UniFFI API checksum mismatch")
+		}
 	}
-	if read_length != int(length) {
-		panic(fmt.Errorf("bad read length when reading string, expected %d, read %d", length, read_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_all_metadata()
+		})
+		if checksum != 29325 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_all_metadata: UniFFI API checksum mismatch")
+		}
 	}
-	return string(buffer)
-}
-
-func (FfiConverterString) Lower(value string) C.RustBuffer {
-	return stringToRustBuffer(value)
-}
-
-func (FfiConverterString) Write(writer io.Writer, value string) {
-	if len(value) > math.MaxInt32 {
-		panic("String is too large to fit into Int32")
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_auto_mint_on_payment()
+		})
+		if checksum != 17642 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_auto_mint_on_payment: UniFFI API checksum mismatch")
+		}
 	}
-
-	writeInt32(writer, int32(len(value)))
-	write_length, err := io.WriteString(writer, value)
-	if err != nil {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_balance()
+		})
+		if checksum != 40463 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_balance: UniFFI API checksum mismatch")
+		}
 	}
-	if write_length != len(value) {
-		panic(fmt.Errorf("bad write length when writing string, expected %d, written %d", len(value), write_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_balance_snapshot()
+		})
+		if checksum != 3428 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_balance_snapshot: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-type FfiDestroyerString struct{}
-
-func (FfiDestroyerString) Destroy(_ string) {}
-
-// Below is an implementation of synchronization requirements outlined in the link.
-// https://github.com/mozilla/uniffi-rs/blob/0dc031132d9493ca812c3af6e7dd60ad2ea95bf0/uniffi_bindgen/src/bindings/kotlin/templates/ObjectRuntime.kt#L31
-
-type FfiObject struct {
-	pointer       unsafe.Pointer
-	callCounter   atomic.Int64
-	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer
-	freeFunction  func(unsafe.Pointer, *C.RustCallStatus)
-	destroyed     atomic.Bool
-}
-
-func newFfiObject(
-	pointer unsafe.Pointer,
-	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer,
-	freeFunction func(unsafe.Pointer, *C.RustCallStatus),
-) FfiObject {
-	return FfiObject{
-		pointer:       pointer,
-		cloneFunction: cloneFunction,
-		freeFunction:  freeFunction,
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_cancel_prepared_send()
+		})
+		if checksum != 43172 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_cancel_prepared_send: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-func (ffiObject *FfiObject) incrementPointer(debugName string) unsafe.Pointer {
-	for {
-		counter := ffiObject.callCounter.Load()
-		if counter <= -1 {
-			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_check_proof_states()
+		})
+		if checksum != 13491 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_check_proof_states: UniFFI API checksum mismatch")
 		}
-		if counter == math.MaxInt64 {
-			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_confirm_send()
+		})
+		if checksum != 17754 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_confirm_send: UniFFI API checksum mismatch")
 		}
-		if ffiObject.callCounter.CompareAndSwap(counter, counter+1) {
-			break
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_denomination_breakdown()
+		})
+		if checksum != 39391 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_denomination_breakdown: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_diagnostic_report()
+		})
+		if checksum != 350 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_diagnostic_report: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_melt_fee()
+		})
+		if checksum != 60348 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_melt_fee: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_receive_fee()
+		})
+		if checksum != 1855 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_receive_fee: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_export_proofs()
+		})
+		if checksum != 59354 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_export_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_fee_reserve_percent()
+		})
+		if checksum != 9429 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_fee_reserve_percent: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_get_metadata()
+		})
+		if checksum != 63990 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_get_metadata: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_get_mint_info()
+		})
+		if checksum != 13159 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_get_mint_info: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_import_proofs()
+		})
+		if checksum != 1454 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_import_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_keyset_counters()
+		})
+		if checksum != 33225 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_keyset_counters: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_list_keysets()
+		})
+		if checksum != 35159 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_list_keysets: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_list_proofs()
+		})
+		if checksum != 54521 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_list_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions()
+		})
+		if checksum != 56192 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions_page()
+		})
+		if checksum != 58900 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions_page: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_lock_proofs()
+		})
+		if checksum != 55882 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_lock_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt()
+		})
+		if checksum != 3275 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt_batch()
+		})
+		if checksum != 30897 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt_batch: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote()
+		})
+		if checksum != 39876 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote_mpp()
+		})
+		if checksum != 33516 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote_mpp: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_melt_with_max_fee()
+		})
+		if checksum != 20142 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_melt_with_max_fee: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint()
+		})
+		if checksum != 58480 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_and_wait()
+		})
+		if checksum != 56474 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_and_wait: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_detailed()
+		})
+		if checksum != 8146 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_detailed: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_info()
+		})
+		if checksum != 17706 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_info: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote()
+		})
+		if checksum != 42885 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_state()
+		})
+		if checksum != 60165 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_state: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_states()
+		})
+		if checksum != 43106 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_states: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_with_options()
+		})
+		if checksum != 35269 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_with_options: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_url()
+		})
+		if checksum != 18647 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_url: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_mint_with_amounts()
+		})
+		if checksum != 48962 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_mint_with_amounts: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_net_flow()
+		})
+		if checksum != 56461 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_net_flow: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_observe_balance()
+		})
+		if checksum != 20066 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_observe_balance: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_operation_availability()
+		})
+		if checksum != 23322 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_operation_availability: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pay_payment_request()
+		})
+		if checksum != 41328 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pay_payment_request: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pending_balance()
+		})
+		if checksum != 34911 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pending_balance: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pending_melt_quotes()
+		})
+		if checksum != 34399 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pending_melt_quotes: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pending_mint_quotes()
+		})
+		if checksum != 19994 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pending_mint_quotes: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pending_quote_expiries()
+		})
+		if checksum != 26522 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pending_quote_expiries: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_prepare_send()
+		})
+		if checksum != 56517 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_prepare_send: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_pubkey()
+		})
+		if checksum != 23468 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_pubkey: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_receive()
+		})
+		if checksum != 17614 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_receive: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_receive_batch()
+		})
+		if checksum != 54931 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_receive_batch: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_receive_offline()
+		})
+		if checksum != 51464 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_receive_offline: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_reclaim_send()
+		})
+		if checksum != 330 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_reclaim_send: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_reconcile()
+		})
+		if checksum != 30248 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_reconcile: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_refresh_keysets()
+		})
+		if checksum != 61780 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_refresh_keysets: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_reserved_balance()
+		})
+		if checksum != 46430 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_reserved_balance: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_send()
+		})
+		if checksum != 15473 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_send: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_auth_token()
+		})
+		if checksum != 29977 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_auth_token: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_deterministic()
+		})
+		if checksum != 38919 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_deterministic: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_fee_reserve_percent()
+		})
+		if checksum != 59130 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_fee_reserve_percent: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_keyset_counter()
+		})
+		if checksum != 58909 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_keyset_counter: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_metadata()
+		})
+		if checksum != 49501 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_metadata: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_prepared_send_ttl()
+		})
+		if checksum != 52769 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_prepared_send_ttl: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_request_timeout()
+		})
+		if checksum != 50590 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_request_timeout: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_set_retry_policy()
+		})
+		if checksum != 38792 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_set_retry_policy: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_subscribe_mint_quote()
+		})
+		if checksum != 8961 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_subscribe_mint_quote: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_swap()
+		})
+		if checksum != 12998 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_swap: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_token_state()
+		})
+		if checksum != 63766 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_token_state: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_unit()
+		})
+		if checksum != 4593 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_unit: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_unlock_proofs()
+		})
+		if checksum != 30551 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_unlock_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_update_mint_url()
+		})
+		if checksum != 24571 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_update_mint_url: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_verify_token_dleq()
+		})
+		if checksum != 22538 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_verify_token_dleq: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_ffiwallet_wait_for_mint_quote_paid()
+		})
+		if checksum != 49289 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_ffiwallet_wait_for_mint_quote_paid: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new()
+		})
+		if checksum != 15364 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_in_memory()
+		})
+		if checksum != 39478 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_in_memory: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_with_path()
+		})
+		if checksum != 766 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_with_path: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffimultimintwallet_new()
+		})
+		if checksum != 24292 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffimultimintwallet_new: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic()
+		})
+		if checksum != 63545 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proofs()
+		})
+		if checksum != 6342 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proofs: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proxy()
+		})
+		if checksum != 37583 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proxy: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_seed()
+		})
+		if checksum != 58443 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_seed: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic()
+		})
+		if checksum != 38466 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic_with_progress()
+		})
+		if checksum != 30125 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic_with_progress: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_balanceobserver_on_change()
+		})
+		if checksum != 27265 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_balanceobserver_on_change: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_logobserver_log()
+		})
+		if checksum != 48837 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_logobserver_log: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_mintquoteobserver_on_update()
+		})
+		if checksum != 31550 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_mintquoteobserver_on_update: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_mintedobserver_on_minted()
+		})
+		if checksum != 15747 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_mintedobserver_on_minted: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_mintedobserver_on_error()
+		})
+		if checksum != 11371 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_mintedobserver_on_error: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_cdk_ffi_checksum_method_restoreprogress_on_batch()
+		})
+		if checksum != 37328 {
+			// If this happens try cleaning and rebuilding your project
+			panic("cdk_ffi: uniffi_cdk_ffi_checksum_method_restoreprogress_on_batch: UniFFI API checksum mismatch")
+		}
+	}
+}
+
+type FfiConverterUint8 struct{}
+
+var FfiConverterUint8INSTANCE = FfiConverterUint8{}
+
+func (FfiConverterUint8) Lower(value uint8) C.uint8_t {
+	return C.uint8_t(value)
+}
+
+func (FfiConverterUint8) Write(writer io.Writer, value uint8) {
+	writeUint8(writer, value)
+}
+
+func (FfiConverterUint8) Lift(value C.uint8_t) uint8 {
+	return uint8(value)
+}
+
+func (FfiConverterUint8) Read(reader io.Reader) uint8 {
+	return readUint8(reader)
+}
+
+type FfiDestroyerUint8 struct{}
+
+func (FfiDestroyerUint8) Destroy(_ uint8) {}
+
+type FfiConverterUint16 struct{}
+
+var FfiConverterUint16INSTANCE = FfiConverterUint16{}
+
+func (FfiConverterUint16) Lower(value uint16) C.uint16_t {
+	return C.uint16_t(value)
+}
+
+func (FfiConverterUint16) Write(writer io.Writer, value uint16) {
+	writeUint16(writer, value)
+}
+
+func (FfiConverterUint16) Lift(value C.uint16_t) uint16 {
+	return uint16(value)
+}
+
+func (FfiConverterUint16) Read(reader io.Reader) uint16 {
+	return readUint16(reader)
+}
+
+type FfiDestroyerUint16 struct{}
+
+func (FfiDestroyerUint16) Destroy(_ uint16) {}
+
+type FfiConverterUint32 struct{}
+
+var FfiConverterUint32INSTANCE = FfiConverterUint32{}
+
+func (FfiConverterUint32) Lower(value uint32) C.uint32_t {
+	return C.uint32_t(value)
+}
+
+func (FfiConverterUint32) Write(writer io.Writer, value uint32) {
+	writeUint32(writer, value)
+}
+
+func (FfiConverterUint32) Lift(value C.uint32_t) uint32 {
+	return uint32(value)
+}
+
+func (FfiConverterUint32) Read(reader io.Reader) uint32 {
+	return readUint32(reader)
+}
+
+type FfiDestroyerUint32 struct{}
+
+func (FfiDestroyerUint32) Destroy(_ uint32) {}
+
+type FfiConverterUint64 struct{}
+
+var FfiConverterUint64INSTANCE = FfiConverterUint64{}
+
+func (FfiConverterUint64) Lower(value uint64) C.uint64_t {
+	return C.uint64_t(value)
+}
+
+func (FfiConverterUint64) Write(writer io.Writer, value uint64) {
+	writeUint64(writer, value)
+}
+
+func (FfiConverterUint64) Lift(value C.uint64_t) uint64 {
+	return uint64(value)
+}
+
+func (FfiConverterUint64) Read(reader io.Reader) uint64 {
+	return readUint64(reader)
+}
+
+type FfiDestroyerUint64 struct{}
+
+func (FfiDestroyerUint64) Destroy(_ uint64) {}
+
+type FfiConverterInt64 struct{}
+
+var FfiConverterInt64INSTANCE = FfiConverterInt64{}
+
+func (FfiConverterInt64) Lower(value int64) C.int64_t {
+	return C.int64_t(value)
+}
+
+func (FfiConverterInt64) Write(writer io.Writer, value int64) {
+	writeInt64(writer, value)
+}
+
+func (FfiConverterInt64) Lift(value C.int64_t) int64 {
+	return int64(value)
+}
+
+func (FfiConverterInt64) Read(reader io.Reader) int64 {
+	return readInt64(reader)
+}
+
+type FfiDestroyerInt64 struct{}
+
+func (FfiDestroyerInt64) Destroy(_ int64) {}
+
+type FfiConverterFloat64 struct{}
+
+var FfiConverterFloat64INSTANCE = FfiConverterFloat64{}
+
+func (FfiConverterFloat64) Lower(value float64) C.double {
+	return C.double(value)
+}
+
+func (FfiConverterFloat64) Write(writer io.Writer, value float64) {
+	writeFloat64(writer, value)
+}
+
+func (FfiConverterFloat64) Lift(value C.double) float64 {
+	return float64(value)
+}
+
+func (FfiConverterFloat64) Read(reader io.Reader) float64 {
+	return readFloat64(reader)
+}
+
+type FfiDestroyerFloat64 struct{}
+
+func (FfiDestroyerFloat64) Destroy(_ float64) {}
+
+type FfiConverterBool struct{}
+
+var FfiConverterBoolINSTANCE = FfiConverterBool{}
+
+func (FfiConverterBool) Lower(value bool) C.int8_t {
+	if value {
+		return C.int8_t(1)
+	}
+	return C.int8_t(0)
+}
+
+func (FfiConverterBool) Write(writer io.Writer, value bool) {
+	if value {
+		writeInt8(writer, 1)
+	} else {
+		writeInt8(writer, 0)
+	}
+}
+
+func (FfiConverterBool) Lift(value C.int8_t) bool {
+	return value != 0
+}
+
+func (FfiConverterBool) Read(reader io.Reader) bool {
+	return readInt8(reader) != 0
+}
+
+type FfiDestroyerBool struct{}
+
+func (FfiDestroyerBool) Destroy(_ bool) {}
+
+type FfiConverterString struct{}
+
+var FfiConverterStringINSTANCE = FfiConverterString{}
+
+func (FfiConverterString) Lift(rb RustBufferI) string {
+	defer rb.Free()
+	reader := rb.AsReader()
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		panic(fmt.Errorf("reading reader: %w", err))
+	}
+	return string(b)
+}
+
+func (FfiConverterString) Read(reader io.Reader) string {
+	length := readInt32(reader)
+	buffer := make([]byte, length)
+	read_length, err := reader.Read(buffer)
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+	if read_length != int(length) {
+		panic(fmt.Errorf("bad read length when reading string, expected %d, read %d", length, read_length))
+	}
+	return string(buffer)
+}
+
+func (FfiConverterString) Lower(value string) C.RustBuffer {
+	return stringToRustBuffer(value)
+}
+
+func (FfiConverterString) Write(writer io.Writer, value string) {
+	if len(value) > math.MaxInt32 {
+		panic("String is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	write_length, err := io.WriteString(writer, value)
+	if err != nil {
+		panic(err)
+	}
+	if write_length != len(value) {
+		panic(fmt.Errorf("bad write length when writing string, expected %d, written %d", len(value), write_length))
+	}
+}
+
+type FfiDestroyerString struct{}
+
+func (FfiDestroyerString) Destroy(_ string) {}
+
+type FfiConverterBytes struct{}
+
+var FfiConverterBytesINSTANCE = FfiConverterBytes{}
+
+func (c FfiConverterBytes) Lower(value []byte) C.RustBuffer {
+	return LowerIntoRustBuffer[[]byte](c, value)
+}
+
+func (c FfiConverterBytes) Write(writer io.Writer, value []byte) {
+	if len(value) > math.MaxInt32 {
+		panic("[]byte is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	write_length, err := writer.Write(value)
+	if err != nil {
+		panic(err)
+	}
+	if write_length != len(value) {
+		panic(fmt.Errorf("bad write length when writing []byte, expected %d, written %d", len(value), write_length))
+	}
+}
+
+func (c FfiConverterBytes) Lift(rb RustBufferI) []byte {
+	return LiftFromRustBuffer[[]byte](c, rb)
+}
+
+func (c FfiConverterBytes) Read(reader io.Reader) []byte {
+	length := readInt32(reader)
+	buffer := make([]byte, length)
+	read_length, err := reader.Read(buffer)
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+	if read_length != int(length) {
+		panic(fmt.Errorf("bad read length when reading []byte, expected %d, read %d", length, read_length))
+	}
+	return buffer
+}
+
+type FfiDestroyerBytes struct{}
+
+func (FfiDestroyerBytes) Destroy(_ []byte) {}
+
+// Below is an implementation of synchronization requirements outlined in the link.
+// https://github.com/mozilla/uniffi-rs/blob/0dc031132d9493ca812c3af6e7dd60ad2ea95bf0/uniffi_bindgen/src/bindings/kotlin/templates/ObjectRuntime.kt#L31
+
+type FfiObject struct {
+	pointer       unsafe.Pointer
+	callCounter   atomic.Int64
+	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer
+	freeFunction  func(unsafe.Pointer, *C.RustCallStatus)
+	destroyed     atomic.Bool
+}
+
+func newFfiObject(
+	pointer unsafe.Pointer,
+	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer,
+	freeFunction func(unsafe.Pointer, *C.RustCallStatus),
+) FfiObject {
+	return FfiObject{
+		pointer:       pointer,
+		cloneFunction: cloneFunction,
+		freeFunction:  freeFunction,
+	}
+}
+
+func (ffiObject *FfiObject) incrementPointer(debugName string) unsafe.Pointer {
+	for {
+		counter := ffiObject.callCounter.Load()
+		if counter <= -1 {
+			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+		}
+		if counter == math.MaxInt64 {
+			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+		}
+		if ffiObject.callCounter.CompareAndSwap(counter, counter+1) {
+			break
+		}
+	}
+
+	return rustCall(func(status *C.RustCallStatus) unsafe.Pointer {
+		return ffiObject.cloneFunction(ffiObject.pointer, status)
+	})
+}
+
+func (ffiObject *FfiObject) decrementPointer() {
+	if ffiObject.callCounter.Add(-1) == -1 {
+		ffiObject.freeRustArcPtr()
+	}
+}
+
+func (ffiObject *FfiObject) destroy() {
+	if ffiObject.destroyed.CompareAndSwap(false, true) {
+		if ffiObject.callCounter.Add(-1) == -1 {
+			ffiObject.freeRustArcPtr()
+		}
+	}
+}
+
+func (ffiObject *FfiObject) freeRustArcPtr() {
+	rustCall(func(status *C.RustCallStatus) int32 {
+		ffiObject.freeFunction(ffiObject.pointer, status)
+		return 0
+	})
+}
+
+type FfiLocalStoreInterface interface {
+	// Remove spent proofs and expired quotes for every mint in the store
+	// Paid mint quotes and pending melt quotes are kept even once expired, since
+	// they can still be completed. Freed pages are reused but the file is not shrunk
+	Vacuum() (FfiVacuumResult, error)
+}
+type FfiLocalStore struct {
+	ffiObject FfiObject
+}
+
+func NewFfiLocalStore() (*FfiLocalStore, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffilocalstore_new(_uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiLocalStore
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiLocalStoreINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a store that lives only in memory and is lost when dropped, meant for tests
+func FfiLocalStoreNewInMemory() (*FfiLocalStore, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffilocalstore_new_in_memory(_uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiLocalStore
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiLocalStoreINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func FfiLocalStoreNewWithPath(dbPath *string) (*FfiLocalStore, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffilocalstore_new_with_path(FfiConverterOptionalStringINSTANCE.Lower(dbPath), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiLocalStore
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiLocalStoreINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Remove spent proofs and expired quotes for every mint in the store
+// Paid mint quotes and pending melt quotes are kept even once expired, since
+// they can still be completed. Freed pages are reused but the file is not shrunk
+func (_self *FfiLocalStore) Vacuum() (FfiVacuumResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiLocalStore")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffilocalstore_vacuum(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiVacuumResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiVacuumResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+func (object *FfiLocalStore) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterFfiLocalStore struct{}
+
+var FfiConverterFfiLocalStoreINSTANCE = FfiConverterFfiLocalStore{}
+
+func (c FfiConverterFfiLocalStore) Lift(pointer unsafe.Pointer) *FfiLocalStore {
+	result := &FfiLocalStore{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_cdk_ffi_fn_clone_ffilocalstore(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_cdk_ffi_fn_free_ffilocalstore(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*FfiLocalStore).Destroy)
+	return result
+}
+
+func (c FfiConverterFfiLocalStore) Read(reader io.Reader) *FfiLocalStore {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterFfiLocalStore) Lower(value *FfiLocalStore) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*FfiLocalStore")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterFfiLocalStore) Write(writer io.Writer, value *FfiLocalStore) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerFfiLocalStore struct{}
+
+func (_ FfiDestroyerFfiLocalStore) Destroy(value *FfiLocalStore) {
+	value.Destroy()
+}
+
+type FfiMultiMintWalletInterface interface {
+	// Add a wallet for the mint, adding a mint that is already present has no effect
+	AddMint(mintUrl string) error
+	// Stop tracking the mint, returns false if it was not added
+	// Its proofs stay in the local store and come back if the mint is added again
+	RemoveMint(mintUrl string) (bool, error)
+	// Sum of the balances of every added mint
+	TotalBalance() (FfiAmount, error)
+	// Move `amount` from one added mint to another by paying a mint quote of the
+	// destination with a melt at the source, returning the amount minted there
+	// The Lightning fee is paid on top by the source. If the destination fails to
+	// mint after the melt, the paid quote stays stored and can be minted later
+	Transfer(fromMint string, toMint string, amount FfiAmount) (FfiAmount, error)
+	// The wallet for one of the added mints
+	Wallet(mintUrl string) (**FfiWallet, error)
+	// Mint URLs of the added mints, sorted
+	Wallets() []string
+}
+
+// One logical wallet spanning several mints, holding an `FFIWallet` per mint
+// All of them share the unit, seed and local store given at construction
+type FfiMultiMintWallet struct {
+	ffiObject FfiObject
+}
+
+func NewFfiMultiMintWallet(unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string) (*FfiMultiMintWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffimultimintwallet_new(FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiMultiMintWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMultiMintWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Add a wallet for the mint, adding a mint that is already present has no effect
+func (_self *FfiMultiMintWallet) AddMint(mintUrl string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_add_mint(
+			_pointer, FfiConverterStringINSTANCE.Lower(mintUrl), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Stop tracking the mint, returns false if it was not added
+// Its proofs stay in the local store and come back if the mint is added again
+func (_self *FfiMultiMintWallet) RemoveMint(mintUrl string) (bool, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) C.int8_t {
+		return C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_remove_mint(
+			_pointer, FfiConverterStringINSTANCE.Lower(mintUrl), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue bool
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBoolINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Sum of the balances of every added mint
+func (_self *FfiMultiMintWallet) TotalBalance() (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_total_balance(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Move `amount` from one added mint to another by paying a mint quote of the
+// destination with a melt at the source, returning the amount minted there
+// The Lightning fee is paid on top by the source. If the destination fails to
+// mint after the melt, the paid quote stays stored and can be minted later
+func (_self *FfiMultiMintWallet) Transfer(fromMint string, toMint string, amount FfiAmount) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_transfer(
+				_pointer, FfiConverterStringINSTANCE.Lower(fromMint), FfiConverterStringINSTANCE.Lower(toMint), FfiConverterFfiAmountINSTANCE.Lower(amount), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// The wallet for one of the added mints
+func (_self *FfiMultiMintWallet) Wallet(mintUrl string) (**FfiWallet, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_wallet(
+				_pointer, FfiConverterStringINSTANCE.Lower(mintUrl), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue **FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterOptionalFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Mint URLs of the added mints, sorted
+func (_self *FfiMultiMintWallet) Wallets() []string {
+	_pointer := _self.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterSequenceStringINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffimultimintwallet_wallets(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+func (object *FfiMultiMintWallet) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterFfiMultiMintWallet struct{}
+
+var FfiConverterFfiMultiMintWalletINSTANCE = FfiConverterFfiMultiMintWallet{}
+
+func (c FfiConverterFfiMultiMintWallet) Lift(pointer unsafe.Pointer) *FfiMultiMintWallet {
+	result := &FfiMultiMintWallet{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_cdk_ffi_fn_clone_ffimultimintwallet(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_cdk_ffi_fn_free_ffimultimintwallet(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*FfiMultiMintWallet).Destroy)
+	return result
+}
+
+func (c FfiConverterFfiMultiMintWallet) Read(reader io.Reader) *FfiMultiMintWallet {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterFfiMultiMintWallet) Lower(value *FfiMultiMintWallet) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*FfiMultiMintWallet")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterFfiMultiMintWallet) Write(writer io.Writer, value *FfiMultiMintWallet) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerFfiMultiMintWallet struct{}
+
+func (_ FfiDestroyerFfiMultiMintWallet) Destroy(value *FfiMultiMintWallet) {
+	value.Destroy()
+}
+
+type FfiSubscriptionInterface interface {
+	// Stop receiving updates, calling it again has no effect
+	Unsubscribe()
+}
+
+// An active NUT-17 or balance subscription, kept alive until `unsubscribe` is called
+// or the wallet that created it is dropped
+type FfiSubscription struct {
+	ffiObject FfiObject
+}
+
+// Stop receiving updates, calling it again has no effect
+func (_self *FfiSubscription) Unsubscribe() {
+	_pointer := _self.ffiObject.incrementPointer("*FfiSubscription")
+	defer _self.ffiObject.decrementPointer()
+	rustCall(func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffisubscription_unsubscribe(
+			_pointer, _uniffiStatus)
+		return false
+	})
+}
+func (object *FfiSubscription) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterFfiSubscription struct{}
+
+var FfiConverterFfiSubscriptionINSTANCE = FfiConverterFfiSubscription{}
+
+func (c FfiConverterFfiSubscription) Lift(pointer unsafe.Pointer) *FfiSubscription {
+	result := &FfiSubscription{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_cdk_ffi_fn_clone_ffisubscription(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_cdk_ffi_fn_free_ffisubscription(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*FfiSubscription).Destroy)
+	return result
+}
+
+func (c FfiConverterFfiSubscription) Read(reader io.Reader) *FfiSubscription {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterFfiSubscription) Lower(value *FfiSubscription) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*FfiSubscription")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterFfiSubscription) Write(writer io.Writer, value *FfiSubscription) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerFfiSubscription struct{}
+
+func (_ FfiDestroyerFfiSubscription) Destroy(value *FfiSubscription) {
+	value.Destroy()
+}
+
+type FfiWalletInterface interface {
+	// Every value stored with `set_metadata` for this wallet's mint and unit
+	AllMetadata() (map[string]string, error)
+	// Mint a quote as soon as the mint reports it paid, watching it like `subscribe_mint_quote`
+	// The subscription ends after `observer` was called, or when `unsubscribe` is called first
+	// Calls come from an internal thread and must not call back into the wallet synchronously
+	AutoMintOnPayment(quoteId string, splitTarget FfiSplitTarget, observer MintedObserver) (*FfiSubscription, error)
+	Balance() (FfiAmount, error)
+	// Spendable, pending and reserved balance read in one pass over the store, so the figures
+	// agree with each other even while other calls move proofs
+	BalanceSnapshot() (FfiBalanceSnapshot, error)
+	// Release the proofs a `prepare_send` reserved, returning them to the balance without a
+	// swap. Unknown reservations, including finished or expired ones, are InvalidInput
+	CancelPreparedSend(prepared FfiPreparedSend) error
+	// Ask the mint for the state of every stored proof (NUT-07)
+	// Proofs the mint reports as spent are marked spent in the database
+	CheckProofStates() ([]FfiProofState, error)
+	// Finish a send from `prepare_send` with the proofs it reserved, so the fee charged is the
+	// one it reported. Unknown, already finished and expired reservations are InvalidInput
+	ConfirmSend(prepared FfiPreparedSend) (FfiToken, error)
+	// Number of unspent proofs held of each denomination, by amount
+	// Many small proofs make sends swap more often, a sign the wallet could consolidate
+	DenominationBreakdown() (map[uint64]uint32, error)
+	// Collect a redacted snapshot of the wallet state for bug reports
+	// Only counts and identifiers are included, never seeds, secrets or proofs. Tokens, auth
+	// tokens and hex secrets are cut from the recent error messages
+	DiagnosticReport() (FfiDiagnostics, error)
+	// Estimate the fee reserve a melt quote for this invoice would ask for, without creating one
+	// The rate is the one set with `set_fee_reserve_percent`, otherwise it is taken from the
+	// largest earlier melt quote in this unit, or CDK's mint default
+	EstimateMeltFee(request string) (FfiAmount, error)
+	// Input fee (NUT-02) the mint charges for swapping a token's proofs when it is received,
+	// computed from the stored keysets without contacting the mint
+	// Tokens from another mint or with a keyset this wallet has not stored are InvalidInput
+	EstimateReceiveFee(token string) (FfiAmount, error)
+	// Export the unspent proofs of this wallet as a blob encrypted with `passphrase`
+	// Reserved and pending proofs are not included
+	ExportProofs(passphrase string) ([]byte, error)
+	// Estimate rate set with `set_fee_reserve_percent`, none while the learned rate is used
+	FeeReservePercent() *float64
+	// Value stored with `set_metadata` under `key`, none if there is no such key
+	GetMetadata(key string) (*string, error)
+	// Fetch and initialize mint information
+	// This should be called after wallet creation to set up the mint in the database
+	GetMintInfo() (string, error)
+	// Receive the proofs of a blob written by `export_proofs`, returning the amount recovered
+	// The proofs are swapped like a received token so the blob cannot be replayed
+	ImportProofs(blob []byte, passphrase string) (FfiAmount, error)
+	// NUT-13 derivation counter of every stored keyset of this wallet's unit, by keyset id
+	// The counter is the index of the next secret the wallet derives for that keyset
+	KeysetCounters() (map[string]uint32, error)
+	// List every keyset the mint has announced, active or not, with its input fee
+	ListKeysets() ([]FfiKeysetInfo, error)
+	// List the stored proofs of this wallet, optionally only those in one state
+	// The secrets allow spending the proofs, so the result must not leave the device
+	ListProofs(state *FfiProofStateFilter) ([]FfiProof, error)
+	// List the transactions recorded for this wallet's mint, newest first
+	// The optional filter limits the result to one direction and/or unit
+	ListTransactions(filter *FfiTransactionFilter) ([]FfiTransaction, error)
+	// One page of the unfiltered `list_transactions`, for paging through long histories
+	// Transactions recorded while paging push the older ones back by as many places. A file
+	// store reads only the page, an in-memory store sorts its whole history for every page
+	ListTransactionsPage(offset uint32, limit uint32) ([]FfiTransaction, error)
+	// Keep proofs out of coin selection, e.g. to hold them for a later offline payment
+	// Locked proofs count as reserved until `unlock_proofs`. A secret that is not an unspent
+	// proof of this wallet is InvalidInput, proofs that are already locked stay locked
+	LockProofs(secrets []string) error
+	// Execute a melt operation (pay Lightning invoice)
+	Melt(quoteId string) (FfiMelted, error)
+	// Pay several Lightning invoices in order from the wallet balance, one entry per invoice
+	// Fails with `OperationDisabled` if the mint disabled melting, and with
+	// `InsufficientFunds` before anything is paid if the balance cannot cover the invoice
+	// amounts and input fees or, once quoted, their fee reserves too, in which case the quotes
+	// are removed again. An invoice that cannot be quoted or paid has its error in its entry
+	// and the others are still paid, until one runs out of funds: later invoices are skipped
+	MeltBatch(requests []string) ([]FfiMeltBatchEntry, error)
+	// Create a melt quote for paying a Lightning invoice
+	MeltQuote(request string) (FfiMeltQuote, error)
+	// Create a NUT-15 multi-path melt quote paying only `partial_amount` of the invoice
+	// The caller is responsible for quoting and paying the other parts at other mints,
+	// the invoice only settles once every part is paid
+	MeltQuoteMpp(request string, partialAmount FfiAmount) (FfiMeltQuote, error)
+	// Execute a melt like `melt`, but only if the quote's fee reserve is at most `max_fee`
+	// Fails with `FFIError::FeeTooHigh` before anything is paid otherwise
+	MeltWithMaxFee(quoteId string, maxFee FfiAmount) (FfiMelted, error)
+	Mint(quoteId string, splitTarget FfiSplitTarget) (FfiAmount, error)
+	// Create a mint quote, wait up to `timeout_secs` for it to be paid and mint it, for scripts
+	// and test mints that pay their own invoices. The quote only comes back at the end, apps
+	// showing the invoice call `mint_quote` and `wait_for_mint_quote_paid` themselves
+	// Fails with `FFIError::Timeout`, naming the quote, if it is still unpaid when time is up
+	MintAndWait(amount FfiAmount, description *string, timeoutSecs uint64, splitTarget FfiSplitTarget) (FfiMintAndWaitResult, error)
+	// Mint like `mint`, also reporting the denomination of every proof issued
+	MintDetailed(quoteId string, splitTarget FfiSplitTarget) (FfiMintResult, error)
+	// Fetch the mint's NUT-06 info as a structured record
+	MintInfo() (FfiMintInfo, error)
+	MintQuote(amount FfiAmount, description *string) (FfiMintQuote, error)
+	MintQuoteState(quoteId string) (FfiMintQuoteBolt11Response, error)
+	// Check several mint quotes at once, returning their states in the order of `quote_ids`
+	// The requests to the mint run concurrently and the first failure fails the whole call
+	MintQuoteStates(quoteIds []string) ([]FfiMintQuoteBolt11Response, error)
+	// Create a mint quote with a description and a shorter lifetime than the mint's
+	// The expiry is enforced by the wallet, NUT-04 has no way to ask the mint for it
+	MintQuoteWithOptions(amount FfiAmount, options FfiMintQuoteOptions) (FfiMintQuote, error)
+	MintUrl() string
+	// Mint a paid quote into proofs of exactly the given denominations
+	// The amounts must add up to the quote amount
+	MintWithAmounts(quoteId string, amounts []FfiAmount) (FfiAmount, error)
+	// Sum the transaction history between two unix timestamps (inclusive)
+	// Incoming amounts are already net of fees, so only outgoing fees reduce `net`. Fails
+	// with `InternalError` if a total or `net` does not fit its type
+	NetFlow(since uint64, until uint64) (FfiNetFlow, error)
+	// Call `observer` with the balance whenever a call on this wallet changed it, such as a
+	// mint, melt, send, receive or swap, until `unsubscribe` is called
+	// Calls come from an internal thread and must not call back into the wallet synchronously
+	ObserveBalance(observer BalanceObserver) (*FfiSubscription, error)
+	// Report whether minting, melting and swapping are enabled in the mint's advertised settings
+	OperationAvailability() (FfiOperationAvailability, error)
+	// Pay a `creqA` payment request (NUT-18) with a token for the requested amount
+	// With an HTTP POST transport the token is also delivered to the payee, and taken back if
+	// that fails. Nostr transports are not supported, the caller hands over the returned token
+	// Proofs are selected as `send` selects them with `options`
+	PayPaymentRequest(request string, options FfiSendOptions) (FfiToken, error)
+	// Total of proofs in the pending state, e.g. inputs of a melt still in flight
+	PendingBalance() (FfiAmount, error)
+	// Melt quotes in the store still to be finished, so an app can show them after a restart:
+	// unpaid ones that have not expired, and ones whose payment is still in flight
+	// CDK does not record the mint of a melt quote, so with a store shared across mints this
+	// lists the quotes of every mint in this unit
+	PendingMeltQuotes() ([]FfiMeltQuote, error)
+	// Mint quotes in the store still to be finished, so an app can resume waiting for them
+	// after a restart: unpaid ones that have not expired, and paid ones not yet minted
+	PendingMintQuotes() ([]FfiMintQuote, error)
+	// List unpaid mint and melt quotes with the seconds left until they expire
+	// Expired quotes are skipped and the soonest to expire comes first. CDK does not record the
+	// mint of a melt quote, so with a store shared across mints the melt quotes of every mint in
+	// this unit are listed
+	PendingQuoteExpiries() ([]FfiQuoteExpiry, error)
+	// Select and reserve the proofs for a send and report its fees
+	// `confirm_send` sends exactly these proofs for the reported fee, within ten minutes unless
+	// `set_prepared_send_ttl` changed it. Reservations are recorded in the store, so other
+	// wallets on it leave the proofs alone until they expire. Proofs of an expired reservation
+	// return to the balance the next time a wallet on the store is opened
+	// With `dry_run` set nothing is reserved or stored: the result previews the fees only and
+	// cannot be finalized, a later send selects its proofs again
+	PrepareSend(amount FfiAmount, options FfiSendOptions) (FfiPreparedSend, error)
+	// Hex public key of the wallet's P2PK receiving key, derived from its seed
+	// Tokens locked to it are unlocked by `receive` without passing a signing key. The key is on a
+	// path of this library's own, so other wallets restored from the same mnemonic cannot spend
+	// them
+	Pubkey() (string, error)
+	// Receive an encoded token into the wallet
+	// The result carries the token's memo and unit for the wallet's history
+	// With `idempotent` set, a token that was already received returns its original amount
+	// With `trust_unswapped` set, the proofs are stored as-is after a NUT-07 unspent check,
+	// saving the swap fee but leaving the sender able to spend them too. Tokens in another
+	// unit or locked with P2PK or HTLC are InvalidInput, they need a swap
+	// With `require_dleq` set, a token with proofs lacking a valid DLEQ proof fails with
+	// DleqVerificationFailed before it is swapped
+	Receive(token string, options FfiReceiveOptions) (FfiReceiveResult, error)
+	// Receive several tokens, e.g. pasted at once, with as few swaps as possible
+	// Tokens of this wallet's mint and unit are swapped together in one request. If that swap
+	// fails, e.g. because one of them was already spent, each token is received on its own so
+	// the others still go through. `idempotent`, `trust_unswapped` and `require_dleq` always
+	// receive one by one
+	// Tokens that could not be received are listed in `failed` and left out of `amount`
+	ReceiveBatch(tokens []string, options FfiReceiveOptions) (FfiReceiveBatchResult, error)
+	// Store a token's proofs as they are, without contacting the mint at all
+	// Only for transfers between wallets of the same owner: the sender keeps the secrets and
+	// the proofs may already be spent, which shows only when they are spent from here
+	// Tokens from another mint or unit, locked with P2PK or HTLC, or with a keyset missing from
+	// the store are InvalidInput
+	ReceiveOffline(token string) (FfiAmount, error)
+	// Take back the proofs of a sent token the recipient has not redeemed yet
+	// The proofs are swapped for fresh ones, returns the amount reclaimed after fees
+	ReclaimSend(token string) (FfiAmount, error)
+	// Check every stored proof against the mint (NUT-07) to repair the store after a crash:
+	// proofs the mint reports spent are deleted, and pending ones it reports unspent become
+	// spendable again. Reserved proofs are left to the send or lock that reserved them
+	Reconcile() (FfiReconcileResult, error)
+	// Fetch the mint's keysets and swap unspent proofs of keysets it no longer keeps active,
+	// so they stay spendable after a key rotation. The swap pays the input fee of those proofs
+	RefreshKeysets() (FfiKeysetRefreshResult, error)
+	// Total of proofs reserved by a prepared send that has not been redeemed or reclaimed
+	ReservedBalance() (FfiAmount, error)
+	Send(amount FfiAmount, options FfiSendOptions, memo *FfiSendMemo) (FfiToken, error)
+	// Set the clear auth token (NUT-21) for mints that protect endpoints
+	// The wallet sends it on clear-auth endpoints and spends it to mint blind auth tokens
+	// (NUT-22) for blind-auth ones. Mints without auth ignore it
+	SetAuthToken(token string) error
+	// For tests only: order coin selection by `seed`, so the same proofs and seed always
+	// select the same proofs and yield the same token. Secrets and blinding factors already
+	// follow the wallet seed (NUT-13), P2PK and HTLC nonces stay random. Never enable this in
+	// production, a predictable selection makes the wallet's payments easier to link
+	SetDeterministic(seed uint64)
+	// Set the Lightning fee reserve, in percent of the amount, that `estimate_melt_fee` uses
+	// instead of the rate learned from earlier melt quotes. Only the local estimate changes:
+	// melts still reserve what the mint quotes. It is kept in the local store for this
+	// wallet's mint and unit, so it survives reopening the wallet
+	SetFeeReservePercent(percent float64) error
+	// Move the NUT-13 derivation counter of a keyset forward, e.g. past secrets a restore
+	// missed. Lowering it would reuse secrets the mint already signed and is InvalidInput,
+	// as is a keyset this wallet has not stored
+	SetKeysetCounter(keysetId string, counter uint32) error
+	// Store an app defined value, such as a label, for this wallet's mint and unit
+	// It is kept in the local store and replaces an earlier value under the same key
+	SetMetadata(key string, value string) error
+	// Hold the proofs of sends prepared from now on for `ttl_secs` before `confirm_send`
+	// refuses them and they return to the balance. Ten minutes unless set
+	SetPreparedSendTtl(ttlSecs uint64)
+	// Fail quote, state and mint info calls that take longer than `timeout_secs` with
+	// `FFIError::Timeout`, so an unreachable mint cannot hang the caller. 0 removes the limit,
+	// which is the default. Calls that move proofs, such as send, receive, melt, swap and
+	// mint, are never cut short, since that could lose signed outputs or leave proofs reserved
+	// `wait_for_mint_quote_paid` keeps its own timeout
+	SetRequestTimeout(timeoutSecs uint64)
+	// Retry failed requests of `get_mint_info`, `mint_info`, `mint_quote_state`,
+	// `mint_quote_states` and `list_keysets` up to `max_retries` times, waiting `base_delay_ms`
+	// and then twice as long before each further retry. Only failures that never reached the
+	// mint or got no mint error back, such as a timeout or a 502, are retried. Calls that move
+	// funds are never retried. The request timeout bounds the whole call, retries included
+	SetRetryPolicy(maxRetries uint8, baseDelayMs uint64)
+	// Subscribe to state changes of a mint quote over the mint's WebSocket (NUT-17)
+	// The mint is polled instead if it does not support WebSocket subscriptions
+	SubscribeMintQuote(quoteId string, observer MintQuoteObserver) (*FfiSubscription, error)
+	// Swap stored proofs with the mint to consolidate them into the given split
+	// Without an amount every unspent proof is swapped, returns the amount after fees
+	Swap(amount *FfiAmount, splitTarget FfiSplitTarget) (FfiAmount, error)
+	// Check with the mint whether a received token was already redeemed, before receiving it
+	// Proofs pending at the mint count as spent since they cannot be redeemed either
+	TokenState(token string) (FfiTokenState, error)
+	Unit() string
+	// Return proofs locked with `lock_proofs` to coin selection
+	// A secret of a proof that is not locked is InvalidInput
+	UnlockProofs(secrets []string) error
+	// Move the wallet's stored proofs, quotes and mint info to the URL its mint migrated to
+	// The mint answering there must have the pubkey stored for this mint, else InvalidInput
+	// Every later call on this wallet fails, reopen it at the new URL to keep using it
+	UpdateMintUrl(newUrl string) error
+	// Verify the mint's DLEQ proofs (NUT-12) on a token using the keys stored for this mint
+	// No request is made, so the mint's keysets must have been loaded before
+	VerifyTokenDleq(token string) (bool, error)
+	// Block until a mint quote is paid or `timeout_secs` elapse, returning its final state
+	// Fails with `FFIError::Timeout` if the quote is still unpaid when the time is up
+	WaitForMintQuotePaid(quoteId string, timeoutSecs uint64) (FfiMintQuoteBolt11Response, error)
+}
+type FfiWallet struct {
+	ffiObject FfiObject
+}
+
+func FfiWalletFromMnemonic(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a wallet from a mnemonic and store `proofs` in it as unspent, e.g. when moving
+// proofs kept outside any wallet. The keysets are fetched from the mint and every proof
+// must belong to one of its keysets for `unit`, otherwise InvalidInput is returned
+// Proofs are not checked against the mint, `reconcile` drops any that were spent
+func FfiWalletFromMnemonicWithProofs(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string, proofs []FfiProof) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic_with_proofs(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), FfiConverterSequenceFfiProofINSTANCE.Lower(proofs), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a wallet from a mnemonic whose requests, to the mint and to NUT-18 payment
+// targets, all go through a proxy
+// `proxy_url` is a socks5, socks5h or http URL, e.g. `socks5h://127.0.0.1:9050` for Tor
+// Returns NetworkError if nothing answers on the proxy address
+func FfiWalletFromMnemonicWithProxy(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string, proxyUrl string) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic_with_proxy(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), FfiConverterStringINSTANCE.Lower(proxyUrl), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a wallet from a 64-byte BIP39 seed, or from 16 to 32 bytes of mnemonic entropy
+func FfiWalletFromSeed(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, seed []byte) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_from_seed(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterBytesINSTANCE.Lower(seed), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func FfiWalletRestoreFromMnemonic(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_restore_from_mnemonic(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Restore a wallet like `restore_from_mnemonic`, reporting progress after every batch
+func FfiWalletRestoreFromMnemonicWithProgress(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string, progress RestoreProgress) (*FfiWallet, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_restore_from_mnemonic_with_progress(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), FfiConverterCallbackInterfaceRestoreProgressINSTANCE.Lower(progress), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiWallet
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Every value stored with `set_metadata` for this wallet's mint and unit
+func (_self *FfiWallet) AllMetadata() (map[string]string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_all_metadata(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue map[string]string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterMapStringStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Mint a quote as soon as the mint reports it paid, watching it like `subscribe_mint_quote`
+// The subscription ends after `observer` was called, or when `unsubscribe` is called first
+// Calls come from an internal thread and must not call back into the wallet synchronously
+func (_self *FfiWallet) AutoMintOnPayment(quoteId string, splitTarget FfiSplitTarget, observer MintedObserver) (*FfiSubscription, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_method_ffiwallet_auto_mint_on_payment(
+			_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), FfiConverterCallbackInterfaceMintedObserverINSTANCE.Lower(observer), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiSubscription
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiSubscriptionINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) Balance() (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_balance(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Spendable, pending and reserved balance read in one pass over the store, so the figures
+// agree with each other even while other calls move proofs
+func (_self *FfiWallet) BalanceSnapshot() (FfiBalanceSnapshot, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_balance_snapshot(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiBalanceSnapshot
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiBalanceSnapshotINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Release the proofs a `prepare_send` reserved, returning them to the balance without a
+// swap. Unknown reservations, including finished or expired ones, are InvalidInput
+func (_self *FfiWallet) CancelPreparedSend(prepared FfiPreparedSend) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_cancel_prepared_send(
+			_pointer, FfiConverterFfiPreparedSendINSTANCE.Lower(prepared), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Ask the mint for the state of every stored proof (NUT-07)
+// Proofs the mint reports as spent are marked spent in the database
+func (_self *FfiWallet) CheckProofStates() ([]FfiProofState, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_check_proof_states(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiProofState
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiProofStateINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Finish a send from `prepare_send` with the proofs it reserved, so the fee charged is the
+// one it reported. Unknown, already finished and expired reservations are InvalidInput
+func (_self *FfiWallet) ConfirmSend(prepared FfiPreparedSend) (FfiToken, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_confirm_send(
+				_pointer, FfiConverterFfiPreparedSendINSTANCE.Lower(prepared), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiToken
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Number of unspent proofs held of each denomination, by amount
+// Many small proofs make sends swap more often, a sign the wallet could consolidate
+func (_self *FfiWallet) DenominationBreakdown() (map[uint64]uint32, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_denomination_breakdown(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue map[uint64]uint32
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterMapUint64Uint32INSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Collect a redacted snapshot of the wallet state for bug reports
+// Only counts and identifiers are included, never seeds, secrets or proofs. Tokens, auth
+// tokens and hex secrets are cut from the recent error messages
+func (_self *FfiWallet) DiagnosticReport() (FfiDiagnostics, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_diagnostic_report(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiDiagnostics
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiDiagnosticsINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Estimate the fee reserve a melt quote for this invoice would ask for, without creating one
+// The rate is the one set with `set_fee_reserve_percent`, otherwise it is taken from the
+// largest earlier melt quote in this unit, or CDK's mint default
+func (_self *FfiWallet) EstimateMeltFee(request string) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_estimate_melt_fee(
+				_pointer, FfiConverterStringINSTANCE.Lower(request), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Input fee (NUT-02) the mint charges for swapping a token's proofs when it is received,
+// computed from the stored keysets without contacting the mint
+// Tokens from another mint or with a keyset this wallet has not stored are InvalidInput
+func (_self *FfiWallet) EstimateReceiveFee(token string) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_estimate_receive_fee(
+				_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Export the unspent proofs of this wallet as a blob encrypted with `passphrase`
+// Reserved and pending proofs are not included
+func (_self *FfiWallet) ExportProofs(passphrase string) ([]byte, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_export_proofs(
+				_pointer, FfiConverterStringINSTANCE.Lower(passphrase), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []byte
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Estimate rate set with `set_fee_reserve_percent`, none while the learned rate is used
+func (_self *FfiWallet) FeeReservePercent() *float64 {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterOptionalFloat64INSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_fee_reserve_percent(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+
+// Value stored with `set_metadata` under `key`, none if there is no such key
+func (_self *FfiWallet) GetMetadata(key string) (*string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_get_metadata(
+				_pointer, FfiConverterStringINSTANCE.Lower(key), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterOptionalStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Fetch and initialize mint information
+// This should be called after wallet creation to set up the mint in the database
+func (_self *FfiWallet) GetMintInfo() (string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_get_mint_info(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Receive the proofs of a blob written by `export_proofs`, returning the amount recovered
+// The proofs are swapped like a received token so the blob cannot be replayed
+func (_self *FfiWallet) ImportProofs(blob []byte, passphrase string) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_import_proofs(
+				_pointer, FfiConverterBytesINSTANCE.Lower(blob), FfiConverterStringINSTANCE.Lower(passphrase), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// NUT-13 derivation counter of every stored keyset of this wallet's unit, by keyset id
+// The counter is the index of the next secret the wallet derives for that keyset
+func (_self *FfiWallet) KeysetCounters() (map[string]uint32, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_keyset_counters(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue map[string]uint32
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterMapStringUint32INSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// List every keyset the mint has announced, active or not, with its input fee
+func (_self *FfiWallet) ListKeysets() ([]FfiKeysetInfo, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_list_keysets(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiKeysetInfo
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiKeysetInfoINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// List the stored proofs of this wallet, optionally only those in one state
+// The secrets allow spending the proofs, so the result must not leave the device
+func (_self *FfiWallet) ListProofs(state *FfiProofStateFilter) ([]FfiProof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_list_proofs(
+				_pointer, FfiConverterOptionalFfiProofStateFilterINSTANCE.Lower(state), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiProof
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiProofINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// List the transactions recorded for this wallet's mint, newest first
+// The optional filter limits the result to one direction and/or unit
+func (_self *FfiWallet) ListTransactions(filter *FfiTransactionFilter) ([]FfiTransaction, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_list_transactions(
+				_pointer, FfiConverterOptionalFfiTransactionFilterINSTANCE.Lower(filter), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiTransaction
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiTransactionINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// One page of the unfiltered `list_transactions`, for paging through long histories
+// Transactions recorded while paging push the older ones back by as many places. A file
+// store reads only the page, an in-memory store sorts its whole history for every page
+func (_self *FfiWallet) ListTransactionsPage(offset uint32, limit uint32) ([]FfiTransaction, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_list_transactions_page(
+				_pointer, FfiConverterUint32INSTANCE.Lower(offset), FfiConverterUint32INSTANCE.Lower(limit), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiTransaction
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiTransactionINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Keep proofs out of coin selection, e.g. to hold them for a later offline payment
+// Locked proofs count as reserved until `unlock_proofs`. A secret that is not an unspent
+// proof of this wallet is InvalidInput, proofs that are already locked stay locked
+func (_self *FfiWallet) LockProofs(secrets []string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_lock_proofs(
+			_pointer, FfiConverterSequenceStringINSTANCE.Lower(secrets), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Execute a melt operation (pay Lightning invoice)
+func (_self *FfiWallet) Melt(quoteId string) (FfiMelted, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMelted
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMeltedINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Pay several Lightning invoices in order from the wallet balance, one entry per invoice
+// Fails with `OperationDisabled` if the mint disabled melting, and with
+// `InsufficientFunds` before anything is paid if the balance cannot cover the invoice
+// amounts and input fees or, once quoted, their fee reserves too, in which case the quotes
+// are removed again. An invoice that cannot be quoted or paid has its error in its entry
+// and the others are still paid, until one runs out of funds: later invoices are skipped
+func (_self *FfiWallet) MeltBatch(requests []string) ([]FfiMeltBatchEntry, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt_batch(
+				_pointer, FfiConverterSequenceStringINSTANCE.Lower(requests), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiMeltBatchEntry
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiMeltBatchEntryINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a melt quote for paying a Lightning invoice
+func (_self *FfiWallet) MeltQuote(request string) (FfiMeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt_quote(
+				_pointer, FfiConverterStringINSTANCE.Lower(request), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMeltQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMeltQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a NUT-15 multi-path melt quote paying only `partial_amount` of the invoice
+// The caller is responsible for quoting and paying the other parts at other mints,
+// the invoice only settles once every part is paid
+func (_self *FfiWallet) MeltQuoteMpp(request string, partialAmount FfiAmount) (FfiMeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt_quote_mpp(
+				_pointer, FfiConverterStringINSTANCE.Lower(request), FfiConverterFfiAmountINSTANCE.Lower(partialAmount), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMeltQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMeltQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Execute a melt like `melt`, but only if the quote's fee reserve is at most `max_fee`
+// Fails with `FFIError::FeeTooHigh` before anything is paid otherwise
+func (_self *FfiWallet) MeltWithMaxFee(quoteId string, maxFee FfiAmount) (FfiMelted, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt_with_max_fee(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterFfiAmountINSTANCE.Lower(maxFee), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMelted
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMeltedINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) Mint(quoteId string, splitTarget FfiSplitTarget) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a mint quote, wait up to `timeout_secs` for it to be paid and mint it, for scripts
+// and test mints that pay their own invoices. The quote only comes back at the end, apps
+// showing the invoice call `mint_quote` and `wait_for_mint_quote_paid` themselves
+// Fails with `FFIError::Timeout`, naming the quote, if it is still unpaid when time is up
+func (_self *FfiWallet) MintAndWait(amount FfiAmount, description *string, timeoutSecs uint64, splitTarget FfiSplitTarget) (FfiMintAndWaitResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_and_wait(
+				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description), FfiConverterUint64INSTANCE.Lower(timeoutSecs), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintAndWaitResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintAndWaitResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Mint like `mint`, also reporting the denomination of every proof issued
+func (_self *FfiWallet) MintDetailed(quoteId string, splitTarget FfiSplitTarget) (FfiMintResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_detailed(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Fetch the mint's NUT-06 info as a structured record
+func (_self *FfiWallet) MintInfo() (FfiMintInfo, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_info(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintInfo
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintInfoINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) MintQuote(amount FfiAmount, description *string) (FfiMintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote(
+				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) MintQuoteState(quoteId string) (FfiMintQuoteBolt11Response, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_state(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintQuoteBolt11Response
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintQuoteBolt11ResponseINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Check several mint quotes at once, returning their states in the order of `quote_ids`
+// The requests to the mint run concurrently and the first failure fails the whole call
+func (_self *FfiWallet) MintQuoteStates(quoteIds []string) ([]FfiMintQuoteBolt11Response, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_states(
+				_pointer, FfiConverterSequenceStringINSTANCE.Lower(quoteIds), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiMintQuoteBolt11Response
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiMintQuoteBolt11ResponseINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Create a mint quote with a description and a shorter lifetime than the mint's
+// The expiry is enforced by the wallet, NUT-04 has no way to ask the mint for it
+func (_self *FfiWallet) MintQuoteWithOptions(amount FfiAmount, options FfiMintQuoteOptions) (FfiMintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_with_options(
+				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterFfiMintQuoteOptionsINSTANCE.Lower(options), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) MintUrl() string {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterStringINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_url(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+
+// Mint a paid quote into proofs of exactly the given denominations
+// The amounts must add up to the quote amount
+func (_self *FfiWallet) MintWithAmounts(quoteId string, amounts []FfiAmount) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_with_amounts(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterSequenceFfiAmountINSTANCE.Lower(amounts), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Sum the transaction history between two unix timestamps (inclusive)
+// Incoming amounts are already net of fees, so only outgoing fees reduce `net`. Fails
+// with `InternalError` if a total or `net` does not fit its type
+func (_self *FfiWallet) NetFlow(since uint64, until uint64) (FfiNetFlow, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_net_flow(
+				_pointer, FfiConverterUint64INSTANCE.Lower(since), FfiConverterUint64INSTANCE.Lower(until), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiNetFlow
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiNetFlowINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Call `observer` with the balance whenever a call on this wallet changed it, such as a
+// mint, melt, send, receive or swap, until `unsubscribe` is called
+// Calls come from an internal thread and must not call back into the wallet synchronously
+func (_self *FfiWallet) ObserveBalance(observer BalanceObserver) (*FfiSubscription, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_method_ffiwallet_observe_balance(
+			_pointer, FfiConverterCallbackInterfaceBalanceObserverINSTANCE.Lower(observer), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiSubscription
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiSubscriptionINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Report whether minting, melting and swapping are enabled in the mint's advertised settings
+func (_self *FfiWallet) OperationAvailability() (FfiOperationAvailability, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_operation_availability(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiOperationAvailability
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiOperationAvailabilityINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Pay a `creqA` payment request (NUT-18) with a token for the requested amount
+// With an HTTP POST transport the token is also delivered to the payee, and taken back if
+// that fails. Nostr transports are not supported, the caller hands over the returned token
+// Proofs are selected as `send` selects them with `options`
+func (_self *FfiWallet) PayPaymentRequest(request string, options FfiSendOptions) (FfiToken, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pay_payment_request(
+				_pointer, FfiConverterStringINSTANCE.Lower(request), FfiConverterFfiSendOptionsINSTANCE.Lower(options), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiToken
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Total of proofs in the pending state, e.g. inputs of a melt still in flight
+func (_self *FfiWallet) PendingBalance() (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pending_balance(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Melt quotes in the store still to be finished, so an app can show them after a restart:
+// unpaid ones that have not expired, and ones whose payment is still in flight
+// CDK does not record the mint of a melt quote, so with a store shared across mints this
+// lists the quotes of every mint in this unit
+func (_self *FfiWallet) PendingMeltQuotes() ([]FfiMeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pending_melt_quotes(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiMeltQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiMeltQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Mint quotes in the store still to be finished, so an app can resume waiting for them
+// after a restart: unpaid ones that have not expired, and paid ones not yet minted
+func (_self *FfiWallet) PendingMintQuotes() ([]FfiMintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pending_mint_quotes(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiMintQuote
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiMintQuoteINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// List unpaid mint and melt quotes with the seconds left until they expire
+// Expired quotes are skipped and the soonest to expire comes first. CDK does not record the
+// mint of a melt quote, so with a store shared across mints the melt quotes of every mint in
+// this unit are listed
+func (_self *FfiWallet) PendingQuoteExpiries() ([]FfiQuoteExpiry, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pending_quote_expiries(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []FfiQuoteExpiry
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterSequenceFfiQuoteExpiryINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Select and reserve the proofs for a send and report its fees
+// `confirm_send` sends exactly these proofs for the reported fee, within ten minutes unless
+// `set_prepared_send_ttl` changed it. Reservations are recorded in the store, so other
+// wallets on it leave the proofs alone until they expire. Proofs of an expired reservation
+// return to the balance the next time a wallet on the store is opened
+// With `dry_run` set nothing is reserved or stored: the result previews the fees only and
+// cannot be finalized, a later send selects its proofs again
+func (_self *FfiWallet) PrepareSend(amount FfiAmount, options FfiSendOptions) (FfiPreparedSend, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_prepare_send(
+				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterFfiSendOptionsINSTANCE.Lower(options), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiPreparedSend
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiPreparedSendINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Hex public key of the wallet's P2PK receiving key, derived from its seed
+// Tokens locked to it are unlocked by `receive` without passing a signing key. The key is on a
+// path of this library's own, so other wallets restored from the same mnemonic cannot spend
+// them
+func (_self *FfiWallet) Pubkey() (string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_pubkey(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Receive an encoded token into the wallet
+// The result carries the token's memo and unit for the wallet's history
+// With `idempotent` set, a token that was already received returns its original amount
+// With `trust_unswapped` set, the proofs are stored as-is after a NUT-07 unspent check,
+// saving the swap fee but leaving the sender able to spend them too. Tokens in another
+// unit or locked with P2PK or HTLC are InvalidInput, they need a swap
+// With `require_dleq` set, a token with proofs lacking a valid DLEQ proof fails with
+// DleqVerificationFailed before it is swapped
+func (_self *FfiWallet) Receive(token string, options FfiReceiveOptions) (FfiReceiveResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_receive(
+				_pointer, FfiConverterStringINSTANCE.Lower(token), FfiConverterFfiReceiveOptionsINSTANCE.Lower(options), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiReceiveResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiReceiveResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Receive several tokens, e.g. pasted at once, with as few swaps as possible
+// Tokens of this wallet's mint and unit are swapped together in one request. If that swap
+// fails, e.g. because one of them was already spent, each token is received on its own so
+// the others still go through. `idempotent`, `trust_unswapped` and `require_dleq` always
+// receive one by one
+// Tokens that could not be received are listed in `failed` and left out of `amount`
+func (_self *FfiWallet) ReceiveBatch(tokens []string, options FfiReceiveOptions) (FfiReceiveBatchResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_receive_batch(
+				_pointer, FfiConverterSequenceStringINSTANCE.Lower(tokens), FfiConverterFfiReceiveOptionsINSTANCE.Lower(options), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiReceiveBatchResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiReceiveBatchResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Store a token's proofs as they are, without contacting the mint at all
+// Only for transfers between wallets of the same owner: the sender keeps the secrets and
+// the proofs may already be spent, which shows only when they are spent from here
+// Tokens from another mint or unit, locked with P2PK or HTLC, or with a keyset missing from
+// the store are InvalidInput
+func (_self *FfiWallet) ReceiveOffline(token string) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_receive_offline(
+				_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Take back the proofs of a sent token the recipient has not redeemed yet
+// The proofs are swapped for fresh ones, returns the amount reclaimed after fees
+func (_self *FfiWallet) ReclaimSend(token string) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_reclaim_send(
+				_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Check every stored proof against the mint (NUT-07) to repair the store after a crash:
+// proofs the mint reports spent are deleted, and pending ones it reports unspent become
+// spendable again. Reserved proofs are left to the send or lock that reserved them
+func (_self *FfiWallet) Reconcile() (FfiReconcileResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_reconcile(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiReconcileResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiReconcileResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Fetch the mint's keysets and swap unspent proofs of keysets it no longer keeps active,
+// so they stay spendable after a key rotation. The swap pays the input fee of those proofs
+func (_self *FfiWallet) RefreshKeysets() (FfiKeysetRefreshResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_refresh_keysets(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiKeysetRefreshResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiKeysetRefreshResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Total of proofs reserved by a prepared send that has not been redeemed or reclaimed
+func (_self *FfiWallet) ReservedBalance() (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_reserved_balance(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) Send(amount FfiAmount, options FfiSendOptions, memo *FfiSendMemo) (FfiToken, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_send(
+				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterFfiSendOptionsINSTANCE.Lower(options), FfiConverterOptionalFfiSendMemoINSTANCE.Lower(memo), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiToken
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Set the clear auth token (NUT-21) for mints that protect endpoints
+// The wallet sends it on clear-auth endpoints and spends it to mint blind auth tokens
+// (NUT-22) for blind-auth ones. Mints without auth ignore it
+func (_self *FfiWallet) SetAuthToken(token string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_auth_token(
+			_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// For tests only: order coin selection by `seed`, so the same proofs and seed always
+// select the same proofs and yield the same token. Secrets and blinding factors already
+// follow the wallet seed (NUT-13), P2PK and HTLC nonces stay random. Never enable this in
+// production, a predictable selection makes the wallet's payments easier to link
+func (_self *FfiWallet) SetDeterministic(seed uint64) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	rustCall(func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_deterministic(
+			_pointer, FfiConverterUint64INSTANCE.Lower(seed), _uniffiStatus)
+		return false
+	})
+}
+
+// Set the Lightning fee reserve, in percent of the amount, that `estimate_melt_fee` uses
+// instead of the rate learned from earlier melt quotes. Only the local estimate changes:
+// melts still reserve what the mint quotes. It is kept in the local store for this
+// wallet's mint and unit, so it survives reopening the wallet
+func (_self *FfiWallet) SetFeeReservePercent(percent float64) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_fee_reserve_percent(
+			_pointer, FfiConverterFloat64INSTANCE.Lower(percent), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Move the NUT-13 derivation counter of a keyset forward, e.g. past secrets a restore
+// missed. Lowering it would reuse secrets the mint already signed and is InvalidInput,
+// as is a keyset this wallet has not stored
+func (_self *FfiWallet) SetKeysetCounter(keysetId string, counter uint32) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_keyset_counter(
+			_pointer, FfiConverterStringINSTANCE.Lower(keysetId), FfiConverterUint32INSTANCE.Lower(counter), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Store an app defined value, such as a label, for this wallet's mint and unit
+// It is kept in the local store and replaces an earlier value under the same key
+func (_self *FfiWallet) SetMetadata(key string, value string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_metadata(
+			_pointer, FfiConverterStringINSTANCE.Lower(key), FfiConverterStringINSTANCE.Lower(value), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Hold the proofs of sends prepared from now on for `ttl_secs` before `confirm_send`
+// refuses them and they return to the balance. Ten minutes unless set
+func (_self *FfiWallet) SetPreparedSendTtl(ttlSecs uint64) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	rustCall(func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_prepared_send_ttl(
+			_pointer, FfiConverterUint64INSTANCE.Lower(ttlSecs), _uniffiStatus)
+		return false
+	})
+}
+
+// Fail quote, state and mint info calls that take longer than `timeout_secs` with
+// `FFIError::Timeout`, so an unreachable mint cannot hang the caller. 0 removes the limit,
+// which is the default. Calls that move proofs, such as send, receive, melt, swap and
+// mint, are never cut short, since that could lose signed outputs or leave proofs reserved
+// `wait_for_mint_quote_paid` keeps its own timeout
+func (_self *FfiWallet) SetRequestTimeout(timeoutSecs uint64) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	rustCall(func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_request_timeout(
+			_pointer, FfiConverterUint64INSTANCE.Lower(timeoutSecs), _uniffiStatus)
+		return false
+	})
+}
+
+// Retry failed requests of `get_mint_info`, `mint_info`, `mint_quote_state`,
+// `mint_quote_states` and `list_keysets` up to `max_retries` times, waiting `base_delay_ms`
+// and then twice as long before each further retry. Only failures that never reached the
+// mint or got no mint error back, such as a timeout or a 502, are retried. Calls that move
+// funds are never retried. The request timeout bounds the whole call, retries included
+func (_self *FfiWallet) SetRetryPolicy(maxRetries uint8, baseDelayMs uint64) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	rustCall(func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_set_retry_policy(
+			_pointer, FfiConverterUint8INSTANCE.Lower(maxRetries), FfiConverterUint64INSTANCE.Lower(baseDelayMs), _uniffiStatus)
+		return false
+	})
+}
+
+// Subscribe to state changes of a mint quote over the mint's WebSocket (NUT-17)
+// The mint is polled instead if it does not support WebSocket subscriptions
+func (_self *FfiWallet) SubscribeMintQuote(quoteId string, observer MintQuoteObserver) (*FfiSubscription, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_cdk_ffi_fn_method_ffiwallet_subscribe_mint_quote(
+			_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterCallbackInterfaceMintQuoteObserverINSTANCE.Lower(observer), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FfiSubscription
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiSubscriptionINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Swap stored proofs with the mint to consolidate them into the given split
+// Without an amount every unspent proof is swapped, returns the amount after fees
+func (_self *FfiWallet) Swap(amount *FfiAmount, splitTarget FfiSplitTarget) (FfiAmount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_swap(
+				_pointer, FfiConverterOptionalFfiAmountINSTANCE.Lower(amount), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiAmount
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Check with the mint whether a received token was already redeemed, before receiving it
+// Proofs pending at the mint count as spent since they cannot be redeemed either
+func (_self *FfiWallet) TokenState(token string) (FfiTokenState, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_token_state(
+				_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiTokenState
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenStateINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *FfiWallet) Unit() string {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterStringINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_unit(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+
+// Return proofs locked with `lock_proofs` to coin selection
+// A secret of a proof that is not locked is InvalidInput
+func (_self *FfiWallet) UnlockProofs(secrets []string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_unlock_proofs(
+			_pointer, FfiConverterSequenceStringINSTANCE.Lower(secrets), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Move the wallet's stored proofs, quotes and mint info to the URL its mint migrated to
+// The mint answering there must have the pubkey stored for this mint, else InvalidInput
+// Every later call on this wallet fails, reopen it at the new URL to keep using it
+func (_self *FfiWallet) UpdateMintUrl(newUrl string) error {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_method_ffiwallet_update_mint_url(
+			_pointer, FfiConverterStringINSTANCE.Lower(newUrl), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Verify the mint's DLEQ proofs (NUT-12) on a token using the keys stored for this mint
+// No request is made, so the mint's keysets must have been loaded before
+func (_self *FfiWallet) VerifyTokenDleq(token string) (bool, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) C.int8_t {
+		return C.uniffi_cdk_ffi_fn_method_ffiwallet_verify_token_dleq(
+			_pointer, FfiConverterStringINSTANCE.Lower(token), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue bool
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBoolINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Block until a mint quote is paid or `timeout_secs` elapse, returning its final state
+// Fails with `FFIError::Timeout` if the quote is still unpaid when the time is up
+func (_self *FfiWallet) WaitForMintQuotePaid(quoteId string, timeoutSecs uint64) (FfiMintQuoteBolt11Response, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_wait_for_mint_quote_paid(
+				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterUint64INSTANCE.Lower(timeoutSecs), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintQuoteBolt11Response
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintQuoteBolt11ResponseINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+func (object *FfiWallet) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterFfiWallet struct{}
+
+var FfiConverterFfiWalletINSTANCE = FfiConverterFfiWallet{}
+
+func (c FfiConverterFfiWallet) Lift(pointer unsafe.Pointer) *FfiWallet {
+	result := &FfiWallet{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_cdk_ffi_fn_clone_ffiwallet(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_cdk_ffi_fn_free_ffiwallet(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*FfiWallet).Destroy)
+	return result
+}
+
+func (c FfiConverterFfiWallet) Read(reader io.Reader) *FfiWallet {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterFfiWallet) Lower(value *FfiWallet) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*FfiWallet")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterFfiWallet) Write(writer io.Writer, value *FfiWallet) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerFfiWallet struct{}
+
+func (_ FfiDestroyerFfiWallet) Destroy(value *FfiWallet) {
+	value.Destroy()
+}
+
+type FfiAmount struct {
+	Value uint64
+}
+
+func (r *FfiAmount) Destroy() {
+	FfiDestroyerUint64{}.Destroy(r.Value)
+}
+
+type FfiConverterFfiAmount struct{}
+
+var FfiConverterFfiAmountINSTANCE = FfiConverterFfiAmount{}
+
+func (c FfiConverterFfiAmount) Lift(rb RustBufferI) FfiAmount {
+	return LiftFromRustBuffer[FfiAmount](c, rb)
+}
+
+func (c FfiConverterFfiAmount) Read(reader io.Reader) FfiAmount {
+	return FfiAmount{
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiAmount) Lower(value FfiAmount) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiAmount](c, value)
+}
+
+func (c FfiConverterFfiAmount) Write(writer io.Writer, value FfiAmount) {
+	FfiConverterUint64INSTANCE.Write(writer, value.Value)
+}
+
+type FfiDestroyerFfiAmount struct{}
+
+func (_ FfiDestroyerFfiAmount) Destroy(value FfiAmount) {
+	value.Destroy()
+}
+
+type FfiBalanceSnapshot struct {
+	Total     FfiAmount
+	Spendable FfiAmount
+	Pending   FfiAmount
+	Reserved  FfiAmount
+}
+
+func (r *FfiBalanceSnapshot) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Total)
+	FfiDestroyerFfiAmount{}.Destroy(r.Spendable)
+	FfiDestroyerFfiAmount{}.Destroy(r.Pending)
+	FfiDestroyerFfiAmount{}.Destroy(r.Reserved)
+}
+
+type FfiConverterFfiBalanceSnapshot struct{}
+
+var FfiConverterFfiBalanceSnapshotINSTANCE = FfiConverterFfiBalanceSnapshot{}
+
+func (c FfiConverterFfiBalanceSnapshot) Lift(rb RustBufferI) FfiBalanceSnapshot {
+	return LiftFromRustBuffer[FfiBalanceSnapshot](c, rb)
+}
+
+func (c FfiConverterFfiBalanceSnapshot) Read(reader io.Reader) FfiBalanceSnapshot {
+	return FfiBalanceSnapshot{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiBalanceSnapshot) Lower(value FfiBalanceSnapshot) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiBalanceSnapshot](c, value)
+}
+
+func (c FfiConverterFfiBalanceSnapshot) Write(writer io.Writer, value FfiBalanceSnapshot) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Total)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Spendable)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Pending)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Reserved)
+}
+
+type FfiDestroyerFfiBalanceSnapshot struct{}
+
+func (_ FfiDestroyerFfiBalanceSnapshot) Destroy(value FfiBalanceSnapshot) {
+	value.Destroy()
+}
+
+type FfiContact struct {
+	Method string
+	Info   string
+}
+
+func (r *FfiContact) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Method)
+	FfiDestroyerString{}.Destroy(r.Info)
+}
+
+type FfiConverterFfiContact struct{}
+
+var FfiConverterFfiContactINSTANCE = FfiConverterFfiContact{}
+
+func (c FfiConverterFfiContact) Lift(rb RustBufferI) FfiContact {
+	return LiftFromRustBuffer[FfiContact](c, rb)
+}
+
+func (c FfiConverterFfiContact) Read(reader io.Reader) FfiContact {
+	return FfiContact{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiContact) Lower(value FfiContact) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiContact](c, value)
+}
+
+func (c FfiConverterFfiContact) Write(writer io.Writer, value FfiContact) {
+	FfiConverterStringINSTANCE.Write(writer, value.Method)
+	FfiConverterStringINSTANCE.Write(writer, value.Info)
+}
+
+type FfiDestroyerFfiContact struct{}
+
+func (_ FfiDestroyerFfiContact) Destroy(value FfiContact) {
+	value.Destroy()
+}
+
+type FfiDiagnostics struct {
+	MintUrl        string
+	Unit           string
+	Balance        FfiAmount
+	ProofCount     uint64
+	KeysetIds      []string
+	LibraryVersion string
+	CdkVersion     string
+	RecentErrors   []string
+}
+
+func (r *FfiDiagnostics) Destroy() {
+	FfiDestroyerString{}.Destroy(r.MintUrl)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerFfiAmount{}.Destroy(r.Balance)
+	FfiDestroyerUint64{}.Destroy(r.ProofCount)
+	FfiDestroyerSequenceString{}.Destroy(r.KeysetIds)
+	FfiDestroyerString{}.Destroy(r.LibraryVersion)
+	FfiDestroyerString{}.Destroy(r.CdkVersion)
+	FfiDestroyerSequenceString{}.Destroy(r.RecentErrors)
+}
+
+type FfiConverterFfiDiagnostics struct{}
+
+var FfiConverterFfiDiagnosticsINSTANCE = FfiConverterFfiDiagnostics{}
+
+func (c FfiConverterFfiDiagnostics) Lift(rb RustBufferI) FfiDiagnostics {
+	return LiftFromRustBuffer[FfiDiagnostics](c, rb)
+}
+
+func (c FfiConverterFfiDiagnostics) Read(reader io.Reader) FfiDiagnostics {
+	return FfiDiagnostics{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterSequenceStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterSequenceStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiDiagnostics) Lower(value FfiDiagnostics) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiDiagnostics](c, value)
+}
+
+func (c FfiConverterFfiDiagnostics) Write(writer io.Writer, value FfiDiagnostics) {
+	FfiConverterStringINSTANCE.Write(writer, value.MintUrl)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Balance)
+	FfiConverterUint64INSTANCE.Write(writer, value.ProofCount)
+	FfiConverterSequenceStringINSTANCE.Write(writer, value.KeysetIds)
+	FfiConverterStringINSTANCE.Write(writer, value.LibraryVersion)
+	FfiConverterStringINSTANCE.Write(writer, value.CdkVersion)
+	FfiConverterSequenceStringINSTANCE.Write(writer, value.RecentErrors)
+}
+
+type FfiDestroyerFfiDiagnostics struct{}
+
+func (_ FfiDestroyerFfiDiagnostics) Destroy(value FfiDiagnostics) {
+	value.Destroy()
+}
+
+type FfiKeysetInfo struct {
+	Id          string
+	Unit        string
+	Active      bool
+	InputFeePpk uint64
+}
+
+func (r *FfiKeysetInfo) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Id)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerBool{}.Destroy(r.Active)
+	FfiDestroyerUint64{}.Destroy(r.InputFeePpk)
+}
+
+type FfiConverterFfiKeysetInfo struct{}
+
+var FfiConverterFfiKeysetInfoINSTANCE = FfiConverterFfiKeysetInfo{}
+
+func (c FfiConverterFfiKeysetInfo) Lift(rb RustBufferI) FfiKeysetInfo {
+	return LiftFromRustBuffer[FfiKeysetInfo](c, rb)
+}
+
+func (c FfiConverterFfiKeysetInfo) Read(reader io.Reader) FfiKeysetInfo {
+	return FfiKeysetInfo{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiKeysetInfo) Lower(value FfiKeysetInfo) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiKeysetInfo](c, value)
+}
+
+func (c FfiConverterFfiKeysetInfo) Write(writer io.Writer, value FfiKeysetInfo) {
+	FfiConverterStringINSTANCE.Write(writer, value.Id)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterBoolINSTANCE.Write(writer, value.Active)
+	FfiConverterUint64INSTANCE.Write(writer, value.InputFeePpk)
+}
+
+type FfiDestroyerFfiKeysetInfo struct{}
+
+func (_ FfiDestroyerFfiKeysetInfo) Destroy(value FfiKeysetInfo) {
+	value.Destroy()
+}
+
+type FfiKeysetRefreshResult struct {
+	NewKeysets     uint32
+	ProofsMigrated uint32
+}
+
+func (r *FfiKeysetRefreshResult) Destroy() {
+	FfiDestroyerUint32{}.Destroy(r.NewKeysets)
+	FfiDestroyerUint32{}.Destroy(r.ProofsMigrated)
+}
+
+type FfiConverterFfiKeysetRefreshResult struct{}
+
+var FfiConverterFfiKeysetRefreshResultINSTANCE = FfiConverterFfiKeysetRefreshResult{}
+
+func (c FfiConverterFfiKeysetRefreshResult) Lift(rb RustBufferI) FfiKeysetRefreshResult {
+	return LiftFromRustBuffer[FfiKeysetRefreshResult](c, rb)
+}
+
+func (c FfiConverterFfiKeysetRefreshResult) Read(reader io.Reader) FfiKeysetRefreshResult {
+	return FfiKeysetRefreshResult{
+		FfiConverterUint32INSTANCE.Read(reader),
+		FfiConverterUint32INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiKeysetRefreshResult) Lower(value FfiKeysetRefreshResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiKeysetRefreshResult](c, value)
+}
+
+func (c FfiConverterFfiKeysetRefreshResult) Write(writer io.Writer, value FfiKeysetRefreshResult) {
+	FfiConverterUint32INSTANCE.Write(writer, value.NewKeysets)
+	FfiConverterUint32INSTANCE.Write(writer, value.ProofsMigrated)
+}
+
+type FfiDestroyerFfiKeysetRefreshResult struct{}
+
+func (_ FfiDestroyerFfiKeysetRefreshResult) Destroy(value FfiKeysetRefreshResult) {
+	value.Destroy()
+}
+
+type FfiMeltBatchEntry struct {
+	Index  uint32
+	Melted *FfiMelted
+	Error  *string
+}
+
+func (r *FfiMeltBatchEntry) Destroy() {
+	FfiDestroyerUint32{}.Destroy(r.Index)
+	FfiDestroyerOptionalFfiMelted{}.Destroy(r.Melted)
+	FfiDestroyerOptionalString{}.Destroy(r.Error)
+}
+
+type FfiConverterFfiMeltBatchEntry struct{}
+
+var FfiConverterFfiMeltBatchEntryINSTANCE = FfiConverterFfiMeltBatchEntry{}
+
+func (c FfiConverterFfiMeltBatchEntry) Lift(rb RustBufferI) FfiMeltBatchEntry {
+	return LiftFromRustBuffer[FfiMeltBatchEntry](c, rb)
+}
+
+func (c FfiConverterFfiMeltBatchEntry) Read(reader io.Reader) FfiMeltBatchEntry {
+	return FfiMeltBatchEntry{
+		FfiConverterUint32INSTANCE.Read(reader),
+		FfiConverterOptionalFfiMeltedINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMeltBatchEntry) Lower(value FfiMeltBatchEntry) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMeltBatchEntry](c, value)
+}
+
+func (c FfiConverterFfiMeltBatchEntry) Write(writer io.Writer, value FfiMeltBatchEntry) {
+	FfiConverterUint32INSTANCE.Write(writer, value.Index)
+	FfiConverterOptionalFfiMeltedINSTANCE.Write(writer, value.Melted)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Error)
+}
+
+type FfiDestroyerFfiMeltBatchEntry struct{}
+
+func (_ FfiDestroyerFfiMeltBatchEntry) Destroy(value FfiMeltBatchEntry) {
+	value.Destroy()
+}
+
+type FfiMeltQuote struct {
+	Id              string
+	Unit            string
+	Amount          FfiAmount
+	Request         string
+	FeeReserve      FfiAmount
+	Expiry          uint64
+	PaymentPreimage *string
+	PaymentHash     string
+}
+
+func (r *FfiMeltQuote) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Id)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerString{}.Destroy(r.Request)
+	FfiDestroyerFfiAmount{}.Destroy(r.FeeReserve)
+	FfiDestroyerUint64{}.Destroy(r.Expiry)
+	FfiDestroyerOptionalString{}.Destroy(r.PaymentPreimage)
+	FfiDestroyerString{}.Destroy(r.PaymentHash)
+}
+
+type FfiConverterFfiMeltQuote struct{}
+
+var FfiConverterFfiMeltQuoteINSTANCE = FfiConverterFfiMeltQuote{}
+
+func (c FfiConverterFfiMeltQuote) Lift(rb RustBufferI) FfiMeltQuote {
+	return LiftFromRustBuffer[FfiMeltQuote](c, rb)
+}
+
+func (c FfiConverterFfiMeltQuote) Read(reader io.Reader) FfiMeltQuote {
+	return FfiMeltQuote{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMeltQuote) Lower(value FfiMeltQuote) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMeltQuote](c, value)
+}
+
+func (c FfiConverterFfiMeltQuote) Write(writer io.Writer, value FfiMeltQuote) {
+	FfiConverterStringINSTANCE.Write(writer, value.Id)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterStringINSTANCE.Write(writer, value.Request)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.FeeReserve)
+	FfiConverterUint64INSTANCE.Write(writer, value.Expiry)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.PaymentPreimage)
+	FfiConverterStringINSTANCE.Write(writer, value.PaymentHash)
+}
+
+type FfiDestroyerFfiMeltQuote struct{}
+
+func (_ FfiDestroyerFfiMeltQuote) Destroy(value FfiMeltQuote) {
+	value.Destroy()
+}
+
+type FfiMelted struct {
+	State    string
+	Preimage *string
+	Amount   FfiAmount
+	FeePaid  FfiAmount
+	Change   FfiAmount
+}
+
+func (r *FfiMelted) Destroy() {
+	FfiDestroyerString{}.Destroy(r.State)
+	FfiDestroyerOptionalString{}.Destroy(r.Preimage)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerFfiAmount{}.Destroy(r.FeePaid)
+	FfiDestroyerFfiAmount{}.Destroy(r.Change)
+}
+
+type FfiConverterFfiMelted struct{}
+
+var FfiConverterFfiMeltedINSTANCE = FfiConverterFfiMelted{}
+
+func (c FfiConverterFfiMelted) Lift(rb RustBufferI) FfiMelted {
+	return LiftFromRustBuffer[FfiMelted](c, rb)
+}
+
+func (c FfiConverterFfiMelted) Read(reader io.Reader) FfiMelted {
+	return FfiMelted{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMelted) Lower(value FfiMelted) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMelted](c, value)
+}
+
+func (c FfiConverterFfiMelted) Write(writer io.Writer, value FfiMelted) {
+	FfiConverterStringINSTANCE.Write(writer, value.State)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Preimage)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.FeePaid)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Change)
+}
+
+type FfiDestroyerFfiMelted struct{}
+
+func (_ FfiDestroyerFfiMelted) Destroy(value FfiMelted) {
+	value.Destroy()
+}
+
+type FfiMintAndWaitResult struct {
+	Quote  FfiMintQuote
+	Amount FfiAmount
+}
+
+func (r *FfiMintAndWaitResult) Destroy() {
+	FfiDestroyerFfiMintQuote{}.Destroy(r.Quote)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+}
+
+type FfiConverterFfiMintAndWaitResult struct{}
+
+var FfiConverterFfiMintAndWaitResultINSTANCE = FfiConverterFfiMintAndWaitResult{}
+
+func (c FfiConverterFfiMintAndWaitResult) Lift(rb RustBufferI) FfiMintAndWaitResult {
+	return LiftFromRustBuffer[FfiMintAndWaitResult](c, rb)
+}
+
+func (c FfiConverterFfiMintAndWaitResult) Read(reader io.Reader) FfiMintAndWaitResult {
+	return FfiMintAndWaitResult{
+		FfiConverterFfiMintQuoteINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintAndWaitResult) Lower(value FfiMintAndWaitResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintAndWaitResult](c, value)
+}
+
+func (c FfiConverterFfiMintAndWaitResult) Write(writer io.Writer, value FfiMintAndWaitResult) {
+	FfiConverterFfiMintQuoteINSTANCE.Write(writer, value.Quote)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+}
+
+type FfiDestroyerFfiMintAndWaitResult struct{}
+
+func (_ FfiDestroyerFfiMintAndWaitResult) Destroy(value FfiMintAndWaitResult) {
+	value.Destroy()
+}
+
+type FfiMintInfo struct {
+	Name           *string
+	Pubkey         *string
+	Version        *string
+	Description    *string
+	ContactMethods []FfiContact
+	Nuts           []uint16
+}
+
+func (r *FfiMintInfo) Destroy() {
+	FfiDestroyerOptionalString{}.Destroy(r.Name)
+	FfiDestroyerOptionalString{}.Destroy(r.Pubkey)
+	FfiDestroyerOptionalString{}.Destroy(r.Version)
+	FfiDestroyerOptionalString{}.Destroy(r.Description)
+	FfiDestroyerSequenceFfiContact{}.Destroy(r.ContactMethods)
+	FfiDestroyerSequenceUint16{}.Destroy(r.Nuts)
+}
+
+type FfiConverterFfiMintInfo struct{}
+
+var FfiConverterFfiMintInfoINSTANCE = FfiConverterFfiMintInfo{}
+
+func (c FfiConverterFfiMintInfo) Lift(rb RustBufferI) FfiMintInfo {
+	return LiftFromRustBuffer[FfiMintInfo](c, rb)
+}
+
+func (c FfiConverterFfiMintInfo) Read(reader io.Reader) FfiMintInfo {
+	return FfiMintInfo{
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterSequenceFfiContactINSTANCE.Read(reader),
+		FfiConverterSequenceUint16INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintInfo) Lower(value FfiMintInfo) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintInfo](c, value)
+}
+
+func (c FfiConverterFfiMintInfo) Write(writer io.Writer, value FfiMintInfo) {
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Name)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Pubkey)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Version)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Description)
+	FfiConverterSequenceFfiContactINSTANCE.Write(writer, value.ContactMethods)
+	FfiConverterSequenceUint16INSTANCE.Write(writer, value.Nuts)
+}
+
+type FfiDestroyerFfiMintInfo struct{}
+
+func (_ FfiDestroyerFfiMintInfo) Destroy(value FfiMintInfo) {
+	value.Destroy()
+}
+
+type FfiMintQuote struct {
+	Id      string
+	MintUrl string
+	Amount  FfiAmount
+	Unit    string
+	Request string
+	State   FfiMintQuoteState
+	Expiry  uint64
+}
+
+func (r *FfiMintQuote) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Id)
+	FfiDestroyerString{}.Destroy(r.MintUrl)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerString{}.Destroy(r.Request)
+	FfiDestroyerFfiMintQuoteState{}.Destroy(r.State)
+	FfiDestroyerUint64{}.Destroy(r.Expiry)
+}
+
+type FfiConverterFfiMintQuote struct{}
+
+var FfiConverterFfiMintQuoteINSTANCE = FfiConverterFfiMintQuote{}
+
+func (c FfiConverterFfiMintQuote) Lift(rb RustBufferI) FfiMintQuote {
+	return LiftFromRustBuffer[FfiMintQuote](c, rb)
+}
+
+func (c FfiConverterFfiMintQuote) Read(reader io.Reader) FfiMintQuote {
+	return FfiMintQuote{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiMintQuoteStateINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintQuote) Lower(value FfiMintQuote) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintQuote](c, value)
+}
+
+func (c FfiConverterFfiMintQuote) Write(writer io.Writer, value FfiMintQuote) {
+	FfiConverterStringINSTANCE.Write(writer, value.Id)
+	FfiConverterStringINSTANCE.Write(writer, value.MintUrl)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterStringINSTANCE.Write(writer, value.Request)
+	FfiConverterFfiMintQuoteStateINSTANCE.Write(writer, value.State)
+	FfiConverterUint64INSTANCE.Write(writer, value.Expiry)
+}
+
+type FfiDestroyerFfiMintQuote struct{}
+
+func (_ FfiDestroyerFfiMintQuote) Destroy(value FfiMintQuote) {
+	value.Destroy()
+}
+
+type FfiMintQuoteBolt11Response struct {
+	Quote   string
+	Request string
+	State   FfiMintQuoteState
+	Expiry  *uint64
+}
+
+func (r *FfiMintQuoteBolt11Response) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Quote)
+	FfiDestroyerString{}.Destroy(r.Request)
+	FfiDestroyerFfiMintQuoteState{}.Destroy(r.State)
+	FfiDestroyerOptionalUint64{}.Destroy(r.Expiry)
+}
+
+type FfiConverterFfiMintQuoteBolt11Response struct{}
+
+var FfiConverterFfiMintQuoteBolt11ResponseINSTANCE = FfiConverterFfiMintQuoteBolt11Response{}
+
+func (c FfiConverterFfiMintQuoteBolt11Response) Lift(rb RustBufferI) FfiMintQuoteBolt11Response {
+	return LiftFromRustBuffer[FfiMintQuoteBolt11Response](c, rb)
+}
+
+func (c FfiConverterFfiMintQuoteBolt11Response) Read(reader io.Reader) FfiMintQuoteBolt11Response {
+	return FfiMintQuoteBolt11Response{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiMintQuoteStateINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintQuoteBolt11Response) Lower(value FfiMintQuoteBolt11Response) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintQuoteBolt11Response](c, value)
+}
+
+func (c FfiConverterFfiMintQuoteBolt11Response) Write(writer io.Writer, value FfiMintQuoteBolt11Response) {
+	FfiConverterStringINSTANCE.Write(writer, value.Quote)
+	FfiConverterStringINSTANCE.Write(writer, value.Request)
+	FfiConverterFfiMintQuoteStateINSTANCE.Write(writer, value.State)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.Expiry)
+}
+
+type FfiDestroyerFfiMintQuoteBolt11Response struct{}
+
+func (_ FfiDestroyerFfiMintQuoteBolt11Response) Destroy(value FfiMintQuoteBolt11Response) {
+	value.Destroy()
+}
+
+type FfiMintQuoteOptions struct {
+	Description *string
+	ExpirySecs  *uint64
+	SingleUse   *bool
+}
+
+func (r *FfiMintQuoteOptions) Destroy() {
+	FfiDestroyerOptionalString{}.Destroy(r.Description)
+	FfiDestroyerOptionalUint64{}.Destroy(r.ExpirySecs)
+	FfiDestroyerOptionalBool{}.Destroy(r.SingleUse)
+}
+
+type FfiConverterFfiMintQuoteOptions struct{}
+
+var FfiConverterFfiMintQuoteOptionsINSTANCE = FfiConverterFfiMintQuoteOptions{}
+
+func (c FfiConverterFfiMintQuoteOptions) Lift(rb RustBufferI) FfiMintQuoteOptions {
+	return LiftFromRustBuffer[FfiMintQuoteOptions](c, rb)
+}
+
+func (c FfiConverterFfiMintQuoteOptions) Read(reader io.Reader) FfiMintQuoteOptions {
+	return FfiMintQuoteOptions{
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+		FfiConverterOptionalBoolINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintQuoteOptions) Lower(value FfiMintQuoteOptions) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintQuoteOptions](c, value)
+}
+
+func (c FfiConverterFfiMintQuoteOptions) Write(writer io.Writer, value FfiMintQuoteOptions) {
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Description)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.ExpirySecs)
+	FfiConverterOptionalBoolINSTANCE.Write(writer, value.SingleUse)
+}
+
+type FfiDestroyerFfiMintQuoteOptions struct{}
+
+func (_ FfiDestroyerFfiMintQuoteOptions) Destroy(value FfiMintQuoteOptions) {
+	value.Destroy()
+}
+
+type FfiMintResult struct {
+	Total   FfiAmount
+	Amounts []FfiAmount
+}
+
+func (r *FfiMintResult) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Total)
+	FfiDestroyerSequenceFfiAmount{}.Destroy(r.Amounts)
+}
+
+type FfiConverterFfiMintResult struct{}
+
+var FfiConverterFfiMintResultINSTANCE = FfiConverterFfiMintResult{}
+
+func (c FfiConverterFfiMintResult) Lift(rb RustBufferI) FfiMintResult {
+	return LiftFromRustBuffer[FfiMintResult](c, rb)
+}
+
+func (c FfiConverterFfiMintResult) Read(reader io.Reader) FfiMintResult {
+	return FfiMintResult{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterSequenceFfiAmountINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiMintResult) Lower(value FfiMintResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintResult](c, value)
+}
+
+func (c FfiConverterFfiMintResult) Write(writer io.Writer, value FfiMintResult) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Total)
+	FfiConverterSequenceFfiAmountINSTANCE.Write(writer, value.Amounts)
+}
+
+type FfiDestroyerFfiMintResult struct{}
+
+func (_ FfiDestroyerFfiMintResult) Destroy(value FfiMintResult) {
+	value.Destroy()
+}
+
+type FfiNetFlow struct {
+	TotalIn   FfiAmount
+	TotalOut  FfiAmount
+	TotalFees FfiAmount
+	Net       int64
+}
+
+func (r *FfiNetFlow) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.TotalIn)
+	FfiDestroyerFfiAmount{}.Destroy(r.TotalOut)
+	FfiDestroyerFfiAmount{}.Destroy(r.TotalFees)
+	FfiDestroyerInt64{}.Destroy(r.Net)
+}
+
+type FfiConverterFfiNetFlow struct{}
+
+var FfiConverterFfiNetFlowINSTANCE = FfiConverterFfiNetFlow{}
+
+func (c FfiConverterFfiNetFlow) Lift(rb RustBufferI) FfiNetFlow {
+	return LiftFromRustBuffer[FfiNetFlow](c, rb)
+}
+
+func (c FfiConverterFfiNetFlow) Read(reader io.Reader) FfiNetFlow {
+	return FfiNetFlow{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterInt64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiNetFlow) Lower(value FfiNetFlow) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiNetFlow](c, value)
+}
+
+func (c FfiConverterFfiNetFlow) Write(writer io.Writer, value FfiNetFlow) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.TotalIn)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.TotalOut)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.TotalFees)
+	FfiConverterInt64INSTANCE.Write(writer, value.Net)
+}
+
+type FfiDestroyerFfiNetFlow struct{}
+
+func (_ FfiDestroyerFfiNetFlow) Destroy(value FfiNetFlow) {
+	value.Destroy()
+}
+
+type FfiOperationAvailability struct {
+	Mint bool
+	Melt bool
+	Swap bool
+}
+
+func (r *FfiOperationAvailability) Destroy() {
+	FfiDestroyerBool{}.Destroy(r.Mint)
+	FfiDestroyerBool{}.Destroy(r.Melt)
+	FfiDestroyerBool{}.Destroy(r.Swap)
+}
+
+type FfiConverterFfiOperationAvailability struct{}
+
+var FfiConverterFfiOperationAvailabilityINSTANCE = FfiConverterFfiOperationAvailability{}
+
+func (c FfiConverterFfiOperationAvailability) Lift(rb RustBufferI) FfiOperationAvailability {
+	return LiftFromRustBuffer[FfiOperationAvailability](c, rb)
+}
+
+func (c FfiConverterFfiOperationAvailability) Read(reader io.Reader) FfiOperationAvailability {
+	return FfiOperationAvailability{
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiOperationAvailability) Lower(value FfiOperationAvailability) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiOperationAvailability](c, value)
+}
+
+func (c FfiConverterFfiOperationAvailability) Write(writer io.Writer, value FfiOperationAvailability) {
+	FfiConverterBoolINSTANCE.Write(writer, value.Mint)
+	FfiConverterBoolINSTANCE.Write(writer, value.Melt)
+	FfiConverterBoolINSTANCE.Write(writer, value.Swap)
+}
+
+type FfiDestroyerFfiOperationAvailability struct{}
+
+func (_ FfiDestroyerFfiOperationAvailability) Destroy(value FfiOperationAvailability) {
+	value.Destroy()
+}
+
+type FfiPaymentRequest struct {
+	PaymentId   *string
+	Amount      *FfiAmount
+	Unit        *string
+	SingleUse   *bool
+	Mints       []string
+	Description *string
+	Transports  []FfiTransport
+}
+
+func (r *FfiPaymentRequest) Destroy() {
+	FfiDestroyerOptionalString{}.Destroy(r.PaymentId)
+	FfiDestroyerOptionalFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerOptionalString{}.Destroy(r.Unit)
+	FfiDestroyerOptionalBool{}.Destroy(r.SingleUse)
+	FfiDestroyerSequenceString{}.Destroy(r.Mints)
+	FfiDestroyerOptionalString{}.Destroy(r.Description)
+	FfiDestroyerSequenceFfiTransport{}.Destroy(r.Transports)
+}
+
+type FfiConverterFfiPaymentRequest struct{}
+
+var FfiConverterFfiPaymentRequestINSTANCE = FfiConverterFfiPaymentRequest{}
+
+func (c FfiConverterFfiPaymentRequest) Lift(rb RustBufferI) FfiPaymentRequest {
+	return LiftFromRustBuffer[FfiPaymentRequest](c, rb)
+}
+
+func (c FfiConverterFfiPaymentRequest) Read(reader io.Reader) FfiPaymentRequest {
+	return FfiPaymentRequest{
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalFfiAmountINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalBoolINSTANCE.Read(reader),
+		FfiConverterSequenceStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterSequenceFfiTransportINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiPaymentRequest) Lower(value FfiPaymentRequest) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiPaymentRequest](c, value)
+}
+
+func (c FfiConverterFfiPaymentRequest) Write(writer io.Writer, value FfiPaymentRequest) {
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.PaymentId)
+	FfiConverterOptionalFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterOptionalBoolINSTANCE.Write(writer, value.SingleUse)
+	FfiConverterSequenceStringINSTANCE.Write(writer, value.Mints)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Description)
+	FfiConverterSequenceFfiTransportINSTANCE.Write(writer, value.Transports)
+}
+
+type FfiDestroyerFfiPaymentRequest struct{}
+
+func (_ FfiDestroyerFfiPaymentRequest) Destroy(value FfiPaymentRequest) {
+	value.Destroy()
+}
+
+type FfiPreparedSend struct {
+	Amount        FfiAmount
+	SwapFee       FfiAmount
+	SendFee       FfiAmount
+	TotalFee      FfiAmount
+	ProofCount    uint32
+	RequiresSwap  bool
+	InputFee      FfiAmount
+	ReservationId string
+}
+
+func (r *FfiPreparedSend) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerFfiAmount{}.Destroy(r.SwapFee)
+	FfiDestroyerFfiAmount{}.Destroy(r.SendFee)
+	FfiDestroyerFfiAmount{}.Destroy(r.TotalFee)
+	FfiDestroyerUint32{}.Destroy(r.ProofCount)
+	FfiDestroyerBool{}.Destroy(r.RequiresSwap)
+	FfiDestroyerFfiAmount{}.Destroy(r.InputFee)
+	FfiDestroyerString{}.Destroy(r.ReservationId)
+}
+
+type FfiConverterFfiPreparedSend struct{}
+
+var FfiConverterFfiPreparedSendINSTANCE = FfiConverterFfiPreparedSend{}
+
+func (c FfiConverterFfiPreparedSend) Lift(rb RustBufferI) FfiPreparedSend {
+	return LiftFromRustBuffer[FfiPreparedSend](c, rb)
+}
+
+func (c FfiConverterFfiPreparedSend) Read(reader io.Reader) FfiPreparedSend {
+	return FfiPreparedSend{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterUint32INSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiPreparedSend) Lower(value FfiPreparedSend) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiPreparedSend](c, value)
+}
+
+func (c FfiConverterFfiPreparedSend) Write(writer io.Writer, value FfiPreparedSend) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.SwapFee)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.SendFee)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.TotalFee)
+	FfiConverterUint32INSTANCE.Write(writer, value.ProofCount)
+	FfiConverterBoolINSTANCE.Write(writer, value.RequiresSwap)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.InputFee)
+	FfiConverterStringINSTANCE.Write(writer, value.ReservationId)
+}
+
+type FfiDestroyerFfiPreparedSend struct{}
+
+func (_ FfiDestroyerFfiPreparedSend) Destroy(value FfiPreparedSend) {
+	value.Destroy()
+}
+
+type FfiProof struct {
+	Amount   FfiAmount
+	KeysetId string
+	Secret   string
+	C        string
+	State    string
+}
+
+func (r *FfiProof) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerString{}.Destroy(r.KeysetId)
+	FfiDestroyerString{}.Destroy(r.Secret)
+	FfiDestroyerString{}.Destroy(r.C)
+	FfiDestroyerString{}.Destroy(r.State)
+}
+
+type FfiConverterFfiProof struct{}
+
+var FfiConverterFfiProofINSTANCE = FfiConverterFfiProof{}
+
+func (c FfiConverterFfiProof) Lift(rb RustBufferI) FfiProof {
+	return LiftFromRustBuffer[FfiProof](c, rb)
+}
+
+func (c FfiConverterFfiProof) Read(reader io.Reader) FfiProof {
+	return FfiProof{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiProof) Lower(value FfiProof) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiProof](c, value)
+}
+
+func (c FfiConverterFfiProof) Write(writer io.Writer, value FfiProof) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterStringINSTANCE.Write(writer, value.KeysetId)
+	FfiConverterStringINSTANCE.Write(writer, value.Secret)
+	FfiConverterStringINSTANCE.Write(writer, value.C)
+	FfiConverterStringINSTANCE.Write(writer, value.State)
+}
+
+type FfiDestroyerFfiProof struct{}
+
+func (_ FfiDestroyerFfiProof) Destroy(value FfiProof) {
+	value.Destroy()
+}
+
+type FfiProofState struct {
+	Y       string
+	State   FfiState
+	Witness *string
+}
+
+func (r *FfiProofState) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Y)
+	FfiDestroyerFfiState{}.Destroy(r.State)
+	FfiDestroyerOptionalString{}.Destroy(r.Witness)
+}
+
+type FfiConverterFfiProofState struct{}
+
+var FfiConverterFfiProofStateINSTANCE = FfiConverterFfiProofState{}
+
+func (c FfiConverterFfiProofState) Lift(rb RustBufferI) FfiProofState {
+	return LiftFromRustBuffer[FfiProofState](c, rb)
+}
+
+func (c FfiConverterFfiProofState) Read(reader io.Reader) FfiProofState {
+	return FfiProofState{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiStateINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiProofState) Lower(value FfiProofState) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiProofState](c, value)
+}
+
+func (c FfiConverterFfiProofState) Write(writer io.Writer, value FfiProofState) {
+	FfiConverterStringINSTANCE.Write(writer, value.Y)
+	FfiConverterFfiStateINSTANCE.Write(writer, value.State)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Witness)
+}
+
+type FfiDestroyerFfiProofState struct{}
+
+func (_ FfiDestroyerFfiProofState) Destroy(value FfiProofState) {
+	value.Destroy()
+}
+
+type FfiQuoteExpiry struct {
+	QuoteId   string
+	Kind      FfiQuoteKind
+	ExpiresIn uint64
+}
+
+func (r *FfiQuoteExpiry) Destroy() {
+	FfiDestroyerString{}.Destroy(r.QuoteId)
+	FfiDestroyerFfiQuoteKind{}.Destroy(r.Kind)
+	FfiDestroyerUint64{}.Destroy(r.ExpiresIn)
+}
+
+type FfiConverterFfiQuoteExpiry struct{}
+
+var FfiConverterFfiQuoteExpiryINSTANCE = FfiConverterFfiQuoteExpiry{}
+
+func (c FfiConverterFfiQuoteExpiry) Lift(rb RustBufferI) FfiQuoteExpiry {
+	return LiftFromRustBuffer[FfiQuoteExpiry](c, rb)
+}
+
+func (c FfiConverterFfiQuoteExpiry) Read(reader io.Reader) FfiQuoteExpiry {
+	return FfiQuoteExpiry{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiQuoteKindINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiQuoteExpiry) Lower(value FfiQuoteExpiry) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiQuoteExpiry](c, value)
+}
+
+func (c FfiConverterFfiQuoteExpiry) Write(writer io.Writer, value FfiQuoteExpiry) {
+	FfiConverterStringINSTANCE.Write(writer, value.QuoteId)
+	FfiConverterFfiQuoteKindINSTANCE.Write(writer, value.Kind)
+	FfiConverterUint64INSTANCE.Write(writer, value.ExpiresIn)
+}
+
+type FfiDestroyerFfiQuoteExpiry struct{}
+
+func (_ FfiDestroyerFfiQuoteExpiry) Destroy(value FfiQuoteExpiry) {
+	value.Destroy()
+}
+
+type FfiReceiveBatchResult struct {
+	Amount FfiAmount
+	Failed []FfiReceiveError
+}
+
+func (r *FfiReceiveBatchResult) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerSequenceFfiReceiveError{}.Destroy(r.Failed)
+}
+
+type FfiConverterFfiReceiveBatchResult struct{}
+
+var FfiConverterFfiReceiveBatchResultINSTANCE = FfiConverterFfiReceiveBatchResult{}
+
+func (c FfiConverterFfiReceiveBatchResult) Lift(rb RustBufferI) FfiReceiveBatchResult {
+	return LiftFromRustBuffer[FfiReceiveBatchResult](c, rb)
+}
+
+func (c FfiConverterFfiReceiveBatchResult) Read(reader io.Reader) FfiReceiveBatchResult {
+	return FfiReceiveBatchResult{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterSequenceFfiReceiveErrorINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiReceiveBatchResult) Lower(value FfiReceiveBatchResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiReceiveBatchResult](c, value)
+}
+
+func (c FfiConverterFfiReceiveBatchResult) Write(writer io.Writer, value FfiReceiveBatchResult) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterSequenceFfiReceiveErrorINSTANCE.Write(writer, value.Failed)
+}
+
+type FfiDestroyerFfiReceiveBatchResult struct{}
+
+func (_ FfiDestroyerFfiReceiveBatchResult) Destroy(value FfiReceiveBatchResult) {
+	value.Destroy()
+}
+
+type FfiReceiveError struct {
+	Index uint32
+	Error string
+}
+
+func (r *FfiReceiveError) Destroy() {
+	FfiDestroyerUint32{}.Destroy(r.Index)
+	FfiDestroyerString{}.Destroy(r.Error)
+}
+
+type FfiConverterFfiReceiveError struct{}
+
+var FfiConverterFfiReceiveErrorINSTANCE = FfiConverterFfiReceiveError{}
+
+func (c FfiConverterFfiReceiveError) Lift(rb RustBufferI) FfiReceiveError {
+	return LiftFromRustBuffer[FfiReceiveError](c, rb)
+}
+
+func (c FfiConverterFfiReceiveError) Read(reader io.Reader) FfiReceiveError {
+	return FfiReceiveError{
+		FfiConverterUint32INSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiReceiveError) Lower(value FfiReceiveError) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiReceiveError](c, value)
+}
+
+func (c FfiConverterFfiReceiveError) Write(writer io.Writer, value FfiReceiveError) {
+	FfiConverterUint32INSTANCE.Write(writer, value.Index)
+	FfiConverterStringINSTANCE.Write(writer, value.Error)
+}
+
+type FfiDestroyerFfiReceiveError struct{}
+
+func (_ FfiDestroyerFfiReceiveError) Destroy(value FfiReceiveError) {
+	value.Destroy()
+}
+
+type FfiReceiveOptions struct {
+	AmountSplitTarget FfiSplitTarget
+	Idempotent        bool
+	TrustUnswapped    bool
+	P2pkSigningKeys   []string
+	Preimages         []string
+	RequireDleq       bool
+}
+
+func (r *FfiReceiveOptions) Destroy() {
+	FfiDestroyerFfiSplitTarget{}.Destroy(r.AmountSplitTarget)
+	FfiDestroyerBool{}.Destroy(r.Idempotent)
+	FfiDestroyerBool{}.Destroy(r.TrustUnswapped)
+	FfiDestroyerSequenceString{}.Destroy(r.P2pkSigningKeys)
+	FfiDestroyerSequenceString{}.Destroy(r.Preimages)
+	FfiDestroyerBool{}.Destroy(r.RequireDleq)
+}
+
+type FfiConverterFfiReceiveOptions struct{}
+
+var FfiConverterFfiReceiveOptionsINSTANCE = FfiConverterFfiReceiveOptions{}
+
+func (c FfiConverterFfiReceiveOptions) Lift(rb RustBufferI) FfiReceiveOptions {
+	return LiftFromRustBuffer[FfiReceiveOptions](c, rb)
+}
+
+func (c FfiConverterFfiReceiveOptions) Read(reader io.Reader) FfiReceiveOptions {
+	return FfiReceiveOptions{
+		FfiConverterFfiSplitTargetINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterSequenceStringINSTANCE.Read(reader),
+		FfiConverterSequenceStringINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiReceiveOptions) Lower(value FfiReceiveOptions) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiReceiveOptions](c, value)
+}
+
+func (c FfiConverterFfiReceiveOptions) Write(writer io.Writer, value FfiReceiveOptions) {
+	FfiConverterFfiSplitTargetINSTANCE.Write(writer, value.AmountSplitTarget)
+	FfiConverterBoolINSTANCE.Write(writer, value.Idempotent)
+	FfiConverterBoolINSTANCE.Write(writer, value.TrustUnswapped)
+	FfiConverterSequenceStringINSTANCE.Write(writer, value.P2pkSigningKeys)
+	FfiConverterSequenceStringINSTANCE.Write(writer, value.Preimages)
+	FfiConverterBoolINSTANCE.Write(writer, value.RequireDleq)
+}
+
+type FfiDestroyerFfiReceiveOptions struct{}
+
+func (_ FfiDestroyerFfiReceiveOptions) Destroy(value FfiReceiveOptions) {
+	value.Destroy()
+}
+
+type FfiReceiveResult struct {
+	Amount        FfiAmount
+	Memo          *string
+	Unit          string
+	LockingPubkey *string
+}
+
+func (r *FfiReceiveResult) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerOptionalString{}.Destroy(r.Memo)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerOptionalString{}.Destroy(r.LockingPubkey)
+}
+
+type FfiConverterFfiReceiveResult struct{}
+
+var FfiConverterFfiReceiveResultINSTANCE = FfiConverterFfiReceiveResult{}
+
+func (c FfiConverterFfiReceiveResult) Lift(rb RustBufferI) FfiReceiveResult {
+	return LiftFromRustBuffer[FfiReceiveResult](c, rb)
+}
+
+func (c FfiConverterFfiReceiveResult) Read(reader io.Reader) FfiReceiveResult {
+	return FfiReceiveResult{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiReceiveResult) Lower(value FfiReceiveResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiReceiveResult](c, value)
+}
+
+func (c FfiConverterFfiReceiveResult) Write(writer io.Writer, value FfiReceiveResult) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Memo)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.LockingPubkey)
+}
+
+type FfiDestroyerFfiReceiveResult struct{}
+
+func (_ FfiDestroyerFfiReceiveResult) Destroy(value FfiReceiveResult) {
+	value.Destroy()
+}
+
+type FfiReconcileResult struct {
+	BalanceBefore  FfiAmount
+	BalanceAfter   FfiAmount
+	ProofsRemoved  uint64
+	ProofsRestored uint64
+}
+
+func (r *FfiReconcileResult) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(r.BalanceBefore)
+	FfiDestroyerFfiAmount{}.Destroy(r.BalanceAfter)
+	FfiDestroyerUint64{}.Destroy(r.ProofsRemoved)
+	FfiDestroyerUint64{}.Destroy(r.ProofsRestored)
+}
+
+type FfiConverterFfiReconcileResult struct{}
+
+var FfiConverterFfiReconcileResultINSTANCE = FfiConverterFfiReconcileResult{}
+
+func (c FfiConverterFfiReconcileResult) Lift(rb RustBufferI) FfiReconcileResult {
+	return LiftFromRustBuffer[FfiReconcileResult](c, rb)
+}
+
+func (c FfiConverterFfiReconcileResult) Read(reader io.Reader) FfiReconcileResult {
+	return FfiReconcileResult{
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiReconcileResult) Lower(value FfiReconcileResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiReconcileResult](c, value)
+}
+
+func (c FfiConverterFfiReconcileResult) Write(writer io.Writer, value FfiReconcileResult) {
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.BalanceBefore)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.BalanceAfter)
+	FfiConverterUint64INSTANCE.Write(writer, value.ProofsRemoved)
+	FfiConverterUint64INSTANCE.Write(writer, value.ProofsRestored)
+}
+
+type FfiDestroyerFfiReconcileResult struct{}
+
+func (_ FfiDestroyerFfiReconcileResult) Destroy(value FfiReconcileResult) {
+	value.Destroy()
+}
+
+type FfiSendMemo struct {
+	Memo        string
+	IncludeMemo bool
+}
+
+func (r *FfiSendMemo) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Memo)
+	FfiDestroyerBool{}.Destroy(r.IncludeMemo)
+}
+
+type FfiConverterFfiSendMemo struct{}
+
+var FfiConverterFfiSendMemoINSTANCE = FfiConverterFfiSendMemo{}
+
+func (c FfiConverterFfiSendMemo) Lift(rb RustBufferI) FfiSendMemo {
+	return LiftFromRustBuffer[FfiSendMemo](c, rb)
+}
+
+func (c FfiConverterFfiSendMemo) Read(reader io.Reader) FfiSendMemo {
+	return FfiSendMemo{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiSendMemo) Lower(value FfiSendMemo) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiSendMemo](c, value)
+}
+
+func (c FfiConverterFfiSendMemo) Write(writer io.Writer, value FfiSendMemo) {
+	FfiConverterStringINSTANCE.Write(writer, value.Memo)
+	FfiConverterBoolINSTANCE.Write(writer, value.IncludeMemo)
+}
+
+type FfiDestroyerFfiSendMemo struct{}
+
+func (_ FfiDestroyerFfiSendMemo) Destroy(value FfiSendMemo) {
+	value.Destroy()
+}
+
+type FfiSendOptions struct {
+	Memo                   *FfiSendMemo
+	AmountSplitTarget      FfiSplitTarget
+	SendKind               FfiSendKind
+	IncludeFee             bool
+	Metadata               map[string]string
+	MaxProofs              *uint64
+	ProofSelection         FfiProofSelection
+	Pubkey                 *string
+	LocktimeSecs           *uint64
+	RefundPubkey           *string
+	TokenVersion           FfiTokenVersion
+	DryRun                 bool
+	HtlcHash               *string
+	HtlcLocktime           *uint64
+	PreferredDenominations []uint64
+}
+
+func (r *FfiSendOptions) Destroy() {
+	FfiDestroyerOptionalFfiSendMemo{}.Destroy(r.Memo)
+	FfiDestroyerFfiSplitTarget{}.Destroy(r.AmountSplitTarget)
+	FfiDestroyerFfiSendKind{}.Destroy(r.SendKind)
+	FfiDestroyerBool{}.Destroy(r.IncludeFee)
+	FfiDestroyerMapStringString{}.Destroy(r.Metadata)
+	FfiDestroyerOptionalUint64{}.Destroy(r.MaxProofs)
+	FfiDestroyerFfiProofSelection{}.Destroy(r.ProofSelection)
+	FfiDestroyerOptionalString{}.Destroy(r.Pubkey)
+	FfiDestroyerOptionalUint64{}.Destroy(r.LocktimeSecs)
+	FfiDestroyerOptionalString{}.Destroy(r.RefundPubkey)
+	FfiDestroyerFfiTokenVersion{}.Destroy(r.TokenVersion)
+	FfiDestroyerBool{}.Destroy(r.DryRun)
+	FfiDestroyerOptionalString{}.Destroy(r.HtlcHash)
+	FfiDestroyerOptionalUint64{}.Destroy(r.HtlcLocktime)
+	FfiDestroyerSequenceUint64{}.Destroy(r.PreferredDenominations)
+}
+
+type FfiConverterFfiSendOptions struct{}
+
+var FfiConverterFfiSendOptionsINSTANCE = FfiConverterFfiSendOptions{}
+
+func (c FfiConverterFfiSendOptions) Lift(rb RustBufferI) FfiSendOptions {
+	return LiftFromRustBuffer[FfiSendOptions](c, rb)
+}
+
+func (c FfiConverterFfiSendOptions) Read(reader io.Reader) FfiSendOptions {
+	return FfiSendOptions{
+		FfiConverterOptionalFfiSendMemoINSTANCE.Read(reader),
+		FfiConverterFfiSplitTargetINSTANCE.Read(reader),
+		FfiConverterFfiSendKindINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterMapStringStringINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+		FfiConverterFfiProofSelectionINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterFfiTokenVersionINSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+		FfiConverterSequenceUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiSendOptions) Lower(value FfiSendOptions) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiSendOptions](c, value)
+}
+
+func (c FfiConverterFfiSendOptions) Write(writer io.Writer, value FfiSendOptions) {
+	FfiConverterOptionalFfiSendMemoINSTANCE.Write(writer, value.Memo)
+	FfiConverterFfiSplitTargetINSTANCE.Write(writer, value.AmountSplitTarget)
+	FfiConverterFfiSendKindINSTANCE.Write(writer, value.SendKind)
+	FfiConverterBoolINSTANCE.Write(writer, value.IncludeFee)
+	FfiConverterMapStringStringINSTANCE.Write(writer, value.Metadata)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.MaxProofs)
+	FfiConverterFfiProofSelectionINSTANCE.Write(writer, value.ProofSelection)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Pubkey)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.LocktimeSecs)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.RefundPubkey)
+	FfiConverterFfiTokenVersionINSTANCE.Write(writer, value.TokenVersion)
+	FfiConverterBoolINSTANCE.Write(writer, value.DryRun)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.HtlcHash)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.HtlcLocktime)
+	FfiConverterSequenceUint64INSTANCE.Write(writer, value.PreferredDenominations)
+}
+
+type FfiDestroyerFfiSendOptions struct{}
+
+func (_ FfiDestroyerFfiSendOptions) Destroy(value FfiSendOptions) {
+	value.Destroy()
+}
+
+type FfiToken struct {
+	TokenString string
+	Mint        string
+	Memo        *string
+	Unit        string
+	Amount      FfiAmount
+	Conditions  FfiTokenConditions
+}
+
+func (r *FfiToken) Destroy() {
+	FfiDestroyerString{}.Destroy(r.TokenString)
+	FfiDestroyerString{}.Destroy(r.Mint)
+	FfiDestroyerOptionalString{}.Destroy(r.Memo)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerFfiTokenConditions{}.Destroy(r.Conditions)
+}
+
+type FfiConverterFfiToken struct{}
+
+var FfiConverterFfiTokenINSTANCE = FfiConverterFfiToken{}
+
+func (c FfiConverterFfiToken) Lift(rb RustBufferI) FfiToken {
+	return LiftFromRustBuffer[FfiToken](c, rb)
+}
+
+func (c FfiConverterFfiToken) Read(reader io.Reader) FfiToken {
+	return FfiToken{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterFfiTokenConditionsINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiToken) Lower(value FfiToken) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiToken](c, value)
+}
+
+func (c FfiConverterFfiToken) Write(writer io.Writer, value FfiToken) {
+	FfiConverterStringINSTANCE.Write(writer, value.TokenString)
+	FfiConverterStringINSTANCE.Write(writer, value.Mint)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Memo)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterFfiTokenConditionsINSTANCE.Write(writer, value.Conditions)
+}
+
+type FfiDestroyerFfiToken struct{}
+
+func (_ FfiDestroyerFfiToken) Destroy(value FfiToken) {
+	value.Destroy()
+}
+
+type FfiTokenConditions struct {
+	SpendingCondition FfiSpendingCondition
+	Pubkey            *string
+	Hash              *string
+	Locktime          *uint64
+}
+
+func (r *FfiTokenConditions) Destroy() {
+	FfiDestroyerFfiSpendingCondition{}.Destroy(r.SpendingCondition)
+	FfiDestroyerOptionalString{}.Destroy(r.Pubkey)
+	FfiDestroyerOptionalString{}.Destroy(r.Hash)
+	FfiDestroyerOptionalUint64{}.Destroy(r.Locktime)
+}
+
+type FfiConverterFfiTokenConditions struct{}
+
+var FfiConverterFfiTokenConditionsINSTANCE = FfiConverterFfiTokenConditions{}
+
+func (c FfiConverterFfiTokenConditions) Lift(rb RustBufferI) FfiTokenConditions {
+	return LiftFromRustBuffer[FfiTokenConditions](c, rb)
+}
+
+func (c FfiConverterFfiTokenConditions) Read(reader io.Reader) FfiTokenConditions {
+	return FfiTokenConditions{
+		FfiConverterFfiSpendingConditionINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterOptionalUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiTokenConditions) Lower(value FfiTokenConditions) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTokenConditions](c, value)
+}
+
+func (c FfiConverterFfiTokenConditions) Write(writer io.Writer, value FfiTokenConditions) {
+	FfiConverterFfiSpendingConditionINSTANCE.Write(writer, value.SpendingCondition)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Pubkey)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Hash)
+	FfiConverterOptionalUint64INSTANCE.Write(writer, value.Locktime)
+}
+
+type FfiDestroyerFfiTokenConditions struct{}
+
+func (_ FfiDestroyerFfiTokenConditions) Destroy(value FfiTokenConditions) {
+	value.Destroy()
+}
+
+type FfiTransaction struct {
+	Id        string
+	Direction FfiTransactionDirection
+	Amount    FfiAmount
+	Unit      string
+	Timestamp uint64
+	Memo      *string
+	MintUrl   string
+}
+
+func (r *FfiTransaction) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Id)
+	FfiDestroyerFfiTransactionDirection{}.Destroy(r.Direction)
+	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
+	FfiDestroyerString{}.Destroy(r.Unit)
+	FfiDestroyerUint64{}.Destroy(r.Timestamp)
+	FfiDestroyerOptionalString{}.Destroy(r.Memo)
+	FfiDestroyerString{}.Destroy(r.MintUrl)
+}
+
+type FfiConverterFfiTransaction struct{}
+
+var FfiConverterFfiTransactionINSTANCE = FfiConverterFfiTransaction{}
+
+func (c FfiConverterFfiTransaction) Lift(rb RustBufferI) FfiTransaction {
+	return LiftFromRustBuffer[FfiTransaction](c, rb)
+}
+
+func (c FfiConverterFfiTransaction) Read(reader io.Reader) FfiTransaction {
+	return FfiTransaction{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterFfiTransactionDirectionINSTANCE.Read(reader),
+		FfiConverterFfiAmountINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiTransaction) Lower(value FfiTransaction) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransaction](c, value)
+}
+
+func (c FfiConverterFfiTransaction) Write(writer io.Writer, value FfiTransaction) {
+	FfiConverterStringINSTANCE.Write(writer, value.Id)
+	FfiConverterFfiTransactionDirectionINSTANCE.Write(writer, value.Direction)
+	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
+	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+	FfiConverterUint64INSTANCE.Write(writer, value.Timestamp)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Memo)
+	FfiConverterStringINSTANCE.Write(writer, value.MintUrl)
+}
+
+type FfiDestroyerFfiTransaction struct{}
+
+func (_ FfiDestroyerFfiTransaction) Destroy(value FfiTransaction) {
+	value.Destroy()
+}
+
+type FfiTransactionFilter struct {
+	Direction *FfiTransactionDirection
+	Unit      *string
+}
+
+func (r *FfiTransactionFilter) Destroy() {
+	FfiDestroyerOptionalFfiTransactionDirection{}.Destroy(r.Direction)
+	FfiDestroyerOptionalString{}.Destroy(r.Unit)
+}
+
+type FfiConverterFfiTransactionFilter struct{}
+
+var FfiConverterFfiTransactionFilterINSTANCE = FfiConverterFfiTransactionFilter{}
+
+func (c FfiConverterFfiTransactionFilter) Lift(rb RustBufferI) FfiTransactionFilter {
+	return LiftFromRustBuffer[FfiTransactionFilter](c, rb)
+}
+
+func (c FfiConverterFfiTransactionFilter) Read(reader io.Reader) FfiTransactionFilter {
+	return FfiTransactionFilter{
+		FfiConverterOptionalFfiTransactionDirectionINSTANCE.Read(reader),
+		FfiConverterOptionalStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiTransactionFilter) Lower(value FfiTransactionFilter) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransactionFilter](c, value)
+}
+
+func (c FfiConverterFfiTransactionFilter) Write(writer io.Writer, value FfiTransactionFilter) {
+	FfiConverterOptionalFfiTransactionDirectionINSTANCE.Write(writer, value.Direction)
+	FfiConverterOptionalStringINSTANCE.Write(writer, value.Unit)
+}
+
+type FfiDestroyerFfiTransactionFilter struct{}
+
+func (_ FfiDestroyerFfiTransactionFilter) Destroy(value FfiTransactionFilter) {
+	value.Destroy()
+}
+
+type FfiTransport struct {
+	TransportType FfiTransportType
+	Target        string
+	Tags          [][]string
+}
+
+func (r *FfiTransport) Destroy() {
+	FfiDestroyerFfiTransportType{}.Destroy(r.TransportType)
+	FfiDestroyerString{}.Destroy(r.Target)
+	FfiDestroyerSequenceSequenceString{}.Destroy(r.Tags)
+}
+
+type FfiConverterFfiTransport struct{}
+
+var FfiConverterFfiTransportINSTANCE = FfiConverterFfiTransport{}
+
+func (c FfiConverterFfiTransport) Lift(rb RustBufferI) FfiTransport {
+	return LiftFromRustBuffer[FfiTransport](c, rb)
+}
+
+func (c FfiConverterFfiTransport) Read(reader io.Reader) FfiTransport {
+	return FfiTransport{
+		FfiConverterFfiTransportTypeINSTANCE.Read(reader),
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterSequenceSequenceStringINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiTransport) Lower(value FfiTransport) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransport](c, value)
+}
+
+func (c FfiConverterFfiTransport) Write(writer io.Writer, value FfiTransport) {
+	FfiConverterFfiTransportTypeINSTANCE.Write(writer, value.TransportType)
+	FfiConverterStringINSTANCE.Write(writer, value.Target)
+	FfiConverterSequenceSequenceStringINSTANCE.Write(writer, value.Tags)
+}
+
+type FfiDestroyerFfiTransport struct{}
+
+func (_ FfiDestroyerFfiTransport) Destroy(value FfiTransport) {
+	value.Destroy()
+}
+
+type FfiVacuumResult struct {
+	ProofsRemoved uint64
+	QuotesRemoved uint64
+}
+
+func (r *FfiVacuumResult) Destroy() {
+	FfiDestroyerUint64{}.Destroy(r.ProofsRemoved)
+	FfiDestroyerUint64{}.Destroy(r.QuotesRemoved)
+}
+
+type FfiConverterFfiVacuumResult struct{}
+
+var FfiConverterFfiVacuumResultINSTANCE = FfiConverterFfiVacuumResult{}
+
+func (c FfiConverterFfiVacuumResult) Lift(rb RustBufferI) FfiVacuumResult {
+	return LiftFromRustBuffer[FfiVacuumResult](c, rb)
+}
+
+func (c FfiConverterFfiVacuumResult) Read(reader io.Reader) FfiVacuumResult {
+	return FfiVacuumResult{
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterFfiVacuumResult) Lower(value FfiVacuumResult) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiVacuumResult](c, value)
+}
+
+func (c FfiConverterFfiVacuumResult) Write(writer io.Writer, value FfiVacuumResult) {
+	FfiConverterUint64INSTANCE.Write(writer, value.ProofsRemoved)
+	FfiConverterUint64INSTANCE.Write(writer, value.QuotesRemoved)
+}
+
+type FfiDestroyerFfiVacuumResult struct{}
+
+func (_ FfiDestroyerFfiVacuumResult) Destroy(value FfiVacuumResult) {
+	value.Destroy()
+}
+
+type FfiCurrencyUnit interface {
+	Destroy()
+}
+type FfiCurrencyUnitSat struct {
+}
+
+func (e FfiCurrencyUnitSat) Destroy() {
+}
+
+type FfiCurrencyUnitMsat struct {
+}
+
+func (e FfiCurrencyUnitMsat) Destroy() {
+}
+
+type FfiCurrencyUnitUsd struct {
+}
+
+func (e FfiCurrencyUnitUsd) Destroy() {
+}
+
+type FfiCurrencyUnitEur struct {
+}
+
+func (e FfiCurrencyUnitEur) Destroy() {
+}
+
+type FfiCurrencyUnitCustom struct {
+	Value string
+}
+
+func (e FfiCurrencyUnitCustom) Destroy() {
+	FfiDestroyerString{}.Destroy(e.Value)
+}
+
+type FfiConverterFfiCurrencyUnit struct{}
+
+var FfiConverterFfiCurrencyUnitINSTANCE = FfiConverterFfiCurrencyUnit{}
+
+func (c FfiConverterFfiCurrencyUnit) Lift(rb RustBufferI) FfiCurrencyUnit {
+	return LiftFromRustBuffer[FfiCurrencyUnit](c, rb)
+}
+
+func (c FfiConverterFfiCurrencyUnit) Lower(value FfiCurrencyUnit) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiCurrencyUnit](c, value)
+}
+func (FfiConverterFfiCurrencyUnit) Read(reader io.Reader) FfiCurrencyUnit {
+	id := readInt32(reader)
+	switch id {
+	case 1:
+		return FfiCurrencyUnitSat{}
+	case 2:
+		return FfiCurrencyUnitMsat{}
+	case 3:
+		return FfiCurrencyUnitUsd{}
+	case 4:
+		return FfiCurrencyUnitEur{}
+	case 5:
+		return FfiCurrencyUnitCustom{
+			FfiConverterStringINSTANCE.Read(reader),
+		}
+	default:
+		panic(fmt.Sprintf("invalid enum value %v in FfiConverterFfiCurrencyUnit.Read()", id))
+	}
+}
+
+func (FfiConverterFfiCurrencyUnit) Write(writer io.Writer, value FfiCurrencyUnit) {
+	switch variant_value := value.(type) {
+	case FfiCurrencyUnitSat:
+		writeInt32(writer, 1)
+	case FfiCurrencyUnitMsat:
+		writeInt32(writer, 2)
+	case FfiCurrencyUnitUsd:
+		writeInt32(writer, 3)
+	case FfiCurrencyUnitEur:
+		writeInt32(writer, 4)
+	case FfiCurrencyUnitCustom:
+		writeInt32(writer, 5)
+		FfiConverterStringINSTANCE.Write(writer, variant_value.Value)
+	default:
+		_ = variant_value
+		panic(fmt.Sprintf("invalid enum value `%v` in FfiConverterFfiCurrencyUnit.Write", value))
+	}
+}
+
+type FfiDestroyerFfiCurrencyUnit struct{}
+
+func (_ FfiDestroyerFfiCurrencyUnit) Destroy(value FfiCurrencyUnit) {
+	value.Destroy()
+}
+
+type FfiError struct {
+	err error
+}
+
+// Convience method to turn *FfiError into error
+// Avoiding treating nil pointer as non nil error interface
+func (err *FfiError) AsError() error {
+	if err == nil {
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (err FfiError) Error() string {
+	return fmt.Sprintf("FfiError: %s", err.err.Error())
+}
+
+func (err FfiError) Unwrap() error {
+	return err.err
+}
+
+// Err* are used for checking error type with `errors.Is`
+var ErrFfiErrorWalletError = fmt.Errorf("FfiErrorWalletError")
+var ErrFfiErrorInvalidInput = fmt.Errorf("FfiErrorInvalidInput")
+var ErrFfiErrorNetworkError = fmt.Errorf("FfiErrorNetworkError")
+var ErrFfiErrorInternalError = fmt.Errorf("FfiErrorInternalError")
+var ErrFfiErrorOperationDisabled = fmt.Errorf("FfiErrorOperationDisabled")
+var ErrFfiErrorTimeout = fmt.Errorf("FfiErrorTimeout")
+var ErrFfiErrorInsufficientFunds = fmt.Errorf("FfiErrorInsufficientFunds")
+var ErrFfiErrorTransferFailed = fmt.Errorf("FfiErrorTransferFailed")
+var ErrFfiErrorFeeTooHigh = fmt.Errorf("FfiErrorFeeTooHigh")
+var ErrFfiErrorOfflineSendImpossible = fmt.Errorf("FfiErrorOfflineSendImpossible")
+var ErrFfiErrorDleqVerificationFailed = fmt.Errorf("FfiErrorDleqVerificationFailed")
+
+// Variant structs
+type FfiErrorWalletError struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorWalletError(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorWalletError{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorWalletError) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorWalletError) Error() string {
+	return fmt.Sprint("WalletError",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorWalletError) Is(target error) bool {
+	return target == ErrFfiErrorWalletError
+}
+
+type FfiErrorInvalidInput struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorInvalidInput(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorInvalidInput{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorInvalidInput) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorInvalidInput) Error() string {
+	return fmt.Sprint("InvalidInput",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorInvalidInput) Is(target error) bool {
+	return target == ErrFfiErrorInvalidInput
+}
+
+type FfiErrorNetworkError struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorNetworkError(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorNetworkError{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorNetworkError) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorNetworkError) Error() string {
+	return fmt.Sprint("NetworkError",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorNetworkError) Is(target error) bool {
+	return target == ErrFfiErrorNetworkError
+}
+
+type FfiErrorInternalError struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorInternalError(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorInternalError{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorInternalError) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorInternalError) Error() string {
+	return fmt.Sprint("InternalError",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorInternalError) Is(target error) bool {
+	return target == ErrFfiErrorInternalError
+}
+
+type FfiErrorOperationDisabled struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorOperationDisabled(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorOperationDisabled{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorOperationDisabled) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorOperationDisabled) Error() string {
+	return fmt.Sprint("OperationDisabled",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorOperationDisabled) Is(target error) bool {
+	return target == ErrFfiErrorOperationDisabled
+}
+
+type FfiErrorTimeout struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorTimeout(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorTimeout{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorTimeout) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorTimeout) Error() string {
+	return fmt.Sprint("Timeout",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorTimeout) Is(target error) bool {
+	return target == ErrFfiErrorTimeout
+}
+
+type FfiErrorInsufficientFunds struct {
+	Available FfiAmount
+	Required  FfiAmount
+	Code      uint32
+}
+
+func NewFfiErrorInsufficientFunds(
+	available FfiAmount,
+	required FfiAmount,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorInsufficientFunds{
+		Available: available,
+		Required:  required,
+		Code:      code}}
+}
+
+func (e FfiErrorInsufficientFunds) destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(e.Available)
+	FfiDestroyerFfiAmount{}.Destroy(e.Required)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorInsufficientFunds) Error() string {
+	return fmt.Sprint("InsufficientFunds",
+		": ",
+
+		"Available=",
+		err.Available,
+		", ",
+		"Required=",
+		err.Required,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorInsufficientFunds) Is(target error) bool {
+	return target == ErrFfiErrorInsufficientFunds
+}
+
+type FfiErrorTransferFailed struct {
+	Leg  FfiTransferLeg
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorTransferFailed(
+	leg FfiTransferLeg,
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorTransferFailed{
+		Leg:  leg,
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorTransferFailed) destroy() {
+	FfiDestroyerFfiTransferLeg{}.Destroy(e.Leg)
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorTransferFailed) Error() string {
+	return fmt.Sprint("TransferFailed",
+		": ",
+
+		"Leg=",
+		err.Leg,
+		", ",
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorTransferFailed) Is(target error) bool {
+	return target == ErrFfiErrorTransferFailed
+}
+
+type FfiErrorFeeTooHigh struct {
+	FeeReserve FfiAmount
+	MaxFee     FfiAmount
+	Code       uint32
+}
+
+func NewFfiErrorFeeTooHigh(
+	feeReserve FfiAmount,
+	maxFee FfiAmount,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorFeeTooHigh{
+		FeeReserve: feeReserve,
+		MaxFee:     maxFee,
+		Code:       code}}
+}
+
+func (e FfiErrorFeeTooHigh) destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(e.FeeReserve)
+	FfiDestroyerFfiAmount{}.Destroy(e.MaxFee)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorFeeTooHigh) Error() string {
+	return fmt.Sprint("FeeTooHigh",
+		": ",
+
+		"FeeReserve=",
+		err.FeeReserve,
+		", ",
+		"MaxFee=",
+		err.MaxFee,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorFeeTooHigh) Is(target error) bool {
+	return target == ErrFfiErrorFeeTooHigh
+}
+
+type FfiErrorOfflineSendImpossible struct {
+	Requested FfiAmount
+	Nearest   FfiAmount
+	Code      uint32
+}
+
+func NewFfiErrorOfflineSendImpossible(
+	requested FfiAmount,
+	nearest FfiAmount,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorOfflineSendImpossible{
+		Requested: requested,
+		Nearest:   nearest,
+		Code:      code}}
+}
+
+func (e FfiErrorOfflineSendImpossible) destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(e.Requested)
+	FfiDestroyerFfiAmount{}.Destroy(e.Nearest)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorOfflineSendImpossible) Error() string {
+	return fmt.Sprint("OfflineSendImpossible",
+		": ",
+
+		"Requested=",
+		err.Requested,
+		", ",
+		"Nearest=",
+		err.Nearest,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorOfflineSendImpossible) Is(target error) bool {
+	return target == ErrFfiErrorOfflineSendImpossible
+}
+
+type FfiErrorDleqVerificationFailed struct {
+	Msg  string
+	Code uint32
+}
+
+func NewFfiErrorDleqVerificationFailed(
+	msg string,
+	code uint32,
+) *FfiError {
+	return &FfiError{err: &FfiErrorDleqVerificationFailed{
+		Msg:  msg,
+		Code: code}}
+}
+
+func (e FfiErrorDleqVerificationFailed) destroy() {
+	FfiDestroyerString{}.Destroy(e.Msg)
+	FfiDestroyerUint32{}.Destroy(e.Code)
+}
+
+func (err FfiErrorDleqVerificationFailed) Error() string {
+	return fmt.Sprint("DleqVerificationFailed",
+		": ",
+
+		"Msg=",
+		err.Msg,
+		", ",
+		"Code=",
+		err.Code,
+	)
+}
+
+func (self FfiErrorDleqVerificationFailed) Is(target error) bool {
+	return target == ErrFfiErrorDleqVerificationFailed
+}
+
+type FfiConverterFfiError struct{}
+
+var FfiConverterFfiErrorINSTANCE = FfiConverterFfiError{}
+
+func (c FfiConverterFfiError) Lift(eb RustBufferI) *FfiError {
+	return LiftFromRustBuffer[*FfiError](c, eb)
+}
+
+func (c FfiConverterFfiError) Lower(value *FfiError) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiError](c, value)
+}
+
+func (c FfiConverterFfiError) Read(reader io.Reader) *FfiError {
+	errorID := readUint32(reader)
+
+	switch errorID {
+	case 1:
+		return &FfiError{&FfiErrorWalletError{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 2:
+		return &FfiError{&FfiErrorInvalidInput{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 3:
+		return &FfiError{&FfiErrorNetworkError{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 4:
+		return &FfiError{&FfiErrorInternalError{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 5:
+		return &FfiError{&FfiErrorOperationDisabled{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 6:
+		return &FfiError{&FfiErrorTimeout{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 7:
+		return &FfiError{&FfiErrorInsufficientFunds{
+			Available: FfiConverterFfiAmountINSTANCE.Read(reader),
+			Required:  FfiConverterFfiAmountINSTANCE.Read(reader),
+			Code:      FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 8:
+		return &FfiError{&FfiErrorTransferFailed{
+			Leg:  FfiConverterFfiTransferLegINSTANCE.Read(reader),
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 9:
+		return &FfiError{&FfiErrorFeeTooHigh{
+			FeeReserve: FfiConverterFfiAmountINSTANCE.Read(reader),
+			MaxFee:     FfiConverterFfiAmountINSTANCE.Read(reader),
+			Code:       FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 10:
+		return &FfiError{&FfiErrorOfflineSendImpossible{
+			Requested: FfiConverterFfiAmountINSTANCE.Read(reader),
+			Nearest:   FfiConverterFfiAmountINSTANCE.Read(reader),
+			Code:      FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	case 11:
+		return &FfiError{&FfiErrorDleqVerificationFailed{
+			Msg:  FfiConverterStringINSTANCE.Read(reader),
+			Code: FfiConverterUint32INSTANCE.Read(reader),
+		}}
+	default:
+		panic(fmt.Sprintf("Unknown error code %d in FfiConverterFfiError.Read()", errorID))
+	}
+}
+
+func (c FfiConverterFfiError) Write(writer io.Writer, value *FfiError) {
+	switch variantValue := value.err.(type) {
+	case *FfiErrorWalletError:
+		writeInt32(writer, 1)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorInvalidInput:
+		writeInt32(writer, 2)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorNetworkError:
+		writeInt32(writer, 3)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorInternalError:
+		writeInt32(writer, 4)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorOperationDisabled:
+		writeInt32(writer, 5)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorTimeout:
+		writeInt32(writer, 6)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorInsufficientFunds:
+		writeInt32(writer, 7)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.Available)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.Required)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorTransferFailed:
+		writeInt32(writer, 8)
+		FfiConverterFfiTransferLegINSTANCE.Write(writer, variantValue.Leg)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorFeeTooHigh:
+		writeInt32(writer, 9)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.FeeReserve)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.MaxFee)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorOfflineSendImpossible:
+		writeInt32(writer, 10)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.Requested)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variantValue.Nearest)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	case *FfiErrorDleqVerificationFailed:
+		writeInt32(writer, 11)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
+		FfiConverterUint32INSTANCE.Write(writer, variantValue.Code)
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterFfiError.Write", value))
+	}
+}
+
+type FfiDestroyerFfiError struct{}
+
+func (_ FfiDestroyerFfiError) Destroy(value *FfiError) {
+	switch variantValue := value.err.(type) {
+	case FfiErrorWalletError:
+		variantValue.destroy()
+	case FfiErrorInvalidInput:
+		variantValue.destroy()
+	case FfiErrorNetworkError:
+		variantValue.destroy()
+	case FfiErrorInternalError:
+		variantValue.destroy()
+	case FfiErrorOperationDisabled:
+		variantValue.destroy()
+	case FfiErrorTimeout:
+		variantValue.destroy()
+	case FfiErrorInsufficientFunds:
+		variantValue.destroy()
+	case FfiErrorTransferFailed:
+		variantValue.destroy()
+	case FfiErrorFeeTooHigh:
+		variantValue.destroy()
+	case FfiErrorOfflineSendImpossible:
+		variantValue.destroy()
+	case FfiErrorDleqVerificationFailed:
+		variantValue.destroy()
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFfiError.Destroy", value))
+	}
+}
+
+type FfiMintQuoteState uint
+
+const (
+	FfiMintQuoteStateUnpaid FfiMintQuoteState = 1
+	FfiMintQuoteStatePaid   FfiMintQuoteState = 2
+	FfiMintQuoteStateIssued FfiMintQuoteState = 3
+)
+
+type FfiConverterFfiMintQuoteState struct{}
+
+var FfiConverterFfiMintQuoteStateINSTANCE = FfiConverterFfiMintQuoteState{}
+
+func (c FfiConverterFfiMintQuoteState) Lift(rb RustBufferI) FfiMintQuoteState {
+	return LiftFromRustBuffer[FfiMintQuoteState](c, rb)
+}
+
+func (c FfiConverterFfiMintQuoteState) Lower(value FfiMintQuoteState) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiMintQuoteState](c, value)
+}
+func (FfiConverterFfiMintQuoteState) Read(reader io.Reader) FfiMintQuoteState {
+	id := readInt32(reader)
+	return FfiMintQuoteState(id)
+}
+
+func (FfiConverterFfiMintQuoteState) Write(writer io.Writer, value FfiMintQuoteState) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiMintQuoteState struct{}
+
+func (_ FfiDestroyerFfiMintQuoteState) Destroy(value FfiMintQuoteState) {
+}
+
+// Order in which stored proofs are considered when selecting proofs to send
+type FfiProofSelection uint
+
+const (
+	FfiProofSelectionLargestFirst  FfiProofSelection = 1
+	FfiProofSelectionSmallestFirst FfiProofSelection = 2
+	// CDK's default selection, which prefers proofs that need no change
+	FfiProofSelectionMinimizeChange FfiProofSelection = 3
+)
+
+type FfiConverterFfiProofSelection struct{}
+
+var FfiConverterFfiProofSelectionINSTANCE = FfiConverterFfiProofSelection{}
+
+func (c FfiConverterFfiProofSelection) Lift(rb RustBufferI) FfiProofSelection {
+	return LiftFromRustBuffer[FfiProofSelection](c, rb)
+}
+
+func (c FfiConverterFfiProofSelection) Lower(value FfiProofSelection) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiProofSelection](c, value)
+}
+func (FfiConverterFfiProofSelection) Read(reader io.Reader) FfiProofSelection {
+	id := readInt32(reader)
+	return FfiProofSelection(id)
+}
+
+func (FfiConverterFfiProofSelection) Write(writer io.Writer, value FfiProofSelection) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiProofSelection struct{}
+
+func (_ FfiDestroyerFfiProofSelection) Destroy(value FfiProofSelection) {
+}
+
+// Stored proof states that `list_proofs` can filter on
+type FfiProofStateFilter uint
+
+const (
+	FfiProofStateFilterUnspent FfiProofStateFilter = 1
+	// Proofs in an unfinished swap or melt, including those pending spent
+	FfiProofStateFilterPending FfiProofStateFilter = 2
+	// Proofs set aside for a prepared send
+	FfiProofStateFilterReserved FfiProofStateFilter = 3
+	FfiProofStateFilterSpent    FfiProofStateFilter = 4
+)
+
+type FfiConverterFfiProofStateFilter struct{}
+
+var FfiConverterFfiProofStateFilterINSTANCE = FfiConverterFfiProofStateFilter{}
+
+func (c FfiConverterFfiProofStateFilter) Lift(rb RustBufferI) FfiProofStateFilter {
+	return LiftFromRustBuffer[FfiProofStateFilter](c, rb)
+}
+
+func (c FfiConverterFfiProofStateFilter) Lower(value FfiProofStateFilter) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiProofStateFilter](c, value)
+}
+func (FfiConverterFfiProofStateFilter) Read(reader io.Reader) FfiProofStateFilter {
+	id := readInt32(reader)
+	return FfiProofStateFilter(id)
+}
+
+func (FfiConverterFfiProofStateFilter) Write(writer io.Writer, value FfiProofStateFilter) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiProofStateFilter struct{}
+
+func (_ FfiDestroyerFfiProofStateFilter) Destroy(value FfiProofStateFilter) {
+}
+
+type FfiQuoteKind uint
+
+const (
+	FfiQuoteKindMint FfiQuoteKind = 1
+	FfiQuoteKindMelt FfiQuoteKind = 2
+)
+
+type FfiConverterFfiQuoteKind struct{}
+
+var FfiConverterFfiQuoteKindINSTANCE = FfiConverterFfiQuoteKind{}
+
+func (c FfiConverterFfiQuoteKind) Lift(rb RustBufferI) FfiQuoteKind {
+	return LiftFromRustBuffer[FfiQuoteKind](c, rb)
+}
+
+func (c FfiConverterFfiQuoteKind) Lower(value FfiQuoteKind) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiQuoteKind](c, value)
+}
+func (FfiConverterFfiQuoteKind) Read(reader io.Reader) FfiQuoteKind {
+	id := readInt32(reader)
+	return FfiQuoteKind(id)
+}
+
+func (FfiConverterFfiQuoteKind) Write(writer io.Writer, value FfiQuoteKind) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiQuoteKind struct{}
+
+func (_ FfiDestroyerFfiQuoteKind) Destroy(value FfiQuoteKind) {
+}
+
+type FfiSendKind interface {
+	Destroy()
+}
+type FfiSendKindOnlineExact struct {
+}
+
+func (e FfiSendKindOnlineExact) Destroy() {
+}
+
+type FfiSendKindOnlineTolerance struct {
+	Tolerance FfiAmount
+}
+
+func (e FfiSendKindOnlineTolerance) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(e.Tolerance)
+}
+
+type FfiSendKindOfflineExact struct {
+}
+
+func (e FfiSendKindOfflineExact) Destroy() {
+}
+
+type FfiSendKindOfflineTolerance struct {
+	Tolerance FfiAmount
+}
+
+func (e FfiSendKindOfflineTolerance) Destroy() {
+	FfiDestroyerFfiAmount{}.Destroy(e.Tolerance)
+}
+
+type FfiConverterFfiSendKind struct{}
+
+var FfiConverterFfiSendKindINSTANCE = FfiConverterFfiSendKind{}
+
+func (c FfiConverterFfiSendKind) Lift(rb RustBufferI) FfiSendKind {
+	return LiftFromRustBuffer[FfiSendKind](c, rb)
+}
+
+func (c FfiConverterFfiSendKind) Lower(value FfiSendKind) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiSendKind](c, value)
+}
+func (FfiConverterFfiSendKind) Read(reader io.Reader) FfiSendKind {
+	id := readInt32(reader)
+	switch id {
+	case 1:
+		return FfiSendKindOnlineExact{}
+	case 2:
+		return FfiSendKindOnlineTolerance{
+			FfiConverterFfiAmountINSTANCE.Read(reader),
+		}
+	case 3:
+		return FfiSendKindOfflineExact{}
+	case 4:
+		return FfiSendKindOfflineTolerance{
+			FfiConverterFfiAmountINSTANCE.Read(reader),
+		}
+	default:
+		panic(fmt.Sprintf("invalid enum value %v in FfiConverterFfiSendKind.Read()", id))
+	}
+}
+
+func (FfiConverterFfiSendKind) Write(writer io.Writer, value FfiSendKind) {
+	switch variant_value := value.(type) {
+	case FfiSendKindOnlineExact:
+		writeInt32(writer, 1)
+	case FfiSendKindOnlineTolerance:
+		writeInt32(writer, 2)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variant_value.Tolerance)
+	case FfiSendKindOfflineExact:
+		writeInt32(writer, 3)
+	case FfiSendKindOfflineTolerance:
+		writeInt32(writer, 4)
+		FfiConverterFfiAmountINSTANCE.Write(writer, variant_value.Tolerance)
+	default:
+		_ = variant_value
+		panic(fmt.Sprintf("invalid enum value `%v` in FfiConverterFfiSendKind.Write", value))
+	}
+}
+
+type FfiDestroyerFfiSendKind struct{}
+
+func (_ FfiDestroyerFfiSendKind) Destroy(value FfiSendKind) {
+	value.Destroy()
+}
+
+// Spending condition (NUT-10) that locks the proofs of a token
+type FfiSpendingCondition uint
+
+const (
+	FfiSpendingConditionNone FfiSpendingCondition = 1
+	// Locked to a public key (NUT-11)
+	FfiSpendingConditionP2pk FfiSpendingCondition = 2
+	// Locked to the preimage of a hash (NUT-14)
+	FfiSpendingConditionHtlc FfiSpendingCondition = 3
+)
+
+type FfiConverterFfiSpendingCondition struct{}
+
+var FfiConverterFfiSpendingConditionINSTANCE = FfiConverterFfiSpendingCondition{}
+
+func (c FfiConverterFfiSpendingCondition) Lift(rb RustBufferI) FfiSpendingCondition {
+	return LiftFromRustBuffer[FfiSpendingCondition](c, rb)
+}
+
+func (c FfiConverterFfiSpendingCondition) Lower(value FfiSpendingCondition) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiSpendingCondition](c, value)
+}
+func (FfiConverterFfiSpendingCondition) Read(reader io.Reader) FfiSpendingCondition {
+	id := readInt32(reader)
+	return FfiSpendingCondition(id)
+}
+
+func (FfiConverterFfiSpendingCondition) Write(writer io.Writer, value FfiSpendingCondition) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiSpendingCondition struct{}
+
+func (_ FfiDestroyerFfiSpendingCondition) Destroy(value FfiSpendingCondition) {
+}
+
+type FfiSplitTarget uint
+
+const (
+	FfiSplitTargetNone    FfiSplitTarget = 1
+	FfiSplitTargetDefault FfiSplitTarget = 2
+)
+
+type FfiConverterFfiSplitTarget struct{}
+
+var FfiConverterFfiSplitTargetINSTANCE = FfiConverterFfiSplitTarget{}
+
+func (c FfiConverterFfiSplitTarget) Lift(rb RustBufferI) FfiSplitTarget {
+	return LiftFromRustBuffer[FfiSplitTarget](c, rb)
+}
+
+func (c FfiConverterFfiSplitTarget) Lower(value FfiSplitTarget) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiSplitTarget](c, value)
+}
+func (FfiConverterFfiSplitTarget) Read(reader io.Reader) FfiSplitTarget {
+	id := readInt32(reader)
+	return FfiSplitTarget(id)
+}
+
+func (FfiConverterFfiSplitTarget) Write(writer io.Writer, value FfiSplitTarget) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiSplitTarget struct{}
+
+func (_ FfiDestroyerFfiSplitTarget) Destroy(value FfiSplitTarget) {
+}
+
+type FfiState uint
+
+const (
+	FfiStateUnspent FfiState = 1
+	FfiStatePending FfiState = 2
+	FfiStateSpent   FfiState = 3
+)
+
+type FfiConverterFfiState struct{}
+
+var FfiConverterFfiStateINSTANCE = FfiConverterFfiState{}
+
+func (c FfiConverterFfiState) Lift(rb RustBufferI) FfiState {
+	return LiftFromRustBuffer[FfiState](c, rb)
+}
+
+func (c FfiConverterFfiState) Lower(value FfiState) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiState](c, value)
+}
+func (FfiConverterFfiState) Read(reader io.Reader) FfiState {
+	id := readInt32(reader)
+	return FfiState(id)
+}
+
+func (FfiConverterFfiState) Write(writer io.Writer, value FfiState) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiState struct{}
+
+func (_ FfiDestroyerFfiState) Destroy(value FfiState) {
+}
+
+// Whether the proofs of a token can still be redeemed, from a NUT-07 check at its mint
+type FfiTokenState uint
+
+const (
+	FfiTokenStateSpendable      FfiTokenState = 1
+	FfiTokenStatePartiallySpent FfiTokenState = 2
+	FfiTokenStateSpent          FfiTokenState = 3
+)
+
+type FfiConverterFfiTokenState struct{}
+
+var FfiConverterFfiTokenStateINSTANCE = FfiConverterFfiTokenState{}
+
+func (c FfiConverterFfiTokenState) Lift(rb RustBufferI) FfiTokenState {
+	return LiftFromRustBuffer[FfiTokenState](c, rb)
+}
+
+func (c FfiConverterFfiTokenState) Lower(value FfiTokenState) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTokenState](c, value)
+}
+func (FfiConverterFfiTokenState) Read(reader io.Reader) FfiTokenState {
+	id := readInt32(reader)
+	return FfiTokenState(id)
+}
+
+func (FfiConverterFfiTokenState) Write(writer io.Writer, value FfiTokenState) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiTokenState struct{}
+
+func (_ FfiDestroyerFfiTokenState) Destroy(value FfiTokenState) {
+}
+
+// Token serialization format produced by a send
+type FfiTokenVersion uint
+
+const (
+	// Legacy JSON "cashuA" tokens, still the only format some wallets read
+	FfiTokenVersionV3 FfiTokenVersion = 1
+	// CBOR "cashuB" tokens
+	FfiTokenVersionV4 FfiTokenVersion = 2
+)
+
+type FfiConverterFfiTokenVersion struct{}
+
+var FfiConverterFfiTokenVersionINSTANCE = FfiConverterFfiTokenVersion{}
+
+func (c FfiConverterFfiTokenVersion) Lift(rb RustBufferI) FfiTokenVersion {
+	return LiftFromRustBuffer[FfiTokenVersion](c, rb)
+}
+
+func (c FfiConverterFfiTokenVersion) Lower(value FfiTokenVersion) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTokenVersion](c, value)
+}
+func (FfiConverterFfiTokenVersion) Read(reader io.Reader) FfiTokenVersion {
+	id := readInt32(reader)
+	return FfiTokenVersion(id)
+}
+
+func (FfiConverterFfiTokenVersion) Write(writer io.Writer, value FfiTokenVersion) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiTokenVersion struct{}
+
+func (_ FfiDestroyerFfiTokenVersion) Destroy(value FfiTokenVersion) {
+}
+
+type FfiTransactionDirection uint
+
+const (
+	FfiTransactionDirectionIncoming FfiTransactionDirection = 1
+	FfiTransactionDirectionOutgoing FfiTransactionDirection = 2
+)
+
+type FfiConverterFfiTransactionDirection struct{}
+
+var FfiConverterFfiTransactionDirectionINSTANCE = FfiConverterFfiTransactionDirection{}
+
+func (c FfiConverterFfiTransactionDirection) Lift(rb RustBufferI) FfiTransactionDirection {
+	return LiftFromRustBuffer[FfiTransactionDirection](c, rb)
+}
+
+func (c FfiConverterFfiTransactionDirection) Lower(value FfiTransactionDirection) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransactionDirection](c, value)
+}
+func (FfiConverterFfiTransactionDirection) Read(reader io.Reader) FfiTransactionDirection {
+	id := readInt32(reader)
+	return FfiTransactionDirection(id)
+}
+
+func (FfiConverterFfiTransactionDirection) Write(writer io.Writer, value FfiTransactionDirection) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiTransactionDirection struct{}
+
+func (_ FfiDestroyerFfiTransactionDirection) Destroy(value FfiTransactionDirection) {
+}
+
+// Step of a transfer between mints, the melt at the source or the mint at the destination
+type FfiTransferLeg uint
+
+const (
+	FfiTransferLegMelt FfiTransferLeg = 1
+	FfiTransferLegMint FfiTransferLeg = 2
+)
+
+type FfiConverterFfiTransferLeg struct{}
+
+var FfiConverterFfiTransferLegINSTANCE = FfiConverterFfiTransferLeg{}
+
+func (c FfiConverterFfiTransferLeg) Lift(rb RustBufferI) FfiTransferLeg {
+	return LiftFromRustBuffer[FfiTransferLeg](c, rb)
+}
+
+func (c FfiConverterFfiTransferLeg) Lower(value FfiTransferLeg) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransferLeg](c, value)
+}
+func (FfiConverterFfiTransferLeg) Read(reader io.Reader) FfiTransferLeg {
+	id := readInt32(reader)
+	return FfiTransferLeg(id)
+}
+
+func (FfiConverterFfiTransferLeg) Write(writer io.Writer, value FfiTransferLeg) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiTransferLeg struct{}
+
+func (_ FfiDestroyerFfiTransferLeg) Destroy(value FfiTransferLeg) {
+}
+
+// How a payment request (NUT-18) wants the token delivered
+type FfiTransportType uint
+
+const (
+	FfiTransportTypeNostr    FfiTransportType = 1
+	FfiTransportTypeHttpPost FfiTransportType = 2
+)
+
+type FfiConverterFfiTransportType struct{}
+
+var FfiConverterFfiTransportTypeINSTANCE = FfiConverterFfiTransportType{}
+
+func (c FfiConverterFfiTransportType) Lift(rb RustBufferI) FfiTransportType {
+	return LiftFromRustBuffer[FfiTransportType](c, rb)
+}
+
+func (c FfiConverterFfiTransportType) Lower(value FfiTransportType) C.RustBuffer {
+	return LowerIntoRustBuffer[FfiTransportType](c, value)
+}
+func (FfiConverterFfiTransportType) Read(reader io.Reader) FfiTransportType {
+	id := readInt32(reader)
+	return FfiTransportType(id)
+}
+
+func (FfiConverterFfiTransportType) Write(writer io.Writer, value FfiTransportType) {
+	writeInt32(writer, int32(value))
+}
+
+type FfiDestroyerFfiTransportType struct{}
+
+func (_ FfiDestroyerFfiTransportType) Destroy(value FfiTransportType) {
+}
+
+type concurrentHandleMap[T any] struct {
+	handles       map[uint64]T
+	currentHandle uint64
+	lock          sync.RWMutex
+}
+
+func newConcurrentHandleMap[T any]() *concurrentHandleMap[T] {
+	return &concurrentHandleMap[T]{
+		handles: map[uint64]T{},
+	}
+}
+
+func (cm *concurrentHandleMap[T]) insert(obj T) uint64 {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	cm.currentHandle = cm.currentHandle + 1
+	cm.handles[cm.currentHandle] = obj
+	return cm.currentHandle
+}
+
+func (cm *concurrentHandleMap[T]) remove(handle uint64) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	delete(cm.handles, handle)
+}
+
+func (cm *concurrentHandleMap[T]) tryGet(handle uint64) (T, bool) {
+	cm.lock.RLock()
+	defer cm.lock.RUnlock()
+
+	val, ok := cm.handles[handle]
+	return val, ok
+}
+
+// Receives the balance of a wallet after an operation changed it, see `observe_balance`
+type BalanceObserver interface {
+	// Called with the new spendable balance
+	OnChange(balance FfiAmount)
+}
+
+type FfiConverterCallbackInterfaceBalanceObserver struct {
+	handleMap *concurrentHandleMap[BalanceObserver]
+}
+
+var FfiConverterCallbackInterfaceBalanceObserverINSTANCE = FfiConverterCallbackInterfaceBalanceObserver{
+	handleMap: newConcurrentHandleMap[BalanceObserver](),
+}
+
+func (c FfiConverterCallbackInterfaceBalanceObserver) Lift(handle uint64) BalanceObserver {
+	val, ok := c.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+	return val
+}
+
+func (c FfiConverterCallbackInterfaceBalanceObserver) Read(reader io.Reader) BalanceObserver {
+	return c.Lift(readUint64(reader))
+}
+
+func (c FfiConverterCallbackInterfaceBalanceObserver) Lower(value BalanceObserver) C.uint64_t {
+	return C.uint64_t(c.handleMap.insert(value))
+}
+
+func (c FfiConverterCallbackInterfaceBalanceObserver) Write(writer io.Writer, value BalanceObserver) {
+	writeUint64(writer, uint64(c.Lower(value)))
+}
+
+type FfiDestroyerCallbackInterfaceBalanceObserver struct{}
+
+func (FfiDestroyerCallbackInterfaceBalanceObserver) Destroy(value BalanceObserver) {}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverMethod0
+func cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverMethod0(uniffiHandle C.uint64_t, balance C.RustBuffer, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceBalanceObserverINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+
+	uniffiObj.OnChange(
+		FfiConverterFfiAmountINSTANCE.Lift(GoRustBuffer{
+			inner: balance,
+		}),
+	)
+
+}
+
+var UniffiVTableCallbackInterfaceBalanceObserverINSTANCE = C.UniffiVTableCallbackInterfaceBalanceObserver{
+	onChange:   (C.UniffiCallbackInterfaceBalanceObserverMethod0)(C.cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverMethod0),
+	uniffiFree: (C.UniffiCallbackInterfaceFree)(C.cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverFree),
+}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverFree
+func cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverFree(handle C.uint64_t) {
+	FfiConverterCallbackInterfaceBalanceObserverINSTANCE.handleMap.remove(uint64(handle))
+}
+
+func (c FfiConverterCallbackInterfaceBalanceObserver) register() {
+	C.uniffi_cdk_ffi_fn_init_callback_vtable_balanceobserver(&UniffiVTableCallbackInterfaceBalanceObserverINSTANCE)
+}
+
+// Receives the log events of this library and CDK, see `set_log_callback`
+type LogObserver interface {
+	// Called for every event, `level` is 1 for error, 2 warn, 3 info, 4 debug and 5 trace
+	Log(level uint8, target string, message string)
+}
+
+type FfiConverterCallbackInterfaceLogObserver struct {
+	handleMap *concurrentHandleMap[LogObserver]
+}
+
+var FfiConverterCallbackInterfaceLogObserverINSTANCE = FfiConverterCallbackInterfaceLogObserver{
+	handleMap: newConcurrentHandleMap[LogObserver](),
+}
+
+func (c FfiConverterCallbackInterfaceLogObserver) Lift(handle uint64) LogObserver {
+	val, ok := c.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+	return val
+}
+
+func (c FfiConverterCallbackInterfaceLogObserver) Read(reader io.Reader) LogObserver {
+	return c.Lift(readUint64(reader))
+}
+
+func (c FfiConverterCallbackInterfaceLogObserver) Lower(value LogObserver) C.uint64_t {
+	return C.uint64_t(c.handleMap.insert(value))
+}
+
+func (c FfiConverterCallbackInterfaceLogObserver) Write(writer io.Writer, value LogObserver) {
+	writeUint64(writer, uint64(c.Lower(value)))
+}
+
+type FfiDestroyerCallbackInterfaceLogObserver struct{}
+
+func (FfiDestroyerCallbackInterfaceLogObserver) Destroy(value LogObserver) {}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverMethod0
+func cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverMethod0(uniffiHandle C.uint64_t, level C.uint8_t, target C.RustBuffer, message C.RustBuffer, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceLogObserverINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+
+	uniffiObj.Log(
+		FfiConverterUint8INSTANCE.Lift(level),
+		FfiConverterStringINSTANCE.Lift(GoRustBuffer{
+			inner: target,
+		}),
+		FfiConverterStringINSTANCE.Lift(GoRustBuffer{
+			inner: message,
+		}),
+	)
+
+}
+
+var UniffiVTableCallbackInterfaceLogObserverINSTANCE = C.UniffiVTableCallbackInterfaceLogObserver{
+	log:        (C.UniffiCallbackInterfaceLogObserverMethod0)(C.cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverMethod0),
+	uniffiFree: (C.UniffiCallbackInterfaceFree)(C.cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverFree),
+}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverFree
+func cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverFree(handle C.uint64_t) {
+	FfiConverterCallbackInterfaceLogObserverINSTANCE.handleMap.remove(uint64(handle))
+}
+
+func (c FfiConverterCallbackInterfaceLogObserver) register() {
+	C.uniffi_cdk_ffi_fn_init_callback_vtable_logobserver(&UniffiVTableCallbackInterfaceLogObserverINSTANCE)
+}
+
+// Receives state changes of a subscribed mint quote
+type MintQuoteObserver interface {
+	// Called with the current state once subscribed and after every change
+	OnUpdate(state FfiMintQuoteState)
+}
 
-	return rustCall(func(status *C.RustCallStatus) unsafe.Pointer {
-		return ffiObject.cloneFunction(ffiObject.pointer, status)
-	})
+type FfiConverterCallbackInterfaceMintQuoteObserver struct {
+	handleMap *concurrentHandleMap[MintQuoteObserver]
 }
 
-func (ffiObject *FfiObject) decrementPointer() {
-	if ffiObject.callCounter.Add(-1) == -1 {
-		ffiObject.freeRustArcPtr()
-	}
+var FfiConverterCallbackInterfaceMintQuoteObserverINSTANCE = FfiConverterCallbackInterfaceMintQuoteObserver{
+	handleMap: newConcurrentHandleMap[MintQuoteObserver](),
 }
 
-func (ffiObject *FfiObject) destroy() {
-	if ffiObject.destroyed.CompareAndSwap(false, true) {
-		if ffiObject.callCounter.Add(-1) == -1 {
-			ffiObject.freeRustArcPtr()
-		}
+func (c FfiConverterCallbackInterfaceMintQuoteObserver) Lift(handle uint64) MintQuoteObserver {
+	val, ok := c.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
 	}
+	return val
 }
 
-func (ffiObject *FfiObject) freeRustArcPtr() {
-	rustCall(func(status *C.RustCallStatus) int32 {
-		ffiObject.freeFunction(ffiObject.pointer, status)
-		return 0
-	})
+func (c FfiConverterCallbackInterfaceMintQuoteObserver) Read(reader io.Reader) MintQuoteObserver {
+	return c.Lift(readUint64(reader))
 }
 
-type FfiLocalStoreInterface interface {
+func (c FfiConverterCallbackInterfaceMintQuoteObserver) Lower(value MintQuoteObserver) C.uint64_t {
+	return C.uint64_t(c.handleMap.insert(value))
 }
-type FfiLocalStore struct {
-	ffiObject FfiObject
+
+func (c FfiConverterCallbackInterfaceMintQuoteObserver) Write(writer io.Writer, value MintQuoteObserver) {
+	writeUint64(writer, uint64(c.Lower(value)))
 }
 
-func NewFfiLocalStore() (*FfiLocalStore, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_cdk_ffi_fn_constructor_ffilocalstore_new(_uniffiStatus)
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue *FfiLocalStore
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiLocalStoreINSTANCE.Lift(_uniffiRV), nil
+type FfiDestroyerCallbackInterfaceMintQuoteObserver struct{}
+
+func (FfiDestroyerCallbackInterfaceMintQuoteObserver) Destroy(value MintQuoteObserver) {}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverMethod0
+func cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverMethod0(uniffiHandle C.uint64_t, state C.RustBuffer, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceMintQuoteObserverINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
 	}
+
+	uniffiObj.OnUpdate(
+		FfiConverterFfiMintQuoteStateINSTANCE.Lift(GoRustBuffer{
+			inner: state,
+		}),
+	)
+
 }
 
-func FfiLocalStoreNewWithPath(dbPath *string) (*FfiLocalStore, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_cdk_ffi_fn_constructor_ffilocalstore_new_with_path(FfiConverterOptionalStringINSTANCE.Lower(dbPath), _uniffiStatus)
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue *FfiLocalStore
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiLocalStoreINSTANCE.Lift(_uniffiRV), nil
+var UniffiVTableCallbackInterfaceMintQuoteObserverINSTANCE = C.UniffiVTableCallbackInterfaceMintQuoteObserver{
+	onUpdate:   (C.UniffiCallbackInterfaceMintQuoteObserverMethod0)(C.cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverMethod0),
+	uniffiFree: (C.UniffiCallbackInterfaceFree)(C.cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverFree),
+}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverFree
+func cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverFree(handle C.uint64_t) {
+	FfiConverterCallbackInterfaceMintQuoteObserverINSTANCE.handleMap.remove(uint64(handle))
+}
+
+func (c FfiConverterCallbackInterfaceMintQuoteObserver) register() {
+	C.uniffi_cdk_ffi_fn_init_callback_vtable_mintquoteobserver(&UniffiVTableCallbackInterfaceMintQuoteObserverINSTANCE)
+}
+
+// Receives the outcome of `auto_mint_on_payment`, exactly one method is called
+type MintedObserver interface {
+	// Called with the minted amount once the quote was paid and its proofs are stored
+	OnMinted(amount FfiAmount)
+	// Called instead if minting failed or the quote was already issued
+	OnError(err string)
+}
+
+type FfiConverterCallbackInterfaceMintedObserver struct {
+	handleMap *concurrentHandleMap[MintedObserver]
+}
+
+var FfiConverterCallbackInterfaceMintedObserverINSTANCE = FfiConverterCallbackInterfaceMintedObserver{
+	handleMap: newConcurrentHandleMap[MintedObserver](),
+}
+
+func (c FfiConverterCallbackInterfaceMintedObserver) Lift(handle uint64) MintedObserver {
+	val, ok := c.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
 	}
+	return val
 }
 
-func (object *FfiLocalStore) Destroy() {
-	runtime.SetFinalizer(object, nil)
-	object.ffiObject.destroy()
+func (c FfiConverterCallbackInterfaceMintedObserver) Read(reader io.Reader) MintedObserver {
+	return c.Lift(readUint64(reader))
 }
 
-type FfiConverterFfiLocalStore struct{}
+func (c FfiConverterCallbackInterfaceMintedObserver) Lower(value MintedObserver) C.uint64_t {
+	return C.uint64_t(c.handleMap.insert(value))
+}
 
-var FfiConverterFfiLocalStoreINSTANCE = FfiConverterFfiLocalStore{}
+func (c FfiConverterCallbackInterfaceMintedObserver) Write(writer io.Writer, value MintedObserver) {
+	writeUint64(writer, uint64(c.Lower(value)))
+}
 
-func (c FfiConverterFfiLocalStore) Lift(pointer unsafe.Pointer) *FfiLocalStore {
-	result := &FfiLocalStore{
-		newFfiObject(
-			pointer,
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_cdk_ffi_fn_clone_ffilocalstore(pointer, status)
-			},
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_cdk_ffi_fn_free_ffilocalstore(pointer, status)
-			},
-		),
+type FfiDestroyerCallbackInterfaceMintedObserver struct{}
+
+func (FfiDestroyerCallbackInterfaceMintedObserver) Destroy(value MintedObserver) {}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod0
+func cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod0(uniffiHandle C.uint64_t, amount C.RustBuffer, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceMintedObserverINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
 	}
-	runtime.SetFinalizer(result, (*FfiLocalStore).Destroy)
-	return result
+
+	uniffiObj.OnMinted(
+		FfiConverterFfiAmountINSTANCE.Lift(GoRustBuffer{
+			inner: amount,
+		}),
+	)
+
 }
 
-func (c FfiConverterFfiLocalStore) Read(reader io.Reader) *FfiLocalStore {
-	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+//export cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod1
+func cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod1(uniffiHandle C.uint64_t, err C.RustBuffer, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceMintedObserverINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+
+	uniffiObj.OnError(
+		FfiConverterStringINSTANCE.Lift(GoRustBuffer{
+			inner: err,
+		}),
+	)
+
 }
 
-func (c FfiConverterFfiLocalStore) Lower(value *FfiLocalStore) unsafe.Pointer {
-	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
-	// because the pointer will be decremented immediately after this function returns,
-	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*FfiLocalStore")
-	defer value.ffiObject.decrementPointer()
-	return pointer
+var UniffiVTableCallbackInterfaceMintedObserverINSTANCE = C.UniffiVTableCallbackInterfaceMintedObserver{
+	onMinted:   (C.UniffiCallbackInterfaceMintedObserverMethod0)(C.cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod0),
+	onError:    (C.UniffiCallbackInterfaceMintedObserverMethod1)(C.cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod1),
+	uniffiFree: (C.UniffiCallbackInterfaceFree)(C.cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverFree),
+}
 
+//export cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverFree
+func cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverFree(handle C.uint64_t) {
+	FfiConverterCallbackInterfaceMintedObserverINSTANCE.handleMap.remove(uint64(handle))
 }
 
-func (c FfiConverterFfiLocalStore) Write(writer io.Writer, value *FfiLocalStore) {
-	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+func (c FfiConverterCallbackInterfaceMintedObserver) register() {
+	C.uniffi_cdk_ffi_fn_init_callback_vtable_mintedobserver(&UniffiVTableCallbackInterfaceMintedObserverINSTANCE)
 }
 
-type FfiDestroyerFfiLocalStore struct{}
+// Receives progress updates while a wallet is restored from its seed
+type RestoreProgress interface {
+	// Called after every restore batch with the keyset being scanned,
+	// the number of keysets finished so far and the number of keysets to scan
+	OnBatch(keysetId string, restored uint32, total uint32)
+}
 
-func (_ FfiDestroyerFfiLocalStore) Destroy(value *FfiLocalStore) {
-	value.Destroy()
+type FfiConverterCallbackInterfaceRestoreProgress struct {
+	handleMap *concurrentHandleMap[RestoreProgress]
 }
 
-type FfiWalletInterface interface {
-	Balance() (FfiAmount, error)
-	// Fetch and initialize mint information
-	// This should be called after wallet creation to set up the mint in the database
-	GetMintInfo() (string, error)
-	// Execute a melt operation (pay Lightning invoice)
-	Melt(quoteId string) (FfiMelted, error)
-	// Create a melt quote for paying a Lightning invoice
-	MeltQuote(request string) (FfiMeltQuote, error)
-	Mint(quoteId string, splitTarget FfiSplitTarget) (FfiAmount, error)
-	MintQuote(amount FfiAmount, description *string) (FfiMintQuote, error)
-	MintQuoteState(quoteId string) (FfiMintQuoteBolt11Response, error)
-	MintUrl() string
-	PrepareSend(amount FfiAmount, options FfiSendOptions) (FfiPreparedSend, error)
-	Send(amount FfiAmount, options FfiSendOptions, memo *FfiSendMemo) (FfiToken, error)
-	Unit() string
+var FfiConverterCallbackInterfaceRestoreProgressINSTANCE = FfiConverterCallbackInterfaceRestoreProgress{
+	handleMap: newConcurrentHandleMap[RestoreProgress](),
 }
-type FfiWallet struct {
-	ffiObject FfiObject
+
+func (c FfiConverterCallbackInterfaceRestoreProgress) Lift(handle uint64) RestoreProgress {
+	val, ok := c.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
+	}
+	return val
 }
 
-func FfiWalletFromMnemonic(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string) (*FfiWallet, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), _uniffiStatus)
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue *FfiWallet
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+func (c FfiConverterCallbackInterfaceRestoreProgress) Read(reader io.Reader) RestoreProgress {
+	return c.Lift(readUint64(reader))
+}
+
+func (c FfiConverterCallbackInterfaceRestoreProgress) Lower(value RestoreProgress) C.uint64_t {
+	return C.uint64_t(c.handleMap.insert(value))
+}
+
+func (c FfiConverterCallbackInterfaceRestoreProgress) Write(writer io.Writer, value RestoreProgress) {
+	writeUint64(writer, uint64(c.Lower(value)))
+}
+
+type FfiDestroyerCallbackInterfaceRestoreProgress struct{}
+
+func (FfiDestroyerCallbackInterfaceRestoreProgress) Destroy(value RestoreProgress) {}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressMethod0
+func cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressMethod0(uniffiHandle C.uint64_t, keysetId C.RustBuffer, restored C.uint32_t, total C.uint32_t, uniffiOutReturn unsafe.Pointer, callStatus *C.RustCallStatus) {
+	handle := uint64(uniffiHandle)
+	uniffiObj, ok := FfiConverterCallbackInterfaceRestoreProgressINSTANCE.handleMap.tryGet(handle)
+	if !ok {
+		panic(fmt.Errorf("no callback in handle map: %d", handle))
 	}
+
+	uniffiObj.OnBatch(
+		FfiConverterStringINSTANCE.Lift(GoRustBuffer{
+			inner: keysetId,
+		}),
+		FfiConverterUint32INSTANCE.Lift(restored),
+		FfiConverterUint32INSTANCE.Lift(total),
+	)
+
 }
 
-func FfiWalletRestoreFromMnemonic(mintUrl string, unit FfiCurrencyUnit, localstore *FfiLocalStore, mnemonicWords string) (*FfiWallet, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_cdk_ffi_fn_constructor_ffiwallet_restore_from_mnemonic(FfiConverterStringINSTANCE.Lower(mintUrl), FfiConverterFfiCurrencyUnitINSTANCE.Lower(unit), FfiConverterFfiLocalStoreINSTANCE.Lower(localstore), FfiConverterStringINSTANCE.Lower(mnemonicWords), _uniffiStatus)
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue *FfiWallet
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiWalletINSTANCE.Lift(_uniffiRV), nil
+var UniffiVTableCallbackInterfaceRestoreProgressINSTANCE = C.UniffiVTableCallbackInterfaceRestoreProgress{
+	onBatch:    (C.UniffiCallbackInterfaceRestoreProgressMethod0)(C.cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressMethod0),
+	uniffiFree: (C.UniffiCallbackInterfaceFree)(C.cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressFree),
+}
+
+//export cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressFree
+func cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressFree(handle C.uint64_t) {
+	FfiConverterCallbackInterfaceRestoreProgressINSTANCE.handleMap.remove(uint64(handle))
+}
+
+func (c FfiConverterCallbackInterfaceRestoreProgress) register() {
+	C.uniffi_cdk_ffi_fn_init_callback_vtable_restoreprogress(&UniffiVTableCallbackInterfaceRestoreProgressINSTANCE)
+}
+
+type FfiConverterOptionalUint64 struct{}
+
+var FfiConverterOptionalUint64INSTANCE = FfiConverterOptionalUint64{}
+
+func (c FfiConverterOptionalUint64) Lift(rb RustBufferI) *uint64 {
+	return LiftFromRustBuffer[*uint64](c, rb)
+}
+
+func (_ FfiConverterOptionalUint64) Read(reader io.Reader) *uint64 {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterUint64INSTANCE.Read(reader)
+	return &temp
 }
 
-func (_self *FfiWallet) Balance() (FfiAmount, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_balance(
-				_pointer, _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiAmount
-		return _uniffiDefaultValue, _uniffiErr
+func (c FfiConverterOptionalUint64) Lower(value *uint64) C.RustBuffer {
+	return LowerIntoRustBuffer[*uint64](c, value)
+}
+
+func (_ FfiConverterOptionalUint64) Write(writer io.Writer, value *uint64) {
+	if value == nil {
+		writeInt8(writer, 0)
 	} else {
-		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+		writeInt8(writer, 1)
+		FfiConverterUint64INSTANCE.Write(writer, *value)
 	}
 }
 
-// Fetch and initialize mint information
-// This should be called after wallet creation to set up the mint in the database
-func (_self *FfiWallet) GetMintInfo() (string, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_get_mint_info(
-				_pointer, _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue string
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+type FfiDestroyerOptionalUint64 struct{}
+
+func (_ FfiDestroyerOptionalUint64) Destroy(value *uint64) {
+	if value != nil {
+		FfiDestroyerUint64{}.Destroy(*value)
 	}
 }
 
-// Execute a melt operation (pay Lightning invoice)
-func (_self *FfiWallet) Melt(quoteId string) (FfiMelted, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt(
-				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiMelted
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiMeltedINSTANCE.Lift(_uniffiRV), nil
+type FfiConverterOptionalFloat64 struct{}
+
+var FfiConverterOptionalFloat64INSTANCE = FfiConverterOptionalFloat64{}
+
+func (c FfiConverterOptionalFloat64) Lift(rb RustBufferI) *float64 {
+	return LiftFromRustBuffer[*float64](c, rb)
+}
+
+func (_ FfiConverterOptionalFloat64) Read(reader io.Reader) *float64 {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterFloat64INSTANCE.Read(reader)
+	return &temp
 }
 
-// Create a melt quote for paying a Lightning invoice
-func (_self *FfiWallet) MeltQuote(request string) (FfiMeltQuote, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_melt_quote(
-				_pointer, FfiConverterStringINSTANCE.Lower(request), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiMeltQuote
-		return _uniffiDefaultValue, _uniffiErr
+func (c FfiConverterOptionalFloat64) Lower(value *float64) C.RustBuffer {
+	return LowerIntoRustBuffer[*float64](c, value)
+}
+
+func (_ FfiConverterOptionalFloat64) Write(writer io.Writer, value *float64) {
+	if value == nil {
+		writeInt8(writer, 0)
 	} else {
-		return FfiConverterFfiMeltQuoteINSTANCE.Lift(_uniffiRV), nil
+		writeInt8(writer, 1)
+		FfiConverterFloat64INSTANCE.Write(writer, *value)
+	}
+}
+
+type FfiDestroyerOptionalFloat64 struct{}
+
+func (_ FfiDestroyerOptionalFloat64) Destroy(value *float64) {
+	if value != nil {
+		FfiDestroyerFloat64{}.Destroy(*value)
 	}
 }
 
-func (_self *FfiWallet) Mint(quoteId string, splitTarget FfiSplitTarget) (FfiAmount, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint(
-				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterFfiSplitTargetINSTANCE.Lower(splitTarget), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiAmount
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiAmountINSTANCE.Lift(_uniffiRV), nil
+type FfiConverterOptionalBool struct{}
+
+var FfiConverterOptionalBoolINSTANCE = FfiConverterOptionalBool{}
+
+func (c FfiConverterOptionalBool) Lift(rb RustBufferI) *bool {
+	return LiftFromRustBuffer[*bool](c, rb)
+}
+
+func (_ FfiConverterOptionalBool) Read(reader io.Reader) *bool {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterBoolINSTANCE.Read(reader)
+	return &temp
 }
 
-func (_self *FfiWallet) MintQuote(amount FfiAmount, description *string) (FfiMintQuote, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote(
-				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiMintQuote
-		return _uniffiDefaultValue, _uniffiErr
+func (c FfiConverterOptionalBool) Lower(value *bool) C.RustBuffer {
+	return LowerIntoRustBuffer[*bool](c, value)
+}
+
+func (_ FfiConverterOptionalBool) Write(writer io.Writer, value *bool) {
+	if value == nil {
+		writeInt8(writer, 0)
 	} else {
-		return FfiConverterFfiMintQuoteINSTANCE.Lift(_uniffiRV), nil
+		writeInt8(writer, 1)
+		FfiConverterBoolINSTANCE.Write(writer, *value)
 	}
 }
 
-func (_self *FfiWallet) MintQuoteState(quoteId string) (FfiMintQuoteBolt11Response, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_state(
-				_pointer, FfiConverterStringINSTANCE.Lower(quoteId), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiMintQuoteBolt11Response
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiMintQuoteBolt11ResponseINSTANCE.Lift(_uniffiRV), nil
+type FfiDestroyerOptionalBool struct{}
+
+func (_ FfiDestroyerOptionalBool) Destroy(value *bool) {
+	if value != nil {
+		FfiDestroyerBool{}.Destroy(*value)
 	}
 }
 
-func (_self *FfiWallet) MintUrl() string {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	return FfiConverterStringINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_mint_url(
-				_pointer, _uniffiStatus),
-		}
-	}))
+type FfiConverterOptionalString struct{}
+
+var FfiConverterOptionalStringINSTANCE = FfiConverterOptionalString{}
+
+func (c FfiConverterOptionalString) Lift(rb RustBufferI) *string {
+	return LiftFromRustBuffer[*string](c, rb)
 }
 
-func (_self *FfiWallet) PrepareSend(amount FfiAmount, options FfiSendOptions) (FfiPreparedSend, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_prepare_send(
-				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterFfiSendOptionsINSTANCE.Lower(options), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiPreparedSend
-		return _uniffiDefaultValue, _uniffiErr
-	} else {
-		return FfiConverterFfiPreparedSendINSTANCE.Lift(_uniffiRV), nil
+func (_ FfiConverterOptionalString) Read(reader io.Reader) *string {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterStringINSTANCE.Read(reader)
+	return &temp
 }
 
-func (_self *FfiWallet) Send(amount FfiAmount, options FfiSendOptions, memo *FfiSendMemo) (FfiToken, error) {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_send(
-				_pointer, FfiConverterFfiAmountINSTANCE.Lower(amount), FfiConverterFfiSendOptionsINSTANCE.Lower(options), FfiConverterOptionalFfiSendMemoINSTANCE.Lower(memo), _uniffiStatus),
-		}
-	})
-	if _uniffiErr != nil {
-		var _uniffiDefaultValue FfiToken
-		return _uniffiDefaultValue, _uniffiErr
+func (c FfiConverterOptionalString) Lower(value *string) C.RustBuffer {
+	return LowerIntoRustBuffer[*string](c, value)
+}
+
+func (_ FfiConverterOptionalString) Write(writer io.Writer, value *string) {
+	if value == nil {
+		writeInt8(writer, 0)
 	} else {
-		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+		writeInt8(writer, 1)
+		FfiConverterStringINSTANCE.Write(writer, *value)
 	}
 }
 
-func (_self *FfiWallet) Unit() string {
-	_pointer := _self.ffiObject.incrementPointer("*FfiWallet")
-	defer _self.ffiObject.decrementPointer()
-	return FfiConverterStringINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_cdk_ffi_fn_method_ffiwallet_unit(
-				_pointer, _uniffiStatus),
-		}
-	}))
-}
-func (object *FfiWallet) Destroy() {
-	runtime.SetFinalizer(object, nil)
-	object.ffiObject.destroy()
+type FfiDestroyerOptionalString struct{}
+
+func (_ FfiDestroyerOptionalString) Destroy(value *string) {
+	if value != nil {
+		FfiDestroyerString{}.Destroy(*value)
+	}
 }
 
-type FfiConverterFfiWallet struct{}
+type FfiConverterOptionalFfiWallet struct{}
 
-var FfiConverterFfiWalletINSTANCE = FfiConverterFfiWallet{}
+var FfiConverterOptionalFfiWalletINSTANCE = FfiConverterOptionalFfiWallet{}
 
-func (c FfiConverterFfiWallet) Lift(pointer unsafe.Pointer) *FfiWallet {
-	result := &FfiWallet{
-		newFfiObject(
-			pointer,
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_cdk_ffi_fn_clone_ffiwallet(pointer, status)
-			},
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_cdk_ffi_fn_free_ffiwallet(pointer, status)
-			},
-		),
+func (c FfiConverterOptionalFfiWallet) Lift(rb RustBufferI) **FfiWallet {
+	return LiftFromRustBuffer[**FfiWallet](c, rb)
+}
+
+func (_ FfiConverterOptionalFfiWallet) Read(reader io.Reader) **FfiWallet {
+	if readInt8(reader) == 0 {
+		return nil
 	}
-	runtime.SetFinalizer(result, (*FfiWallet).Destroy)
-	return result
+	temp := FfiConverterFfiWalletINSTANCE.Read(reader)
+	return &temp
 }
 
-func (c FfiConverterFfiWallet) Read(reader io.Reader) *FfiWallet {
-	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+func (c FfiConverterOptionalFfiWallet) Lower(value **FfiWallet) C.RustBuffer {
+	return LowerIntoRustBuffer[**FfiWallet](c, value)
 }
 
-func (c FfiConverterFfiWallet) Lower(value *FfiWallet) unsafe.Pointer {
-	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
-	// because the pointer will be decremented immediately after this function returns,
-	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*FfiWallet")
-	defer value.ffiObject.decrementPointer()
-	return pointer
+func (_ FfiConverterOptionalFfiWallet) Write(writer io.Writer, value **FfiWallet) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiWalletINSTANCE.Write(writer, *value)
+	}
+}
 
+type FfiDestroyerOptionalFfiWallet struct{}
+
+func (_ FfiDestroyerOptionalFfiWallet) Destroy(value **FfiWallet) {
+	if value != nil {
+		FfiDestroyerFfiWallet{}.Destroy(*value)
+	}
 }
 
-func (c FfiConverterFfiWallet) Write(writer io.Writer, value *FfiWallet) {
-	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+type FfiConverterOptionalFfiAmount struct{}
+
+var FfiConverterOptionalFfiAmountINSTANCE = FfiConverterOptionalFfiAmount{}
+
+func (c FfiConverterOptionalFfiAmount) Lift(rb RustBufferI) *FfiAmount {
+	return LiftFromRustBuffer[*FfiAmount](c, rb)
 }
 
-type FfiDestroyerFfiWallet struct{}
+func (_ FfiConverterOptionalFfiAmount) Read(reader io.Reader) *FfiAmount {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterFfiAmountINSTANCE.Read(reader)
+	return &temp
+}
 
-func (_ FfiDestroyerFfiWallet) Destroy(value *FfiWallet) {
-	value.Destroy()
+func (c FfiConverterOptionalFfiAmount) Lower(value *FfiAmount) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiAmount](c, value)
 }
 
-type FfiAmount struct {
-	Value uint64
+func (_ FfiConverterOptionalFfiAmount) Write(writer io.Writer, value *FfiAmount) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiAmountINSTANCE.Write(writer, *value)
+	}
 }
 
-func (r *FfiAmount) Destroy() {
-	FfiDestroyerUint64{}.Destroy(r.Value)
+type FfiDestroyerOptionalFfiAmount struct{}
+
+func (_ FfiDestroyerOptionalFfiAmount) Destroy(value *FfiAmount) {
+	if value != nil {
+		FfiDestroyerFfiAmount{}.Destroy(*value)
+	}
 }
 
-type FfiConverterFfiAmount struct{}
+type FfiConverterOptionalFfiMelted struct{}
 
-var FfiConverterFfiAmountINSTANCE = FfiConverterFfiAmount{}
+var FfiConverterOptionalFfiMeltedINSTANCE = FfiConverterOptionalFfiMelted{}
 
-func (c FfiConverterFfiAmount) Lift(rb RustBufferI) FfiAmount {
-	return LiftFromRustBuffer[FfiAmount](c, rb)
+func (c FfiConverterOptionalFfiMelted) Lift(rb RustBufferI) *FfiMelted {
+	return LiftFromRustBuffer[*FfiMelted](c, rb)
 }
 
-func (c FfiConverterFfiAmount) Read(reader io.Reader) FfiAmount {
-	return FfiAmount{
-		FfiConverterUint64INSTANCE.Read(reader),
+func (_ FfiConverterOptionalFfiMelted) Read(reader io.Reader) *FfiMelted {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterFfiMeltedINSTANCE.Read(reader)
+	return &temp
 }
 
-func (c FfiConverterFfiAmount) Lower(value FfiAmount) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiAmount](c, value)
+func (c FfiConverterOptionalFfiMelted) Lower(value *FfiMelted) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiMelted](c, value)
 }
 
-func (c FfiConverterFfiAmount) Write(writer io.Writer, value FfiAmount) {
-	FfiConverterUint64INSTANCE.Write(writer, value.Value)
+func (_ FfiConverterOptionalFfiMelted) Write(writer io.Writer, value *FfiMelted) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiMeltedINSTANCE.Write(writer, *value)
+	}
 }
 
-type FfiDestroyerFfiAmount struct{}
+type FfiDestroyerOptionalFfiMelted struct{}
 
-func (_ FfiDestroyerFfiAmount) Destroy(value FfiAmount) {
-	value.Destroy()
+func (_ FfiDestroyerOptionalFfiMelted) Destroy(value *FfiMelted) {
+	if value != nil {
+		FfiDestroyerFfiMelted{}.Destroy(*value)
+	}
 }
 
-type FfiMeltQuote struct {
-	Id              string
-	Unit            string
-	Amount          FfiAmount
-	Request         string
-	FeeReserve      FfiAmount
-	Expiry          uint64
-	PaymentPreimage *string
+type FfiConverterOptionalFfiSendMemo struct{}
+
+var FfiConverterOptionalFfiSendMemoINSTANCE = FfiConverterOptionalFfiSendMemo{}
+
+func (c FfiConverterOptionalFfiSendMemo) Lift(rb RustBufferI) *FfiSendMemo {
+	return LiftFromRustBuffer[*FfiSendMemo](c, rb)
+}
+
+func (_ FfiConverterOptionalFfiSendMemo) Read(reader io.Reader) *FfiSendMemo {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterFfiSendMemoINSTANCE.Read(reader)
+	return &temp
+}
+
+func (c FfiConverterOptionalFfiSendMemo) Lower(value *FfiSendMemo) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiSendMemo](c, value)
+}
+
+func (_ FfiConverterOptionalFfiSendMemo) Write(writer io.Writer, value *FfiSendMemo) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiSendMemoINSTANCE.Write(writer, *value)
+	}
 }
 
-func (r *FfiMeltQuote) Destroy() {
-	FfiDestroyerString{}.Destroy(r.Id)
-	FfiDestroyerString{}.Destroy(r.Unit)
-	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
-	FfiDestroyerString{}.Destroy(r.Request)
-	FfiDestroyerFfiAmount{}.Destroy(r.FeeReserve)
-	FfiDestroyerUint64{}.Destroy(r.Expiry)
-	FfiDestroyerOptionalString{}.Destroy(r.PaymentPreimage)
+type FfiDestroyerOptionalFfiSendMemo struct{}
+
+func (_ FfiDestroyerOptionalFfiSendMemo) Destroy(value *FfiSendMemo) {
+	if value != nil {
+		FfiDestroyerFfiSendMemo{}.Destroy(*value)
+	}
 }
 
-type FfiConverterFfiMeltQuote struct{}
+type FfiConverterOptionalFfiTransactionFilter struct{}
 
-var FfiConverterFfiMeltQuoteINSTANCE = FfiConverterFfiMeltQuote{}
+var FfiConverterOptionalFfiTransactionFilterINSTANCE = FfiConverterOptionalFfiTransactionFilter{}
 
-func (c FfiConverterFfiMeltQuote) Lift(rb RustBufferI) FfiMeltQuote {
-	return LiftFromRustBuffer[FfiMeltQuote](c, rb)
+func (c FfiConverterOptionalFfiTransactionFilter) Lift(rb RustBufferI) *FfiTransactionFilter {
+	return LiftFromRustBuffer[*FfiTransactionFilter](c, rb)
 }
 
-func (c FfiConverterFfiMeltQuote) Read(reader io.Reader) FfiMeltQuote {
-	return FfiMeltQuote{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterUint64INSTANCE.Read(reader),
-		FfiConverterOptionalStringINSTANCE.Read(reader),
+func (_ FfiConverterOptionalFfiTransactionFilter) Read(reader io.Reader) *FfiTransactionFilter {
+	if readInt8(reader) == 0 {
+		return nil
 	}
+	temp := FfiConverterFfiTransactionFilterINSTANCE.Read(reader)
+	return &temp
 }
 
-func (c FfiConverterFfiMeltQuote) Lower(value FfiMeltQuote) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiMeltQuote](c, value)
+func (c FfiConverterOptionalFfiTransactionFilter) Lower(value *FfiTransactionFilter) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiTransactionFilter](c, value)
 }
 
-func (c FfiConverterFfiMeltQuote) Write(writer io.Writer, value FfiMeltQuote) {
-	FfiConverterStringINSTANCE.Write(writer, value.Id)
-	FfiConverterStringINSTANCE.Write(writer, value.Unit)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
-	FfiConverterStringINSTANCE.Write(writer, value.Request)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.FeeReserve)
-	FfiConverterUint64INSTANCE.Write(writer, value.Expiry)
-	FfiConverterOptionalStringINSTANCE.Write(writer, value.PaymentPreimage)
+func (_ FfiConverterOptionalFfiTransactionFilter) Write(writer io.Writer, value *FfiTransactionFilter) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiTransactionFilterINSTANCE.Write(writer, *value)
+	}
 }
 
-type FfiDestroyerFfiMeltQuote struct{}
+type FfiDestroyerOptionalFfiTransactionFilter struct{}
 
-func (_ FfiDestroyerFfiMeltQuote) Destroy(value FfiMeltQuote) {
-	value.Destroy()
+func (_ FfiDestroyerOptionalFfiTransactionFilter) Destroy(value *FfiTransactionFilter) {
+	if value != nil {
+		FfiDestroyerFfiTransactionFilter{}.Destroy(*value)
+	}
 }
 
-type FfiMelted struct {
-	State    string
-	Preimage *string
-	Amount   FfiAmount
-	FeePaid  FfiAmount
-}
+type FfiConverterOptionalFfiProofStateFilter struct{}
 
-func (r *FfiMelted) Destroy() {
-	FfiDestroyerString{}.Destroy(r.State)
-	FfiDestroyerOptionalString{}.Destroy(r.Preimage)
-	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
-	FfiDestroyerFfiAmount{}.Destroy(r.FeePaid)
-}
+var FfiConverterOptionalFfiProofStateFilterINSTANCE = FfiConverterOptionalFfiProofStateFilter{}
 
-type FfiConverterFfiMelted struct{}
+func (c FfiConverterOptionalFfiProofStateFilter) Lift(rb RustBufferI) *FfiProofStateFilter {
+	return LiftFromRustBuffer[*FfiProofStateFilter](c, rb)
+}
 
-var FfiConverterFfiMeltedINSTANCE = FfiConverterFfiMelted{}
+func (_ FfiConverterOptionalFfiProofStateFilter) Read(reader io.Reader) *FfiProofStateFilter {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterFfiProofStateFilterINSTANCE.Read(reader)
+	return &temp
+}
 
-func (c FfiConverterFfiMelted) Lift(rb RustBufferI) FfiMelted {
-	return LiftFromRustBuffer[FfiMelted](c, rb)
+func (c FfiConverterOptionalFfiProofStateFilter) Lower(value *FfiProofStateFilter) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiProofStateFilter](c, value)
 }
 
-func (c FfiConverterFfiMelted) Read(reader io.Reader) FfiMelted {
-	return FfiMelted{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterOptionalStringINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
+func (_ FfiConverterOptionalFfiProofStateFilter) Write(writer io.Writer, value *FfiProofStateFilter) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiProofStateFilterINSTANCE.Write(writer, *value)
 	}
 }
 
-func (c FfiConverterFfiMelted) Lower(value FfiMelted) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiMelted](c, value)
-}
+type FfiDestroyerOptionalFfiProofStateFilter struct{}
 
-func (c FfiConverterFfiMelted) Write(writer io.Writer, value FfiMelted) {
-	FfiConverterStringINSTANCE.Write(writer, value.State)
-	FfiConverterOptionalStringINSTANCE.Write(writer, value.Preimage)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.FeePaid)
+func (_ FfiDestroyerOptionalFfiProofStateFilter) Destroy(value *FfiProofStateFilter) {
+	if value != nil {
+		FfiDestroyerFfiProofStateFilter{}.Destroy(*value)
+	}
 }
 
-type FfiDestroyerFfiMelted struct{}
+type FfiConverterOptionalFfiTransactionDirection struct{}
 
-func (_ FfiDestroyerFfiMelted) Destroy(value FfiMelted) {
-	value.Destroy()
+var FfiConverterOptionalFfiTransactionDirectionINSTANCE = FfiConverterOptionalFfiTransactionDirection{}
+
+func (c FfiConverterOptionalFfiTransactionDirection) Lift(rb RustBufferI) *FfiTransactionDirection {
+	return LiftFromRustBuffer[*FfiTransactionDirection](c, rb)
 }
 
-type FfiMintQuote struct {
-	Id      string
-	MintUrl string
-	Amount  FfiAmount
-	Unit    string
-	Request string
-	State   FfiMintQuoteState
-	Expiry  uint64
+func (_ FfiConverterOptionalFfiTransactionDirection) Read(reader io.Reader) *FfiTransactionDirection {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterFfiTransactionDirectionINSTANCE.Read(reader)
+	return &temp
 }
 
-func (r *FfiMintQuote) Destroy() {
-	FfiDestroyerString{}.Destroy(r.Id)
-	FfiDestroyerString{}.Destroy(r.MintUrl)
-	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
-	FfiDestroyerString{}.Destroy(r.Unit)
-	FfiDestroyerString{}.Destroy(r.Request)
-	FfiDestroyerFfiMintQuoteState{}.Destroy(r.State)
-	FfiDestroyerUint64{}.Destroy(r.Expiry)
+func (c FfiConverterOptionalFfiTransactionDirection) Lower(value *FfiTransactionDirection) C.RustBuffer {
+	return LowerIntoRustBuffer[*FfiTransactionDirection](c, value)
 }
 
-type FfiConverterFfiMintQuote struct{}
+func (_ FfiConverterOptionalFfiTransactionDirection) Write(writer io.Writer, value *FfiTransactionDirection) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterFfiTransactionDirectionINSTANCE.Write(writer, *value)
+	}
+}
 
-var FfiConverterFfiMintQuoteINSTANCE = FfiConverterFfiMintQuote{}
+type FfiDestroyerOptionalFfiTransactionDirection struct{}
 
-func (c FfiConverterFfiMintQuote) Lift(rb RustBufferI) FfiMintQuote {
-	return LiftFromRustBuffer[FfiMintQuote](c, rb)
+func (_ FfiDestroyerOptionalFfiTransactionDirection) Destroy(value *FfiTransactionDirection) {
+	if value != nil {
+		FfiDestroyerFfiTransactionDirection{}.Destroy(*value)
+	}
 }
 
-func (c FfiConverterFfiMintQuote) Read(reader io.Reader) FfiMintQuote {
-	return FfiMintQuote{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterFfiMintQuoteStateINSTANCE.Read(reader),
-		FfiConverterUint64INSTANCE.Read(reader),
-	}
+type FfiConverterSequenceUint16 struct{}
+
+var FfiConverterSequenceUint16INSTANCE = FfiConverterSequenceUint16{}
+
+func (c FfiConverterSequenceUint16) Lift(rb RustBufferI) []uint16 {
+	return LiftFromRustBuffer[[]uint16](c, rb)
 }
 
-func (c FfiConverterFfiMintQuote) Lower(value FfiMintQuote) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiMintQuote](c, value)
+func (c FfiConverterSequenceUint16) Read(reader io.Reader) []uint16 {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]uint16, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterUint16INSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterFfiMintQuote) Write(writer io.Writer, value FfiMintQuote) {
-	FfiConverterStringINSTANCE.Write(writer, value.Id)
-	FfiConverterStringINSTANCE.Write(writer, value.MintUrl)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
-	FfiConverterStringINSTANCE.Write(writer, value.Unit)
-	FfiConverterStringINSTANCE.Write(writer, value.Request)
-	FfiConverterFfiMintQuoteStateINSTANCE.Write(writer, value.State)
-	FfiConverterUint64INSTANCE.Write(writer, value.Expiry)
+func (c FfiConverterSequenceUint16) Lower(value []uint16) C.RustBuffer {
+	return LowerIntoRustBuffer[[]uint16](c, value)
 }
 
-type FfiDestroyerFfiMintQuote struct{}
+func (c FfiConverterSequenceUint16) Write(writer io.Writer, value []uint16) {
+	if len(value) > math.MaxInt32 {
+		panic("[]uint16 is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiMintQuote) Destroy(value FfiMintQuote) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterUint16INSTANCE.Write(writer, item)
+	}
 }
 
-type FfiMintQuoteBolt11Response struct {
-	Quote   string
-	Request string
-	State   FfiMintQuoteState
-	Expiry  *uint64
-}
+type FfiDestroyerSequenceUint16 struct{}
 
-func (r *FfiMintQuoteBolt11Response) Destroy() {
-	FfiDestroyerString{}.Destroy(r.Quote)
-	FfiDestroyerString{}.Destroy(r.Request)
-	FfiDestroyerFfiMintQuoteState{}.Destroy(r.State)
-	FfiDestroyerOptionalUint64{}.Destroy(r.Expiry)
+func (FfiDestroyerSequenceUint16) Destroy(sequence []uint16) {
+	for _, value := range sequence {
+		FfiDestroyerUint16{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiMintQuoteBolt11Response struct{}
+type FfiConverterSequenceUint64 struct{}
 
-var FfiConverterFfiMintQuoteBolt11ResponseINSTANCE = FfiConverterFfiMintQuoteBolt11Response{}
+var FfiConverterSequenceUint64INSTANCE = FfiConverterSequenceUint64{}
 
-func (c FfiConverterFfiMintQuoteBolt11Response) Lift(rb RustBufferI) FfiMintQuoteBolt11Response {
-	return LiftFromRustBuffer[FfiMintQuoteBolt11Response](c, rb)
+func (c FfiConverterSequenceUint64) Lift(rb RustBufferI) []uint64 {
+	return LiftFromRustBuffer[[]uint64](c, rb)
 }
 
-func (c FfiConverterFfiMintQuoteBolt11Response) Read(reader io.Reader) FfiMintQuoteBolt11Response {
-	return FfiMintQuoteBolt11Response{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterFfiMintQuoteStateINSTANCE.Read(reader),
-		FfiConverterOptionalUint64INSTANCE.Read(reader),
+func (c FfiConverterSequenceUint64) Read(reader io.Reader) []uint64 {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]uint64, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterUint64INSTANCE.Read(reader))
 	}
+	return result
 }
 
-func (c FfiConverterFfiMintQuoteBolt11Response) Lower(value FfiMintQuoteBolt11Response) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiMintQuoteBolt11Response](c, value)
+func (c FfiConverterSequenceUint64) Lower(value []uint64) C.RustBuffer {
+	return LowerIntoRustBuffer[[]uint64](c, value)
 }
 
-func (c FfiConverterFfiMintQuoteBolt11Response) Write(writer io.Writer, value FfiMintQuoteBolt11Response) {
-	FfiConverterStringINSTANCE.Write(writer, value.Quote)
-	FfiConverterStringINSTANCE.Write(writer, value.Request)
-	FfiConverterFfiMintQuoteStateINSTANCE.Write(writer, value.State)
-	FfiConverterOptionalUint64INSTANCE.Write(writer, value.Expiry)
+func (c FfiConverterSequenceUint64) Write(writer io.Writer, value []uint64) {
+	if len(value) > math.MaxInt32 {
+		panic("[]uint64 is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterUint64INSTANCE.Write(writer, item)
+	}
 }
 
-type FfiDestroyerFfiMintQuoteBolt11Response struct{}
+type FfiDestroyerSequenceUint64 struct{}
 
-func (_ FfiDestroyerFfiMintQuoteBolt11Response) Destroy(value FfiMintQuoteBolt11Response) {
-	value.Destroy()
+func (FfiDestroyerSequenceUint64) Destroy(sequence []uint64) {
+	for _, value := range sequence {
+		FfiDestroyerUint64{}.Destroy(value)
+	}
 }
 
-type FfiPreparedSend struct {
-	Amount   FfiAmount
-	SwapFee  FfiAmount
-	SendFee  FfiAmount
-	TotalFee FfiAmount
+type FfiConverterSequenceString struct{}
+
+var FfiConverterSequenceStringINSTANCE = FfiConverterSequenceString{}
+
+func (c FfiConverterSequenceString) Lift(rb RustBufferI) []string {
+	return LiftFromRustBuffer[[]string](c, rb)
 }
 
-func (r *FfiPreparedSend) Destroy() {
-	FfiDestroyerFfiAmount{}.Destroy(r.Amount)
-	FfiDestroyerFfiAmount{}.Destroy(r.SwapFee)
-	FfiDestroyerFfiAmount{}.Destroy(r.SendFee)
-	FfiDestroyerFfiAmount{}.Destroy(r.TotalFee)
+func (c FfiConverterSequenceString) Read(reader io.Reader) []string {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]string, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterStringINSTANCE.Read(reader))
+	}
+	return result
 }
 
-type FfiConverterFfiPreparedSend struct{}
+func (c FfiConverterSequenceString) Lower(value []string) C.RustBuffer {
+	return LowerIntoRustBuffer[[]string](c, value)
+}
 
-var FfiConverterFfiPreparedSendINSTANCE = FfiConverterFfiPreparedSend{}
+func (c FfiConverterSequenceString) Write(writer io.Writer, value []string) {
+	if len(value) > math.MaxInt32 {
+		panic("[]string is too large to fit into Int32")
+	}
 
-func (c FfiConverterFfiPreparedSend) Lift(rb RustBufferI) FfiPreparedSend {
-	return LiftFromRustBuffer[FfiPreparedSend](c, rb)
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterStringINSTANCE.Write(writer, item)
+	}
 }
 
-func (c FfiConverterFfiPreparedSend) Read(reader io.Reader) FfiPreparedSend {
-	return FfiPreparedSend{
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
-		FfiConverterFfiAmountINSTANCE.Read(reader),
+type FfiDestroyerSequenceString struct{}
+
+func (FfiDestroyerSequenceString) Destroy(sequence []string) {
+	for _, value := range sequence {
+		FfiDestroyerString{}.Destroy(value)
 	}
 }
 
-func (c FfiConverterFfiPreparedSend) Lower(value FfiPreparedSend) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiPreparedSend](c, value)
+type FfiConverterSequenceFfiAmount struct{}
+
+var FfiConverterSequenceFfiAmountINSTANCE = FfiConverterSequenceFfiAmount{}
+
+func (c FfiConverterSequenceFfiAmount) Lift(rb RustBufferI) []FfiAmount {
+	return LiftFromRustBuffer[[]FfiAmount](c, rb)
 }
 
-func (c FfiConverterFfiPreparedSend) Write(writer io.Writer, value FfiPreparedSend) {
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.Amount)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.SwapFee)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.SendFee)
-	FfiConverterFfiAmountINSTANCE.Write(writer, value.TotalFee)
+func (c FfiConverterSequenceFfiAmount) Read(reader io.Reader) []FfiAmount {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiAmount, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiAmountINSTANCE.Read(reader))
+	}
+	return result
 }
 
-type FfiDestroyerFfiPreparedSend struct{}
+func (c FfiConverterSequenceFfiAmount) Lower(value []FfiAmount) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiAmount](c, value)
+}
+
+func (c FfiConverterSequenceFfiAmount) Write(writer io.Writer, value []FfiAmount) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiAmount is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiPreparedSend) Destroy(value FfiPreparedSend) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiAmountINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiSendMemo struct {
-	Memo        string
-	IncludeMemo bool
-}
+type FfiDestroyerSequenceFfiAmount struct{}
 
-func (r *FfiSendMemo) Destroy() {
-	FfiDestroyerString{}.Destroy(r.Memo)
-	FfiDestroyerBool{}.Destroy(r.IncludeMemo)
+func (FfiDestroyerSequenceFfiAmount) Destroy(sequence []FfiAmount) {
+	for _, value := range sequence {
+		FfiDestroyerFfiAmount{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiSendMemo struct{}
+type FfiConverterSequenceFfiContact struct{}
 
-var FfiConverterFfiSendMemoINSTANCE = FfiConverterFfiSendMemo{}
+var FfiConverterSequenceFfiContactINSTANCE = FfiConverterSequenceFfiContact{}
 
-func (c FfiConverterFfiSendMemo) Lift(rb RustBufferI) FfiSendMemo {
-	return LiftFromRustBuffer[FfiSendMemo](c, rb)
+func (c FfiConverterSequenceFfiContact) Lift(rb RustBufferI) []FfiContact {
+	return LiftFromRustBuffer[[]FfiContact](c, rb)
 }
 
-func (c FfiConverterFfiSendMemo) Read(reader io.Reader) FfiSendMemo {
-	return FfiSendMemo{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterBoolINSTANCE.Read(reader),
+func (c FfiConverterSequenceFfiContact) Read(reader io.Reader) []FfiContact {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
 	}
+	result := make([]FfiContact, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiContactINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterFfiSendMemo) Lower(value FfiSendMemo) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiSendMemo](c, value)
-}
-
-func (c FfiConverterFfiSendMemo) Write(writer io.Writer, value FfiSendMemo) {
-	FfiConverterStringINSTANCE.Write(writer, value.Memo)
-	FfiConverterBoolINSTANCE.Write(writer, value.IncludeMemo)
+func (c FfiConverterSequenceFfiContact) Lower(value []FfiContact) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiContact](c, value)
 }
 
-type FfiDestroyerFfiSendMemo struct{}
+func (c FfiConverterSequenceFfiContact) Write(writer io.Writer, value []FfiContact) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiContact is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiSendMemo) Destroy(value FfiSendMemo) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiContactINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiSendOptions struct {
-	Memo              *FfiSendMemo
-	AmountSplitTarget FfiSplitTarget
-	SendKind          FfiSendKind
-	IncludeFee        bool
-	Metadata          map[string]string
-	MaxProofs         *uint64
-}
+type FfiDestroyerSequenceFfiContact struct{}
 
-func (r *FfiSendOptions) Destroy() {
-	FfiDestroyerOptionalFfiSendMemo{}.Destroy(r.Memo)
-	FfiDestroyerFfiSplitTarget{}.Destroy(r.AmountSplitTarget)
-	FfiDestroyerFfiSendKind{}.Destroy(r.SendKind)
-	FfiDestroyerBool{}.Destroy(r.IncludeFee)
-	FfiDestroyerMapStringString{}.Destroy(r.Metadata)
-	FfiDestroyerOptionalUint64{}.Destroy(r.MaxProofs)
+func (FfiDestroyerSequenceFfiContact) Destroy(sequence []FfiContact) {
+	for _, value := range sequence {
+		FfiDestroyerFfiContact{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiSendOptions struct{}
+type FfiConverterSequenceFfiKeysetInfo struct{}
 
-var FfiConverterFfiSendOptionsINSTANCE = FfiConverterFfiSendOptions{}
+var FfiConverterSequenceFfiKeysetInfoINSTANCE = FfiConverterSequenceFfiKeysetInfo{}
 
-func (c FfiConverterFfiSendOptions) Lift(rb RustBufferI) FfiSendOptions {
-	return LiftFromRustBuffer[FfiSendOptions](c, rb)
+func (c FfiConverterSequenceFfiKeysetInfo) Lift(rb RustBufferI) []FfiKeysetInfo {
+	return LiftFromRustBuffer[[]FfiKeysetInfo](c, rb)
 }
 
-func (c FfiConverterFfiSendOptions) Read(reader io.Reader) FfiSendOptions {
-	return FfiSendOptions{
-		FfiConverterOptionalFfiSendMemoINSTANCE.Read(reader),
-		FfiConverterFfiSplitTargetINSTANCE.Read(reader),
-		FfiConverterFfiSendKindINSTANCE.Read(reader),
-		FfiConverterBoolINSTANCE.Read(reader),
-		FfiConverterMapStringStringINSTANCE.Read(reader),
-		FfiConverterOptionalUint64INSTANCE.Read(reader),
+func (c FfiConverterSequenceFfiKeysetInfo) Read(reader io.Reader) []FfiKeysetInfo {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
 	}
+	result := make([]FfiKeysetInfo, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiKeysetInfoINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterFfiSendOptions) Lower(value FfiSendOptions) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiSendOptions](c, value)
-}
-
-func (c FfiConverterFfiSendOptions) Write(writer io.Writer, value FfiSendOptions) {
-	FfiConverterOptionalFfiSendMemoINSTANCE.Write(writer, value.Memo)
-	FfiConverterFfiSplitTargetINSTANCE.Write(writer, value.AmountSplitTarget)
-	FfiConverterFfiSendKindINSTANCE.Write(writer, value.SendKind)
-	FfiConverterBoolINSTANCE.Write(writer, value.IncludeFee)
-	FfiConverterMapStringStringINSTANCE.Write(writer, value.Metadata)
-	FfiConverterOptionalUint64INSTANCE.Write(writer, value.MaxProofs)
+func (c FfiConverterSequenceFfiKeysetInfo) Lower(value []FfiKeysetInfo) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiKeysetInfo](c, value)
 }
 
-type FfiDestroyerFfiSendOptions struct{}
+func (c FfiConverterSequenceFfiKeysetInfo) Write(writer io.Writer, value []FfiKeysetInfo) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiKeysetInfo is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiSendOptions) Destroy(value FfiSendOptions) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiKeysetInfoINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiToken struct {
-	TokenString string
-	Mint        string
-	Memo        *string
-	Unit        string
-}
+type FfiDestroyerSequenceFfiKeysetInfo struct{}
 
-func (r *FfiToken) Destroy() {
-	FfiDestroyerString{}.Destroy(r.TokenString)
-	FfiDestroyerString{}.Destroy(r.Mint)
-	FfiDestroyerOptionalString{}.Destroy(r.Memo)
-	FfiDestroyerString{}.Destroy(r.Unit)
+func (FfiDestroyerSequenceFfiKeysetInfo) Destroy(sequence []FfiKeysetInfo) {
+	for _, value := range sequence {
+		FfiDestroyerFfiKeysetInfo{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiToken struct{}
+type FfiConverterSequenceFfiMeltBatchEntry struct{}
 
-var FfiConverterFfiTokenINSTANCE = FfiConverterFfiToken{}
+var FfiConverterSequenceFfiMeltBatchEntryINSTANCE = FfiConverterSequenceFfiMeltBatchEntry{}
 
-func (c FfiConverterFfiToken) Lift(rb RustBufferI) FfiToken {
-	return LiftFromRustBuffer[FfiToken](c, rb)
+func (c FfiConverterSequenceFfiMeltBatchEntry) Lift(rb RustBufferI) []FfiMeltBatchEntry {
+	return LiftFromRustBuffer[[]FfiMeltBatchEntry](c, rb)
 }
 
-func (c FfiConverterFfiToken) Read(reader io.Reader) FfiToken {
-	return FfiToken{
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
-		FfiConverterOptionalStringINSTANCE.Read(reader),
-		FfiConverterStringINSTANCE.Read(reader),
+func (c FfiConverterSequenceFfiMeltBatchEntry) Read(reader io.Reader) []FfiMeltBatchEntry {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
 	}
+	result := make([]FfiMeltBatchEntry, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiMeltBatchEntryINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterFfiToken) Lower(value FfiToken) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiToken](c, value)
-}
-
-func (c FfiConverterFfiToken) Write(writer io.Writer, value FfiToken) {
-	FfiConverterStringINSTANCE.Write(writer, value.TokenString)
-	FfiConverterStringINSTANCE.Write(writer, value.Mint)
-	FfiConverterOptionalStringINSTANCE.Write(writer, value.Memo)
-	FfiConverterStringINSTANCE.Write(writer, value.Unit)
+func (c FfiConverterSequenceFfiMeltBatchEntry) Lower(value []FfiMeltBatchEntry) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiMeltBatchEntry](c, value)
 }
 
-type FfiDestroyerFfiToken struct{}
+func (c FfiConverterSequenceFfiMeltBatchEntry) Write(writer io.Writer, value []FfiMeltBatchEntry) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiMeltBatchEntry is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiToken) Destroy(value FfiToken) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiMeltBatchEntryINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiCurrencyUnit uint
+type FfiDestroyerSequenceFfiMeltBatchEntry struct{}
 
-const (
-	FfiCurrencyUnitSat  FfiCurrencyUnit = 1
-	FfiCurrencyUnitMsat FfiCurrencyUnit = 2
-	FfiCurrencyUnitUsd  FfiCurrencyUnit = 3
-	FfiCurrencyUnitEur  FfiCurrencyUnit = 4
-)
+func (FfiDestroyerSequenceFfiMeltBatchEntry) Destroy(sequence []FfiMeltBatchEntry) {
+	for _, value := range sequence {
+		FfiDestroyerFfiMeltBatchEntry{}.Destroy(value)
+	}
+}
 
-type FfiConverterFfiCurrencyUnit struct{}
+type FfiConverterSequenceFfiMeltQuote struct{}
 
-var FfiConverterFfiCurrencyUnitINSTANCE = FfiConverterFfiCurrencyUnit{}
+var FfiConverterSequenceFfiMeltQuoteINSTANCE = FfiConverterSequenceFfiMeltQuote{}
 
-func (c FfiConverterFfiCurrencyUnit) Lift(rb RustBufferI) FfiCurrencyUnit {
-	return LiftFromRustBuffer[FfiCurrencyUnit](c, rb)
+func (c FfiConverterSequenceFfiMeltQuote) Lift(rb RustBufferI) []FfiMeltQuote {
+	return LiftFromRustBuffer[[]FfiMeltQuote](c, rb)
 }
 
-func (c FfiConverterFfiCurrencyUnit) Lower(value FfiCurrencyUnit) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiCurrencyUnit](c, value)
+func (c FfiConverterSequenceFfiMeltQuote) Read(reader io.Reader) []FfiMeltQuote {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiMeltQuote, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiMeltQuoteINSTANCE.Read(reader))
+	}
+	return result
 }
-func (FfiConverterFfiCurrencyUnit) Read(reader io.Reader) FfiCurrencyUnit {
-	id := readInt32(reader)
-	return FfiCurrencyUnit(id)
+
+func (c FfiConverterSequenceFfiMeltQuote) Lower(value []FfiMeltQuote) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiMeltQuote](c, value)
 }
 
-func (FfiConverterFfiCurrencyUnit) Write(writer io.Writer, value FfiCurrencyUnit) {
-	writeInt32(writer, int32(value))
+func (c FfiConverterSequenceFfiMeltQuote) Write(writer io.Writer, value []FfiMeltQuote) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiMeltQuote is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiMeltQuoteINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiDestroyerFfiCurrencyUnit struct{}
+type FfiDestroyerSequenceFfiMeltQuote struct{}
 
-func (_ FfiDestroyerFfiCurrencyUnit) Destroy(value FfiCurrencyUnit) {
+func (FfiDestroyerSequenceFfiMeltQuote) Destroy(sequence []FfiMeltQuote) {
+	for _, value := range sequence {
+		FfiDestroyerFfiMeltQuote{}.Destroy(value)
+	}
 }
 
-type FfiError struct {
-	err error
+type FfiConverterSequenceFfiMintQuote struct{}
+
+var FfiConverterSequenceFfiMintQuoteINSTANCE = FfiConverterSequenceFfiMintQuote{}
+
+func (c FfiConverterSequenceFfiMintQuote) Lift(rb RustBufferI) []FfiMintQuote {
+	return LiftFromRustBuffer[[]FfiMintQuote](c, rb)
 }
 
-// Convience method to turn *FfiError into error
-// Avoiding treating nil pointer as non nil error interface
-func (err *FfiError) AsError() error {
-	if err == nil {
+func (c FfiConverterSequenceFfiMintQuote) Read(reader io.Reader) []FfiMintQuote {
+	length := readInt32(reader)
+	if length == 0 {
 		return nil
-	} else {
-		return err
 	}
+	result := make([]FfiMintQuote, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiMintQuoteINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (err FfiError) Error() string {
-	return fmt.Sprintf("FfiError: %s", err.err.Error())
-}
-
-func (err FfiError) Unwrap() error {
-	return err.err
+func (c FfiConverterSequenceFfiMintQuote) Lower(value []FfiMintQuote) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiMintQuote](c, value)
 }
 
-// Err* are used for checking error type with `errors.Is`
-var ErrFfiErrorWalletError = fmt.Errorf("FfiErrorWalletError")
-var ErrFfiErrorInvalidInput = fmt.Errorf("FfiErrorInvalidInput")
-var ErrFfiErrorNetworkError = fmt.Errorf("FfiErrorNetworkError")
-var ErrFfiErrorInternalError = fmt.Errorf("FfiErrorInternalError")
+func (c FfiConverterSequenceFfiMintQuote) Write(writer io.Writer, value []FfiMintQuote) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiMintQuote is too large to fit into Int32")
+	}
 
-// Variant structs
-type FfiErrorWalletError struct {
-	Msg string
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiMintQuoteINSTANCE.Write(writer, item)
+	}
 }
 
-func NewFfiErrorWalletError(
-	msg string,
-) *FfiError {
-	return &FfiError{err: &FfiErrorWalletError{
-		Msg: msg}}
-}
+type FfiDestroyerSequenceFfiMintQuote struct{}
 
-func (e FfiErrorWalletError) destroy() {
-	FfiDestroyerString{}.Destroy(e.Msg)
+func (FfiDestroyerSequenceFfiMintQuote) Destroy(sequence []FfiMintQuote) {
+	for _, value := range sequence {
+		FfiDestroyerFfiMintQuote{}.Destroy(value)
+	}
 }
 
-func (err FfiErrorWalletError) Error() string {
-	return fmt.Sprint("WalletError",
-		": ",
+type FfiConverterSequenceFfiMintQuoteBolt11Response struct{}
 
-		"Msg=",
-		err.Msg,
-	)
-}
+var FfiConverterSequenceFfiMintQuoteBolt11ResponseINSTANCE = FfiConverterSequenceFfiMintQuoteBolt11Response{}
 
-func (self FfiErrorWalletError) Is(target error) bool {
-	return target == ErrFfiErrorWalletError
+func (c FfiConverterSequenceFfiMintQuoteBolt11Response) Lift(rb RustBufferI) []FfiMintQuoteBolt11Response {
+	return LiftFromRustBuffer[[]FfiMintQuoteBolt11Response](c, rb)
 }
 
-type FfiErrorInvalidInput struct {
-	Msg string
+func (c FfiConverterSequenceFfiMintQuoteBolt11Response) Read(reader io.Reader) []FfiMintQuoteBolt11Response {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiMintQuoteBolt11Response, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiMintQuoteBolt11ResponseINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func NewFfiErrorInvalidInput(
-	msg string,
-) *FfiError {
-	return &FfiError{err: &FfiErrorInvalidInput{
-		Msg: msg}}
+func (c FfiConverterSequenceFfiMintQuoteBolt11Response) Lower(value []FfiMintQuoteBolt11Response) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiMintQuoteBolt11Response](c, value)
 }
 
-func (e FfiErrorInvalidInput) destroy() {
-	FfiDestroyerString{}.Destroy(e.Msg)
+func (c FfiConverterSequenceFfiMintQuoteBolt11Response) Write(writer io.Writer, value []FfiMintQuoteBolt11Response) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiMintQuoteBolt11Response is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiMintQuoteBolt11ResponseINSTANCE.Write(writer, item)
+	}
 }
 
-func (err FfiErrorInvalidInput) Error() string {
-	return fmt.Sprint("InvalidInput",
-		": ",
+type FfiDestroyerSequenceFfiMintQuoteBolt11Response struct{}
 
-		"Msg=",
-		err.Msg,
-	)
+func (FfiDestroyerSequenceFfiMintQuoteBolt11Response) Destroy(sequence []FfiMintQuoteBolt11Response) {
+	for _, value := range sequence {
+		FfiDestroyerFfiMintQuoteBolt11Response{}.Destroy(value)
+	}
 }
 
-func (self FfiErrorInvalidInput) Is(target error) bool {
-	return target == ErrFfiErrorInvalidInput
-}
+type FfiConverterSequenceFfiProof struct{}
 
-type FfiErrorNetworkError struct {
-	Msg string
+var FfiConverterSequenceFfiProofINSTANCE = FfiConverterSequenceFfiProof{}
+
+func (c FfiConverterSequenceFfiProof) Lift(rb RustBufferI) []FfiProof {
+	return LiftFromRustBuffer[[]FfiProof](c, rb)
 }
 
-func NewFfiErrorNetworkError(
-	msg string,
-) *FfiError {
-	return &FfiError{err: &FfiErrorNetworkError{
-		Msg: msg}}
+func (c FfiConverterSequenceFfiProof) Read(reader io.Reader) []FfiProof {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiProof, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiProofINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (e FfiErrorNetworkError) destroy() {
-	FfiDestroyerString{}.Destroy(e.Msg)
+func (c FfiConverterSequenceFfiProof) Lower(value []FfiProof) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiProof](c, value)
 }
 
-func (err FfiErrorNetworkError) Error() string {
-	return fmt.Sprint("NetworkError",
-		": ",
+func (c FfiConverterSequenceFfiProof) Write(writer io.Writer, value []FfiProof) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiProof is too large to fit into Int32")
+	}
 
-		"Msg=",
-		err.Msg,
-	)
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiProofINSTANCE.Write(writer, item)
+	}
 }
 
-func (self FfiErrorNetworkError) Is(target error) bool {
-	return target == ErrFfiErrorNetworkError
+type FfiDestroyerSequenceFfiProof struct{}
+
+func (FfiDestroyerSequenceFfiProof) Destroy(sequence []FfiProof) {
+	for _, value := range sequence {
+		FfiDestroyerFfiProof{}.Destroy(value)
+	}
 }
 
-type FfiErrorInternalError struct {
-	Msg string
+type FfiConverterSequenceFfiProofState struct{}
+
+var FfiConverterSequenceFfiProofStateINSTANCE = FfiConverterSequenceFfiProofState{}
+
+func (c FfiConverterSequenceFfiProofState) Lift(rb RustBufferI) []FfiProofState {
+	return LiftFromRustBuffer[[]FfiProofState](c, rb)
 }
 
-func NewFfiErrorInternalError(
-	msg string,
-) *FfiError {
-	return &FfiError{err: &FfiErrorInternalError{
-		Msg: msg}}
+func (c FfiConverterSequenceFfiProofState) Read(reader io.Reader) []FfiProofState {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiProofState, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiProofStateINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (e FfiErrorInternalError) destroy() {
-	FfiDestroyerString{}.Destroy(e.Msg)
+func (c FfiConverterSequenceFfiProofState) Lower(value []FfiProofState) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiProofState](c, value)
 }
 
-func (err FfiErrorInternalError) Error() string {
-	return fmt.Sprint("InternalError",
-		": ",
+func (c FfiConverterSequenceFfiProofState) Write(writer io.Writer, value []FfiProofState) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiProofState is too large to fit into Int32")
+	}
 
-		"Msg=",
-		err.Msg,
-	)
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiProofStateINSTANCE.Write(writer, item)
+	}
 }
 
-func (self FfiErrorInternalError) Is(target error) bool {
-	return target == ErrFfiErrorInternalError
+type FfiDestroyerSequenceFfiProofState struct{}
+
+func (FfiDestroyerSequenceFfiProofState) Destroy(sequence []FfiProofState) {
+	for _, value := range sequence {
+		FfiDestroyerFfiProofState{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiError struct{}
+type FfiConverterSequenceFfiQuoteExpiry struct{}
 
-var FfiConverterFfiErrorINSTANCE = FfiConverterFfiError{}
+var FfiConverterSequenceFfiQuoteExpiryINSTANCE = FfiConverterSequenceFfiQuoteExpiry{}
 
-func (c FfiConverterFfiError) Lift(eb RustBufferI) *FfiError {
-	return LiftFromRustBuffer[*FfiError](c, eb)
+func (c FfiConverterSequenceFfiQuoteExpiry) Lift(rb RustBufferI) []FfiQuoteExpiry {
+	return LiftFromRustBuffer[[]FfiQuoteExpiry](c, rb)
 }
 
-func (c FfiConverterFfiError) Lower(value *FfiError) C.RustBuffer {
-	return LowerIntoRustBuffer[*FfiError](c, value)
+func (c FfiConverterSequenceFfiQuoteExpiry) Read(reader io.Reader) []FfiQuoteExpiry {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiQuoteExpiry, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiQuoteExpiryINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterFfiError) Read(reader io.Reader) *FfiError {
-	errorID := readUint32(reader)
+func (c FfiConverterSequenceFfiQuoteExpiry) Lower(value []FfiQuoteExpiry) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiQuoteExpiry](c, value)
+}
 
-	switch errorID {
-	case 1:
-		return &FfiError{&FfiErrorWalletError{
-			Msg: FfiConverterStringINSTANCE.Read(reader),
-		}}
-	case 2:
-		return &FfiError{&FfiErrorInvalidInput{
-			Msg: FfiConverterStringINSTANCE.Read(reader),
-		}}
-	case 3:
-		return &FfiError{&FfiErrorNetworkError{
-			Msg: FfiConverterStringINSTANCE.Read(reader),
-		}}
-	case 4:
-		return &FfiError{&FfiErrorInternalError{
-			Msg: FfiConverterStringINSTANCE.Read(reader),
-		}}
-	default:
-		panic(fmt.Sprintf("Unknown error code %d in FfiConverterFfiError.Read()", errorID))
+func (c FfiConverterSequenceFfiQuoteExpiry) Write(writer io.Writer, value []FfiQuoteExpiry) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiQuoteExpiry is too large to fit into Int32")
 	}
-}
 
-func (c FfiConverterFfiError) Write(writer io.Writer, value *FfiError) {
-	switch variantValue := value.err.(type) {
-	case *FfiErrorWalletError:
-		writeInt32(writer, 1)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
-	case *FfiErrorInvalidInput:
-		writeInt32(writer, 2)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
-	case *FfiErrorNetworkError:
-		writeInt32(writer, 3)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
-	case *FfiErrorInternalError:
-		writeInt32(writer, 4)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Msg)
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterFfiError.Write", value))
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiQuoteExpiryINSTANCE.Write(writer, item)
 	}
 }
 
-type FfiDestroyerFfiError struct{}
+type FfiDestroyerSequenceFfiQuoteExpiry struct{}
 
-func (_ FfiDestroyerFfiError) Destroy(value *FfiError) {
-	switch variantValue := value.err.(type) {
-	case FfiErrorWalletError:
-		variantValue.destroy()
-	case FfiErrorInvalidInput:
-		variantValue.destroy()
-	case FfiErrorNetworkError:
-		variantValue.destroy()
-	case FfiErrorInternalError:
-		variantValue.destroy()
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFfiError.Destroy", value))
+func (FfiDestroyerSequenceFfiQuoteExpiry) Destroy(sequence []FfiQuoteExpiry) {
+	for _, value := range sequence {
+		FfiDestroyerFfiQuoteExpiry{}.Destroy(value)
 	}
 }
 
-type FfiMintQuoteState uint
-
-const (
-	FfiMintQuoteStateUnpaid FfiMintQuoteState = 1
-	FfiMintQuoteStatePaid   FfiMintQuoteState = 2
-	FfiMintQuoteStateIssued FfiMintQuoteState = 3
-)
-
-type FfiConverterFfiMintQuoteState struct{}
+type FfiConverterSequenceFfiReceiveError struct{}
 
-var FfiConverterFfiMintQuoteStateINSTANCE = FfiConverterFfiMintQuoteState{}
+var FfiConverterSequenceFfiReceiveErrorINSTANCE = FfiConverterSequenceFfiReceiveError{}
 
-func (c FfiConverterFfiMintQuoteState) Lift(rb RustBufferI) FfiMintQuoteState {
-	return LiftFromRustBuffer[FfiMintQuoteState](c, rb)
+func (c FfiConverterSequenceFfiReceiveError) Lift(rb RustBufferI) []FfiReceiveError {
+	return LiftFromRustBuffer[[]FfiReceiveError](c, rb)
 }
 
-func (c FfiConverterFfiMintQuoteState) Lower(value FfiMintQuoteState) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiMintQuoteState](c, value)
-}
-func (FfiConverterFfiMintQuoteState) Read(reader io.Reader) FfiMintQuoteState {
-	id := readInt32(reader)
-	return FfiMintQuoteState(id)
+func (c FfiConverterSequenceFfiReceiveError) Read(reader io.Reader) []FfiReceiveError {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiReceiveError, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiReceiveErrorINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (FfiConverterFfiMintQuoteState) Write(writer io.Writer, value FfiMintQuoteState) {
-	writeInt32(writer, int32(value))
+func (c FfiConverterSequenceFfiReceiveError) Lower(value []FfiReceiveError) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiReceiveError](c, value)
 }
 
-type FfiDestroyerFfiMintQuoteState struct{}
+func (c FfiConverterSequenceFfiReceiveError) Write(writer io.Writer, value []FfiReceiveError) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiReceiveError is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiMintQuoteState) Destroy(value FfiMintQuoteState) {
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiReceiveErrorINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiSendKind interface {
-	Destroy()
-}
-type FfiSendKindOnlineExact struct {
-}
+type FfiDestroyerSequenceFfiReceiveError struct{}
 
-func (e FfiSendKindOnlineExact) Destroy() {
+func (FfiDestroyerSequenceFfiReceiveError) Destroy(sequence []FfiReceiveError) {
+	for _, value := range sequence {
+		FfiDestroyerFfiReceiveError{}.Destroy(value)
+	}
 }
 
-type FfiSendKindOnlineTolerance struct {
-	Tolerance FfiAmount
-}
+type FfiConverterSequenceFfiTransaction struct{}
 
-func (e FfiSendKindOnlineTolerance) Destroy() {
-	FfiDestroyerFfiAmount{}.Destroy(e.Tolerance)
+var FfiConverterSequenceFfiTransactionINSTANCE = FfiConverterSequenceFfiTransaction{}
+
+func (c FfiConverterSequenceFfiTransaction) Lift(rb RustBufferI) []FfiTransaction {
+	return LiftFromRustBuffer[[]FfiTransaction](c, rb)
 }
 
-type FfiSendKindOfflineExact struct {
+func (c FfiConverterSequenceFfiTransaction) Read(reader io.Reader) []FfiTransaction {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FfiTransaction, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiTransactionINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (e FfiSendKindOfflineExact) Destroy() {
+func (c FfiConverterSequenceFfiTransaction) Lower(value []FfiTransaction) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiTransaction](c, value)
 }
 
-type FfiSendKindOfflineTolerance struct {
-	Tolerance FfiAmount
+func (c FfiConverterSequenceFfiTransaction) Write(writer io.Writer, value []FfiTransaction) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiTransaction is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiTransactionINSTANCE.Write(writer, item)
+	}
 }
 
-func (e FfiSendKindOfflineTolerance) Destroy() {
-	FfiDestroyerFfiAmount{}.Destroy(e.Tolerance)
+type FfiDestroyerSequenceFfiTransaction struct{}
+
+func (FfiDestroyerSequenceFfiTransaction) Destroy(sequence []FfiTransaction) {
+	for _, value := range sequence {
+		FfiDestroyerFfiTransaction{}.Destroy(value)
+	}
 }
 
-type FfiConverterFfiSendKind struct{}
+type FfiConverterSequenceFfiTransport struct{}
 
-var FfiConverterFfiSendKindINSTANCE = FfiConverterFfiSendKind{}
+var FfiConverterSequenceFfiTransportINSTANCE = FfiConverterSequenceFfiTransport{}
 
-func (c FfiConverterFfiSendKind) Lift(rb RustBufferI) FfiSendKind {
-	return LiftFromRustBuffer[FfiSendKind](c, rb)
+func (c FfiConverterSequenceFfiTransport) Lift(rb RustBufferI) []FfiTransport {
+	return LiftFromRustBuffer[[]FfiTransport](c, rb)
 }
 
-func (c FfiConverterFfiSendKind) Lower(value FfiSendKind) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiSendKind](c, value)
-}
-func (FfiConverterFfiSendKind) Read(reader io.Reader) FfiSendKind {
-	id := readInt32(reader)
-	switch id {
-	case 1:
-		return FfiSendKindOnlineExact{}
-	case 2:
-		return FfiSendKindOnlineTolerance{
-			FfiConverterFfiAmountINSTANCE.Read(reader),
-		}
-	case 3:
-		return FfiSendKindOfflineExact{}
-	case 4:
-		return FfiSendKindOfflineTolerance{
-			FfiConverterFfiAmountINSTANCE.Read(reader),
-		}
-	default:
-		panic(fmt.Sprintf("invalid enum value %v in FfiConverterFfiSendKind.Read()", id))
+func (c FfiConverterSequenceFfiTransport) Read(reader io.Reader) []FfiTransport {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
 	}
+	result := make([]FfiTransport, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFfiTransportINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (FfiConverterFfiSendKind) Write(writer io.Writer, value FfiSendKind) {
-	switch variant_value := value.(type) {
-	case FfiSendKindOnlineExact:
-		writeInt32(writer, 1)
-	case FfiSendKindOnlineTolerance:
-		writeInt32(writer, 2)
-		FfiConverterFfiAmountINSTANCE.Write(writer, variant_value.Tolerance)
-	case FfiSendKindOfflineExact:
-		writeInt32(writer, 3)
-	case FfiSendKindOfflineTolerance:
-		writeInt32(writer, 4)
-		FfiConverterFfiAmountINSTANCE.Write(writer, variant_value.Tolerance)
-	default:
-		_ = variant_value
-		panic(fmt.Sprintf("invalid enum value `%v` in FfiConverterFfiSendKind.Write", value))
-	}
+func (c FfiConverterSequenceFfiTransport) Lower(value []FfiTransport) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FfiTransport](c, value)
 }
 
-type FfiDestroyerFfiSendKind struct{}
+func (c FfiConverterSequenceFfiTransport) Write(writer io.Writer, value []FfiTransport) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FfiTransport is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiSendKind) Destroy(value FfiSendKind) {
-	value.Destroy()
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFfiTransportINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiSplitTarget uint
+type FfiDestroyerSequenceFfiTransport struct{}
 
-const (
-	FfiSplitTargetNone    FfiSplitTarget = 1
-	FfiSplitTargetDefault FfiSplitTarget = 2
-)
+func (FfiDestroyerSequenceFfiTransport) Destroy(sequence []FfiTransport) {
+	for _, value := range sequence {
+		FfiDestroyerFfiTransport{}.Destroy(value)
+	}
+}
 
-type FfiConverterFfiSplitTarget struct{}
+type FfiConverterSequenceSequenceString struct{}
 
-var FfiConverterFfiSplitTargetINSTANCE = FfiConverterFfiSplitTarget{}
+var FfiConverterSequenceSequenceStringINSTANCE = FfiConverterSequenceSequenceString{}
 
-func (c FfiConverterFfiSplitTarget) Lift(rb RustBufferI) FfiSplitTarget {
-	return LiftFromRustBuffer[FfiSplitTarget](c, rb)
+func (c FfiConverterSequenceSequenceString) Lift(rb RustBufferI) [][]string {
+	return LiftFromRustBuffer[[][]string](c, rb)
 }
 
-func (c FfiConverterFfiSplitTarget) Lower(value FfiSplitTarget) C.RustBuffer {
-	return LowerIntoRustBuffer[FfiSplitTarget](c, value)
-}
-func (FfiConverterFfiSplitTarget) Read(reader io.Reader) FfiSplitTarget {
-	id := readInt32(reader)
-	return FfiSplitTarget(id)
+func (c FfiConverterSequenceSequenceString) Read(reader io.Reader) [][]string {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([][]string, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterSequenceStringINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (FfiConverterFfiSplitTarget) Write(writer io.Writer, value FfiSplitTarget) {
-	writeInt32(writer, int32(value))
+func (c FfiConverterSequenceSequenceString) Lower(value [][]string) C.RustBuffer {
+	return LowerIntoRustBuffer[[][]string](c, value)
 }
 
-type FfiDestroyerFfiSplitTarget struct{}
+func (c FfiConverterSequenceSequenceString) Write(writer io.Writer, value [][]string) {
+	if len(value) > math.MaxInt32 {
+		panic("[][]string is too large to fit into Int32")
+	}
 
-func (_ FfiDestroyerFfiSplitTarget) Destroy(value FfiSplitTarget) {
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterSequenceStringINSTANCE.Write(writer, item)
+	}
 }
 
-type FfiConverterOptionalUint64 struct{}
-
-var FfiConverterOptionalUint64INSTANCE = FfiConverterOptionalUint64{}
+type FfiDestroyerSequenceSequenceString struct{}
 
-func (c FfiConverterOptionalUint64) Lift(rb RustBufferI) *uint64 {
-	return LiftFromRustBuffer[*uint64](c, rb)
+func (FfiDestroyerSequenceSequenceString) Destroy(sequence [][]string) {
+	for _, value := range sequence {
+		FfiDestroyerSequenceString{}.Destroy(value)
+	}
 }
 
-func (_ FfiConverterOptionalUint64) Read(reader io.Reader) *uint64 {
-	if readInt8(reader) == 0 {
-		return nil
+type FfiConverterMapUint64Uint32 struct{}
+
+var FfiConverterMapUint64Uint32INSTANCE = FfiConverterMapUint64Uint32{}
+
+func (c FfiConverterMapUint64Uint32) Lift(rb RustBufferI) map[uint64]uint32 {
+	return LiftFromRustBuffer[map[uint64]uint32](c, rb)
+}
+
+func (_ FfiConverterMapUint64Uint32) Read(reader io.Reader) map[uint64]uint32 {
+	result := make(map[uint64]uint32)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterUint64INSTANCE.Read(reader)
+		value := FfiConverterUint32INSTANCE.Read(reader)
+		result[key] = value
 	}
-	temp := FfiConverterUint64INSTANCE.Read(reader)
-	return &temp
+	return result
 }
 
-func (c FfiConverterOptionalUint64) Lower(value *uint64) C.RustBuffer {
-	return LowerIntoRustBuffer[*uint64](c, value)
+func (c FfiConverterMapUint64Uint32) Lower(value map[uint64]uint32) C.RustBuffer {
+	return LowerIntoRustBuffer[map[uint64]uint32](c, value)
 }
 
-func (_ FfiConverterOptionalUint64) Write(writer io.Writer, value *uint64) {
-	if value == nil {
-		writeInt8(writer, 0)
-	} else {
-		writeInt8(writer, 1)
-		FfiConverterUint64INSTANCE.Write(writer, *value)
+func (_ FfiConverterMapUint64Uint32) Write(writer io.Writer, mapValue map[uint64]uint32) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[uint64]uint32 is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterUint64INSTANCE.Write(writer, key)
+		FfiConverterUint32INSTANCE.Write(writer, value)
 	}
 }
 
-type FfiDestroyerOptionalUint64 struct{}
+type FfiDestroyerMapUint64Uint32 struct{}
 
-func (_ FfiDestroyerOptionalUint64) Destroy(value *uint64) {
-	if value != nil {
-		FfiDestroyerUint64{}.Destroy(*value)
+func (_ FfiDestroyerMapUint64Uint32) Destroy(mapValue map[uint64]uint32) {
+	for key, value := range mapValue {
+		FfiDestroyerUint64{}.Destroy(key)
+		FfiDestroyerUint32{}.Destroy(value)
 	}
 }
 
-type FfiConverterOptionalString struct{}
+type FfiConverterMapUint64String struct{}
 
-var FfiConverterOptionalStringINSTANCE = FfiConverterOptionalString{}
+var FfiConverterMapUint64StringINSTANCE = FfiConverterMapUint64String{}
 
-func (c FfiConverterOptionalString) Lift(rb RustBufferI) *string {
-	return LiftFromRustBuffer[*string](c, rb)
+func (c FfiConverterMapUint64String) Lift(rb RustBufferI) map[uint64]string {
+	return LiftFromRustBuffer[map[uint64]string](c, rb)
 }
 
-func (_ FfiConverterOptionalString) Read(reader io.Reader) *string {
-	if readInt8(reader) == 0 {
-		return nil
+func (_ FfiConverterMapUint64String) Read(reader io.Reader) map[uint64]string {
+	result := make(map[uint64]string)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterUint64INSTANCE.Read(reader)
+		value := FfiConverterStringINSTANCE.Read(reader)
+		result[key] = value
 	}
-	temp := FfiConverterStringINSTANCE.Read(reader)
-	return &temp
+	return result
 }
 
-func (c FfiConverterOptionalString) Lower(value *string) C.RustBuffer {
-	return LowerIntoRustBuffer[*string](c, value)
+func (c FfiConverterMapUint64String) Lower(value map[uint64]string) C.RustBuffer {
+	return LowerIntoRustBuffer[map[uint64]string](c, value)
 }
 
-func (_ FfiConverterOptionalString) Write(writer io.Writer, value *string) {
-	if value == nil {
-		writeInt8(writer, 0)
-	} else {
-		writeInt8(writer, 1)
-		FfiConverterStringINSTANCE.Write(writer, *value)
+func (_ FfiConverterMapUint64String) Write(writer io.Writer, mapValue map[uint64]string) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[uint64]string is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterUint64INSTANCE.Write(writer, key)
+		FfiConverterStringINSTANCE.Write(writer, value)
 	}
 }
 
-type FfiDestroyerOptionalString struct{}
+type FfiDestroyerMapUint64String struct{}
 
-func (_ FfiDestroyerOptionalString) Destroy(value *string) {
-	if value != nil {
-		FfiDestroyerString{}.Destroy(*value)
+func (_ FfiDestroyerMapUint64String) Destroy(mapValue map[uint64]string) {
+	for key, value := range mapValue {
+		FfiDestroyerUint64{}.Destroy(key)
+		FfiDestroyerString{}.Destroy(value)
 	}
 }
 
-type FfiConverterOptionalFfiSendMemo struct{}
+type FfiConverterMapStringUint32 struct{}
 
-var FfiConverterOptionalFfiSendMemoINSTANCE = FfiConverterOptionalFfiSendMemo{}
+var FfiConverterMapStringUint32INSTANCE = FfiConverterMapStringUint32{}
 
-func (c FfiConverterOptionalFfiSendMemo) Lift(rb RustBufferI) *FfiSendMemo {
-	return LiftFromRustBuffer[*FfiSendMemo](c, rb)
+func (c FfiConverterMapStringUint32) Lift(rb RustBufferI) map[string]uint32 {
+	return LiftFromRustBuffer[map[string]uint32](c, rb)
 }
 
-func (_ FfiConverterOptionalFfiSendMemo) Read(reader io.Reader) *FfiSendMemo {
-	if readInt8(reader) == 0 {
-		return nil
+func (_ FfiConverterMapStringUint32) Read(reader io.Reader) map[string]uint32 {
+	result := make(map[string]uint32)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterStringINSTANCE.Read(reader)
+		value := FfiConverterUint32INSTANCE.Read(reader)
+		result[key] = value
 	}
-	temp := FfiConverterFfiSendMemoINSTANCE.Read(reader)
-	return &temp
+	return result
 }
 
-func (c FfiConverterOptionalFfiSendMemo) Lower(value *FfiSendMemo) C.RustBuffer {
-	return LowerIntoRustBuffer[*FfiSendMemo](c, value)
+func (c FfiConverterMapStringUint32) Lower(value map[string]uint32) C.RustBuffer {
+	return LowerIntoRustBuffer[map[string]uint32](c, value)
 }
 
-func (_ FfiConverterOptionalFfiSendMemo) Write(writer io.Writer, value *FfiSendMemo) {
-	if value == nil {
-		writeInt8(writer, 0)
-	} else {
-		writeInt8(writer, 1)
-		FfiConverterFfiSendMemoINSTANCE.Write(writer, *value)
+func (_ FfiConverterMapStringUint32) Write(writer io.Writer, mapValue map[string]uint32) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[string]uint32 is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterStringINSTANCE.Write(writer, key)
+		FfiConverterUint32INSTANCE.Write(writer, value)
 	}
 }
 
-type FfiDestroyerOptionalFfiSendMemo struct{}
+type FfiDestroyerMapStringUint32 struct{}
 
-func (_ FfiDestroyerOptionalFfiSendMemo) Destroy(value *FfiSendMemo) {
-	if value != nil {
-		FfiDestroyerFfiSendMemo{}.Destroy(*value)
+func (_ FfiDestroyerMapStringUint32) Destroy(mapValue map[string]uint32) {
+	for key, value := range mapValue {
+		FfiDestroyerString{}.Destroy(key)
+		FfiDestroyerUint32{}.Destroy(value)
 	}
 }
 
@@ -2011,6 +8347,102 @@ func (_ FfiDestroyerMapStringString) Destroy(mapValue map[string]string) {
 	}
 }
 
+type FfiConverterMapStringMapUint64String struct{}
+
+var FfiConverterMapStringMapUint64StringINSTANCE = FfiConverterMapStringMapUint64String{}
+
+func (c FfiConverterMapStringMapUint64String) Lift(rb RustBufferI) map[string]map[uint64]string {
+	return LiftFromRustBuffer[map[string]map[uint64]string](c, rb)
+}
+
+func (_ FfiConverterMapStringMapUint64String) Read(reader io.Reader) map[string]map[uint64]string {
+	result := make(map[string]map[uint64]string)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterStringINSTANCE.Read(reader)
+		value := FfiConverterMapUint64StringINSTANCE.Read(reader)
+		result[key] = value
+	}
+	return result
+}
+
+func (c FfiConverterMapStringMapUint64String) Lower(value map[string]map[uint64]string) C.RustBuffer {
+	return LowerIntoRustBuffer[map[string]map[uint64]string](c, value)
+}
+
+func (_ FfiConverterMapStringMapUint64String) Write(writer io.Writer, mapValue map[string]map[uint64]string) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[string]map[uint64]string is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterStringINSTANCE.Write(writer, key)
+		FfiConverterMapUint64StringINSTANCE.Write(writer, value)
+	}
+}
+
+type FfiDestroyerMapStringMapUint64String struct{}
+
+func (_ FfiDestroyerMapStringMapUint64String) Destroy(mapValue map[string]map[uint64]string) {
+	for key, value := range mapValue {
+		FfiDestroyerString{}.Destroy(key)
+		FfiDestroyerMapUint64String{}.Destroy(value)
+	}
+}
+
+// Current unix time in seconds on the clock the wallet checks quote expiries against
+func CurrentMintTime() uint64 {
+	return FfiConverterUint64INSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint64_t {
+		return C.uniffi_cdk_ffi_fn_func_current_mint_time(_uniffiStatus)
+	}))
+}
+
+// Decode a `creqA` payment request (NUT-18) emitted by any Cashu wallet
+func DecodePaymentRequest(request string) (FfiPaymentRequest, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_decode_payment_request(FfiConverterStringINSTANCE.Lower(request), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiPaymentRequest
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiPaymentRequestINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Decode a Cashu token string without a wallet or a mint connection
+func DecodeToken(token string) (FfiToken, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_decode_token(FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiToken
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Encode a payment request (NUT-18) as the `creqA` string a payee publishes, e.g. in a QR code
+func EncodePaymentRequest(request FfiPaymentRequest) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_encode_payment_request(FfiConverterFfiPaymentRequestINSTANCE.Lower(request), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 // Generate a 12-word mnemonic phrase
 func GenerateMnemonic() (string, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -2025,3 +8457,153 @@ func GenerateMnemonic() (string, error) {
 		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
 	}
 }
+
+// Generate a mnemonic phrase of 12, 15, 18, 21 or 24 words
+func GenerateMnemonicWithWordCount(words uint8) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_generate_mnemonic_with_word_count(FfiConverterUint8INSTANCE.Lower(words), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Canonical form of a mint URL, as wallets store it: lowercase scheme and host and no
+// trailing slash, so different spellings of one mint map to the same records
+func NormalizeMintUrl(mintUrl string) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_normalize_mint_url(FfiConverterStringINSTANCE.Lower(mintUrl), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Fetch a mint's info (NUT-06) to check that a URL points to a reachable Cashu mint, without
+// a wallet or a store. Returns NetworkError if the mint cannot be reached and InvalidInput if
+// the URL does not answer with mint info
+func PingMint(mintUrl string) (FfiMintInfo, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_ping_mint(FfiConverterStringINSTANCE.Lower(mintUrl), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiMintInfo
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiMintInfoINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Re-encode a token as V3 (`cashuA`) or V4 (`cashuB`) without a wallet, e.g. for a relay
+// serving older clients. The memo is dropped unless `include_memo`. V4 tokens with short
+// keyset ids (NUT-02 v2 keysets) need the mint's keysets to become V3 and are InvalidInput
+func ReencodeToken(token string, version FfiTokenVersion, includeMemo bool) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_reencode_token(FfiConverterStringINSTANCE.Lower(token), FfiConverterFfiTokenVersionINSTANCE.Lower(version), FfiConverterBoolINSTANCE.Lower(includeMemo), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Send the log events of this library and CDK to `observer`
+// The callback is process wide and can only be set once, later calls return InvalidInput
+func SetLogCallback(observer LogObserver) error {
+	_, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_cdk_ffi_fn_func_set_log_callback(FfiConverterCallbackInterfaceLogObserverINSTANCE.Lower(observer), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Deserialize bytes written by `token_to_bytes`, telling the formats apart by their prefix
+func TokenFromBytes(bytes []byte) (FfiToken, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_token_from_bytes(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FfiToken
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFfiTokenINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Serialize a token into bytes that `token_from_bytes` turns back into the same token
+// V4 tokens use their binary encoding, V3 tokens have none and are kept as the `cashuA` string
+func TokenToBytes(token string) ([]byte, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_token_to_bytes(FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []byte
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Encode a V4 token in its binary form (`craw` prefix followed by CBOR), as used over NFC
+func TokenToRawBytes(token string) ([]byte, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_cdk_ffi_fn_func_token_to_raw_bytes(FfiConverterStringINSTANCE.Lower(token), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []byte
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Check that a phrase is a valid BIP39 mnemonic: known words, a valid word count and checksum
+// Returns false for any of those problems, and InvalidInput only for an empty phrase
+func ValidateMnemonic(phrase string) (bool, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) C.int8_t {
+		return C.uniffi_cdk_ffi_fn_func_validate_mnemonic(FfiConverterStringINSTANCE.Lower(phrase), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue bool
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBoolINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Verify the mint's DLEQ proofs (NUT-12) on every proof of a token without contacting the mint
+// `keys` maps each keyset id to the mint's public key per amount, as served by `/v1/keys`
+// Returns false if any signature does not match, and InvalidInput if a proof carries no DLEQ
+func VerifyTokenDleq(token string, keys map[string]map[uint64]string) (bool, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FfiError](FfiConverterFfiError{}, func(_uniffiStatus *C.RustCallStatus) C.int8_t {
+		return C.uniffi_cdk_ffi_fn_func_verify_token_dleq(FfiConverterStringINSTANCE.Lower(token), FfiConverterMapStringMapUint64StringINSTANCE.Lower(keys), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue bool
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBoolINSTANCE.Lift(_uniffiRV), nil
+	}
+}
@@ -377,6 +377,131 @@ static void call_UniffiForeignFutureCompleteVoid(
 }
 
 
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_BALANCE_OBSERVER_METHOD0
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_BALANCE_OBSERVER_METHOD0
+typedef void (*UniffiCallbackInterfaceBalanceObserverMethod0)(uint64_t uniffi_handle, RustBuffer balance, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceBalanceObserverMethod0(
+				UniffiCallbackInterfaceBalanceObserverMethod0 cb, uint64_t uniffi_handle, RustBuffer balance, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, balance, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_BALANCE_OBSERVER
+#define UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_BALANCE_OBSERVER
+typedef struct UniffiVTableCallbackInterfaceBalanceObserver {
+    UniffiCallbackInterfaceBalanceObserverMethod0 onChange;
+    UniffiCallbackInterfaceFree uniffiFree;
+} UniffiVTableCallbackInterfaceBalanceObserver;
+
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_LOG_OBSERVER_METHOD0
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_LOG_OBSERVER_METHOD0
+typedef void (*UniffiCallbackInterfaceLogObserverMethod0)(uint64_t uniffi_handle, uint8_t level, RustBuffer target, RustBuffer message, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceLogObserverMethod0(
+				UniffiCallbackInterfaceLogObserverMethod0 cb, uint64_t uniffi_handle, uint8_t level, RustBuffer target, RustBuffer message, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, level, target, message, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_LOG_OBSERVER
+#define UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_LOG_OBSERVER
+typedef struct UniffiVTableCallbackInterfaceLogObserver {
+    UniffiCallbackInterfaceLogObserverMethod0 log;
+    UniffiCallbackInterfaceFree uniffiFree;
+} UniffiVTableCallbackInterfaceLogObserver;
+
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINT_QUOTE_OBSERVER_METHOD0
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINT_QUOTE_OBSERVER_METHOD0
+typedef void (*UniffiCallbackInterfaceMintQuoteObserverMethod0)(uint64_t uniffi_handle, RustBuffer state, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceMintQuoteObserverMethod0(
+				UniffiCallbackInterfaceMintQuoteObserverMethod0 cb, uint64_t uniffi_handle, RustBuffer state, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, state, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_MINT_QUOTE_OBSERVER
+#define UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_MINT_QUOTE_OBSERVER
+typedef struct UniffiVTableCallbackInterfaceMintQuoteObserver {
+    UniffiCallbackInterfaceMintQuoteObserverMethod0 onUpdate;
+    UniffiCallbackInterfaceFree uniffiFree;
+} UniffiVTableCallbackInterfaceMintQuoteObserver;
+
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINTED_OBSERVER_METHOD0
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINTED_OBSERVER_METHOD0
+typedef void (*UniffiCallbackInterfaceMintedObserverMethod0)(uint64_t uniffi_handle, RustBuffer amount, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceMintedObserverMethod0(
+				UniffiCallbackInterfaceMintedObserverMethod0 cb, uint64_t uniffi_handle, RustBuffer amount, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, amount, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINTED_OBSERVER_METHOD1
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_MINTED_OBSERVER_METHOD1
+typedef void (*UniffiCallbackInterfaceMintedObserverMethod1)(uint64_t uniffi_handle, RustBuffer err, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceMintedObserverMethod1(
+				UniffiCallbackInterfaceMintedObserverMethod1 cb, uint64_t uniffi_handle, RustBuffer err, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, err, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_MINTED_OBSERVER
+#define UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_MINTED_OBSERVER
+typedef struct UniffiVTableCallbackInterfaceMintedObserver {
+    UniffiCallbackInterfaceMintedObserverMethod0 onMinted;
+    UniffiCallbackInterfaceMintedObserverMethod1 onError;
+    UniffiCallbackInterfaceFree uniffiFree;
+} UniffiVTableCallbackInterfaceMintedObserver;
+
+#endif
+#ifndef UNIFFI_FFIDEF_CALLBACK_INTERFACE_RESTORE_PROGRESS_METHOD0
+#define UNIFFI_FFIDEF_CALLBACK_INTERFACE_RESTORE_PROGRESS_METHOD0
+typedef void (*UniffiCallbackInterfaceRestoreProgressMethod0)(uint64_t uniffi_handle, RustBuffer keyset_id, uint32_t restored, uint32_t total, void* uniffi_out_return, RustCallStatus* callStatus );
+
+// Making function static works arround:
+// https://github.com/golang/go/issues/11263
+static void call_UniffiCallbackInterfaceRestoreProgressMethod0(
+				UniffiCallbackInterfaceRestoreProgressMethod0 cb, uint64_t uniffi_handle, RustBuffer keyset_id, uint32_t restored, uint32_t total, void* uniffi_out_return, RustCallStatus* callStatus )
+{
+	return cb(uniffi_handle, keyset_id, restored, total, uniffi_out_return, callStatus );
+}
+
+
+#endif
+#ifndef UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_RESTORE_PROGRESS
+#define UNIFFI_FFIDEF_V_TABLE_CALLBACK_INTERFACE_RESTORE_PROGRESS
+typedef struct UniffiVTableCallbackInterfaceRestoreProgress {
+    UniffiCallbackInterfaceRestoreProgressMethod0 onBatch;
+    UniffiCallbackInterfaceFree uniffiFree;
+} UniffiVTableCallbackInterfaceRestoreProgress;
+
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFILOCALSTORE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFILOCALSTORE
@@ -392,6 +517,12 @@ void uniffi_cdk_ffi_fn_free_ffilocalstore(void* ptr, RustCallStatus *out_status
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFILOCALSTORE_NEW
 void* uniffi_cdk_ffi_fn_constructor_ffilocalstore_new(RustCallStatus *out_status
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFILOCALSTORE_NEW_IN_MEMORY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFILOCALSTORE_NEW_IN_MEMORY
+void* uniffi_cdk_ffi_fn_constructor_ffilocalstore_new_in_memory(RustCallStatus *out_status
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFILOCALSTORE_NEW_WITH_PATH
@@ -399,6 +530,71 @@ void* uniffi_cdk_ffi_fn_constructor_ffilocalstore_new(RustCallStatus *out_status
 void* uniffi_cdk_ffi_fn_constructor_ffilocalstore_new_with_path(RustBuffer db_path, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFILOCALSTORE_VACUUM
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFILOCALSTORE_VACUUM
+RustBuffer uniffi_cdk_ffi_fn_method_ffilocalstore_vacuum(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFIMULTIMINTWALLET
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFIMULTIMINTWALLET
+void* uniffi_cdk_ffi_fn_clone_ffimultimintwallet(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FREE_FFIMULTIMINTWALLET
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FREE_FFIMULTIMINTWALLET
+void uniffi_cdk_ffi_fn_free_ffimultimintwallet(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIMULTIMINTWALLET_NEW
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIMULTIMINTWALLET_NEW
+void* uniffi_cdk_ffi_fn_constructor_ffimultimintwallet_new(RustBuffer unit, void* localstore, RustBuffer mnemonic_words, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_ADD_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_ADD_MINT
+void uniffi_cdk_ffi_fn_method_ffimultimintwallet_add_mint(void* ptr, RustBuffer mint_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_REMOVE_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_REMOVE_MINT
+int8_t uniffi_cdk_ffi_fn_method_ffimultimintwallet_remove_mint(void* ptr, RustBuffer mint_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_TOTAL_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_TOTAL_BALANCE
+RustBuffer uniffi_cdk_ffi_fn_method_ffimultimintwallet_total_balance(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_TRANSFER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_TRANSFER
+RustBuffer uniffi_cdk_ffi_fn_method_ffimultimintwallet_transfer(void* ptr, RustBuffer from_mint, RustBuffer to_mint, RustBuffer amount, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_WALLET
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_WALLET
+RustBuffer uniffi_cdk_ffi_fn_method_ffimultimintwallet_wallet(void* ptr, RustBuffer mint_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_WALLETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIMULTIMINTWALLET_WALLETS
+RustBuffer uniffi_cdk_ffi_fn_method_ffimultimintwallet_wallets(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFISUBSCRIPTION
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFISUBSCRIPTION
+void* uniffi_cdk_ffi_fn_clone_ffisubscription(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FREE_FFISUBSCRIPTION
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FREE_FFISUBSCRIPTION
+void uniffi_cdk_ffi_fn_free_ffisubscription(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFISUBSCRIPTION_UNSUBSCRIBE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFISUBSCRIPTION_UNSUBSCRIBE
+void uniffi_cdk_ffi_fn_method_ffisubscription_unsubscribe(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFIWALLET
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CLONE_FFIWALLET
 void* uniffi_cdk_ffi_fn_clone_ffiwallet(void* ptr, RustCallStatus *out_status
@@ -414,36 +610,186 @@ void uniffi_cdk_ffi_fn_free_ffiwallet(void* ptr, RustCallStatus *out_status
 void* uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer mnemonic_words, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROOFS
+void* uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic_with_proofs(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer mnemonic_words, RustBuffer proofs, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROXY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROXY
+void* uniffi_cdk_ffi_fn_constructor_ffiwallet_from_mnemonic_with_proxy(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer mnemonic_words, RustBuffer proxy_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_SEED
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_FROM_SEED
+void* uniffi_cdk_ffi_fn_constructor_ffiwallet_from_seed(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer seed, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC
 void* uniffi_cdk_ffi_fn_constructor_ffiwallet_restore_from_mnemonic(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer mnemonic_words, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC_WITH_PROGRESS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC_WITH_PROGRESS
+void* uniffi_cdk_ffi_fn_constructor_ffiwallet_restore_from_mnemonic_with_progress(RustBuffer mint_url, RustBuffer unit, void* localstore, RustBuffer mnemonic_words, uint64_t progress, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ALL_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ALL_METADATA
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_all_metadata(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_AUTO_MINT_ON_PAYMENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_AUTO_MINT_ON_PAYMENT
+void* uniffi_cdk_ffi_fn_method_ffiwallet_auto_mint_on_payment(void* ptr, RustBuffer quote_id, RustBuffer split_target, uint64_t observer, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_BALANCE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_BALANCE
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_balance(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_BALANCE_SNAPSHOT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_BALANCE_SNAPSHOT
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_balance_snapshot(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CANCEL_PREPARED_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CANCEL_PREPARED_SEND
+void uniffi_cdk_ffi_fn_method_ffiwallet_cancel_prepared_send(void* ptr, RustBuffer prepared, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CHECK_PROOF_STATES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CHECK_PROOF_STATES
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_check_proof_states(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CONFIRM_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_CONFIRM_SEND
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_confirm_send(void* ptr, RustBuffer prepared, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_DENOMINATION_BREAKDOWN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_DENOMINATION_BREAKDOWN
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_denomination_breakdown(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_DIAGNOSTIC_REPORT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_DIAGNOSTIC_REPORT
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_diagnostic_report(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ESTIMATE_MELT_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ESTIMATE_MELT_FEE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_estimate_melt_fee(void* ptr, RustBuffer request, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ESTIMATE_RECEIVE_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_ESTIMATE_RECEIVE_FEE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_estimate_receive_fee(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_EXPORT_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_EXPORT_PROOFS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_export_proofs(void* ptr, RustBuffer passphrase, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_FEE_RESERVE_PERCENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_FEE_RESERVE_PERCENT
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_fee_reserve_percent(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_GET_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_GET_METADATA
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_get_metadata(void* ptr, RustBuffer key, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_GET_MINT_INFO
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_GET_MINT_INFO
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_get_mint_info(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_IMPORT_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_IMPORT_PROOFS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_import_proofs(void* ptr, RustBuffer blob, RustBuffer passphrase, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_KEYSET_COUNTERS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_KEYSET_COUNTERS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_keyset_counters(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_KEYSETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_KEYSETS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_list_keysets(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_PROOFS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_list_proofs(void* ptr, RustBuffer state, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_TRANSACTIONS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_TRANSACTIONS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_list_transactions(void* ptr, RustBuffer filter, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_TRANSACTIONS_PAGE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LIST_TRANSACTIONS_PAGE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_list_transactions_page(void* ptr, uint32_t offset, uint32_t limit, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LOCK_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_LOCK_PROOFS
+void uniffi_cdk_ffi_fn_method_ffiwallet_lock_proofs(void* ptr, RustBuffer secrets, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_melt(void* ptr, RustBuffer quote_id, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_BATCH
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_BATCH
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_melt_batch(void* ptr, RustBuffer requests, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_QUOTE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_QUOTE
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_melt_quote(void* ptr, RustBuffer request, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_QUOTE_MPP
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_QUOTE_MPP
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_melt_quote_mpp(void* ptr, RustBuffer request, RustBuffer partial_amount, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_WITH_MAX_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MELT_WITH_MAX_FEE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_melt_with_max_fee(void* ptr, RustBuffer quote_id, RustBuffer max_fee, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint(void* ptr, RustBuffer quote_id, RustBuffer split_target, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_AND_WAIT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_AND_WAIT
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_and_wait(void* ptr, RustBuffer amount, RustBuffer description, uint64_t timeout_secs, RustBuffer split_target, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_DETAILED
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_DETAILED
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_detailed(void* ptr, RustBuffer quote_id, RustBuffer split_target, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_INFO
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_INFO
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_info(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote(void* ptr, RustBuffer amount, RustBuffer description, RustCallStatus *out_status
@@ -454,30 +800,296 @@ RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote(void* ptr, RustBuffer a
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_state(void* ptr, RustBuffer quote_id, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE_STATES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE_STATES
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_states(void* ptr, RustBuffer quote_ids, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE_WITH_OPTIONS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_QUOTE_WITH_OPTIONS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_quote_with_options(void* ptr, RustBuffer amount, RustBuffer options, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_URL
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_URL
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_url(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_WITH_AMOUNTS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_MINT_WITH_AMOUNTS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_mint_with_amounts(void* ptr, RustBuffer quote_id, RustBuffer amounts, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_NET_FLOW
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_NET_FLOW
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_net_flow(void* ptr, uint64_t since, uint64_t until, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_OBSERVE_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_OBSERVE_BALANCE
+void* uniffi_cdk_ffi_fn_method_ffiwallet_observe_balance(void* ptr, uint64_t observer, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_OPERATION_AVAILABILITY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_OPERATION_AVAILABILITY
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_operation_availability(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PAY_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PAY_PAYMENT_REQUEST
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pay_payment_request(void* ptr, RustBuffer request, RustBuffer options, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_BALANCE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pending_balance(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_MELT_QUOTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_MELT_QUOTES
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pending_melt_quotes(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_MINT_QUOTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_MINT_QUOTES
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pending_mint_quotes(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_QUOTE_EXPIRIES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PENDING_QUOTE_EXPIRIES
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pending_quote_expiries(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PREPARE_SEND
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PREPARE_SEND
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_prepare_send(void* ptr, RustBuffer amount, RustBuffer options, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PUBKEY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_PUBKEY
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_pubkey(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_receive(void* ptr, RustBuffer token, RustBuffer options, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE_BATCH
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE_BATCH
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_receive_batch(void* ptr, RustBuffer tokens, RustBuffer options, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE_OFFLINE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECEIVE_OFFLINE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_receive_offline(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECLAIM_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECLAIM_SEND
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_reclaim_send(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECONCILE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RECONCILE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_reconcile(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_REFRESH_KEYSETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_REFRESH_KEYSETS
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_refresh_keysets(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RESERVED_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_RESERVED_BALANCE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_reserved_balance(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SEND
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SEND
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_send(void* ptr, RustBuffer amount, RustBuffer options, RustBuffer memo, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_AUTH_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_AUTH_TOKEN
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_auth_token(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_DETERMINISTIC
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_DETERMINISTIC
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_deterministic(void* ptr, uint64_t seed, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_FEE_RESERVE_PERCENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_FEE_RESERVE_PERCENT
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_fee_reserve_percent(void* ptr, double percent, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_KEYSET_COUNTER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_KEYSET_COUNTER
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_keyset_counter(void* ptr, RustBuffer keyset_id, uint32_t counter, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_METADATA
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_metadata(void* ptr, RustBuffer key, RustBuffer value, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_PREPARED_SEND_TTL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_PREPARED_SEND_TTL
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_prepared_send_ttl(void* ptr, uint64_t ttl_secs, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_REQUEST_TIMEOUT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_REQUEST_TIMEOUT
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_request_timeout(void* ptr, uint64_t timeout_secs, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_RETRY_POLICY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SET_RETRY_POLICY
+void uniffi_cdk_ffi_fn_method_ffiwallet_set_retry_policy(void* ptr, uint8_t max_retries, uint64_t base_delay_ms, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SUBSCRIBE_MINT_QUOTE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SUBSCRIBE_MINT_QUOTE
+void* uniffi_cdk_ffi_fn_method_ffiwallet_subscribe_mint_quote(void* ptr, RustBuffer quote_id, uint64_t observer, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SWAP
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_SWAP
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_swap(void* ptr, RustBuffer amount, RustBuffer split_target, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_TOKEN_STATE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_TOKEN_STATE
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_token_state(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UNIT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UNIT
 RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_unit(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UNLOCK_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UNLOCK_PROOFS
+void uniffi_cdk_ffi_fn_method_ffiwallet_unlock_proofs(void* ptr, RustBuffer secrets, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UPDATE_MINT_URL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_UPDATE_MINT_URL
+void uniffi_cdk_ffi_fn_method_ffiwallet_update_mint_url(void* ptr, RustBuffer new_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_VERIFY_TOKEN_DLEQ
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_VERIFY_TOKEN_DLEQ
+int8_t uniffi_cdk_ffi_fn_method_ffiwallet_verify_token_dleq(void* ptr, RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_WAIT_FOR_MINT_QUOTE_PAID
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_METHOD_FFIWALLET_WAIT_FOR_MINT_QUOTE_PAID
+RustBuffer uniffi_cdk_ffi_fn_method_ffiwallet_wait_for_mint_quote_paid(void* ptr, RustBuffer quote_id, uint64_t timeout_secs, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_BALANCEOBSERVER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_BALANCEOBSERVER
+void uniffi_cdk_ffi_fn_init_callback_vtable_balanceobserver(UniffiVTableCallbackInterfaceBalanceObserver* vtable
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_LOGOBSERVER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_LOGOBSERVER
+void uniffi_cdk_ffi_fn_init_callback_vtable_logobserver(UniffiVTableCallbackInterfaceLogObserver* vtable
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_MINTQUOTEOBSERVER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_MINTQUOTEOBSERVER
+void uniffi_cdk_ffi_fn_init_callback_vtable_mintquoteobserver(UniffiVTableCallbackInterfaceMintQuoteObserver* vtable
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_MINTEDOBSERVER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_MINTEDOBSERVER
+void uniffi_cdk_ffi_fn_init_callback_vtable_mintedobserver(UniffiVTableCallbackInterfaceMintedObserver* vtable
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_RESTOREPROGRESS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_INIT_CALLBACK_VTABLE_RESTOREPROGRESS
+void uniffi_cdk_ffi_fn_init_callback_vtable_restoreprogress(UniffiVTableCallbackInterfaceRestoreProgress* vtable
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_CURRENT_MINT_TIME
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_CURRENT_MINT_TIME
+uint64_t uniffi_cdk_ffi_fn_func_current_mint_time(RustCallStatus *out_status
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_DECODE_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_DECODE_PAYMENT_REQUEST
+RustBuffer uniffi_cdk_ffi_fn_func_decode_payment_request(RustBuffer request, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_DECODE_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_DECODE_TOKEN
+RustBuffer uniffi_cdk_ffi_fn_func_decode_token(RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_ENCODE_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_ENCODE_PAYMENT_REQUEST
+RustBuffer uniffi_cdk_ffi_fn_func_encode_payment_request(RustBuffer request, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_GENERATE_MNEMONIC
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_GENERATE_MNEMONIC
 RustBuffer uniffi_cdk_ffi_fn_func_generate_mnemonic(RustCallStatus *out_status
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_GENERATE_MNEMONIC_WITH_WORD_COUNT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_GENERATE_MNEMONIC_WITH_WORD_COUNT
+RustBuffer uniffi_cdk_ffi_fn_func_generate_mnemonic_with_word_count(uint8_t words, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_NORMALIZE_MINT_URL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_NORMALIZE_MINT_URL
+RustBuffer uniffi_cdk_ffi_fn_func_normalize_mint_url(RustBuffer mint_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_PING_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_PING_MINT
+RustBuffer uniffi_cdk_ffi_fn_func_ping_mint(RustBuffer mint_url, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_REENCODE_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_REENCODE_TOKEN
+RustBuffer uniffi_cdk_ffi_fn_func_reencode_token(RustBuffer token, RustBuffer version, int8_t include_memo, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_SET_LOG_CALLBACK
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_SET_LOG_CALLBACK
+void uniffi_cdk_ffi_fn_func_set_log_callback(uint64_t observer, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_FROM_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_FROM_BYTES
+RustBuffer uniffi_cdk_ffi_fn_func_token_from_bytes(RustBuffer bytes, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_TO_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_TO_BYTES
+RustBuffer uniffi_cdk_ffi_fn_func_token_to_bytes(RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_TO_RAW_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_TOKEN_TO_RAW_BYTES
+RustBuffer uniffi_cdk_ffi_fn_func_token_to_raw_bytes(RustBuffer token, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_VALIDATE_MNEMONIC
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_VALIDATE_MNEMONIC
+int8_t uniffi_cdk_ffi_fn_func_validate_mnemonic(RustBuffer phrase, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_VERIFY_TOKEN_DLEQ
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_FN_FUNC_VERIFY_TOKEN_DLEQ
+int8_t uniffi_cdk_ffi_fn_func_verify_token_dleq(RustBuffer token, RustBuffer keys, RustCallStatus *out_status
 );
 #endif
 #ifndef UNIFFI_FFIDEF_FFI_CDK_FFI_RUSTBUFFER_ALLOC
@@ -760,40 +1372,328 @@ void ffi_cdk_ffi_rust_future_free_void(uint64_t handle
 void ffi_cdk_ffi_rust_future_complete_void(uint64_t handle, RustCallStatus *out_status
 );
 #endif
-#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC
-#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_CURRENT_MINT_TIME
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_CURRENT_MINT_TIME
+uint16_t uniffi_cdk_ffi_checksum_func_current_mint_time(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_DECODE_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_DECODE_PAYMENT_REQUEST
+uint16_t uniffi_cdk_ffi_checksum_func_decode_payment_request(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_DECODE_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_DECODE_TOKEN
+uint16_t uniffi_cdk_ffi_checksum_func_decode_token(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_ENCODE_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_ENCODE_PAYMENT_REQUEST
+uint16_t uniffi_cdk_ffi_checksum_func_encode_payment_request(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC
 uint16_t uniffi_cdk_ffi_checksum_func_generate_mnemonic(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC_WITH_WORD_COUNT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_GENERATE_MNEMONIC_WITH_WORD_COUNT
+uint16_t uniffi_cdk_ffi_checksum_func_generate_mnemonic_with_word_count(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_NORMALIZE_MINT_URL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_NORMALIZE_MINT_URL
+uint16_t uniffi_cdk_ffi_checksum_func_normalize_mint_url(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_PING_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_PING_MINT
+uint16_t uniffi_cdk_ffi_checksum_func_ping_mint(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_REENCODE_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_REENCODE_TOKEN
+uint16_t uniffi_cdk_ffi_checksum_func_reencode_token(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_SET_LOG_CALLBACK
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_SET_LOG_CALLBACK
+uint16_t uniffi_cdk_ffi_checksum_func_set_log_callback(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_FROM_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_FROM_BYTES
+uint16_t uniffi_cdk_ffi_checksum_func_token_from_bytes(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_TO_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_TO_BYTES
+uint16_t uniffi_cdk_ffi_checksum_func_token_to_bytes(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_TO_RAW_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_TOKEN_TO_RAW_BYTES
+uint16_t uniffi_cdk_ffi_checksum_func_token_to_raw_bytes(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_VALIDATE_MNEMONIC
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_VALIDATE_MNEMONIC
+uint16_t uniffi_cdk_ffi_checksum_func_validate_mnemonic(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_VERIFY_TOKEN_DLEQ
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_FUNC_VERIFY_TOKEN_DLEQ
+uint16_t uniffi_cdk_ffi_checksum_func_verify_token_dleq(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFILOCALSTORE_VACUUM
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFILOCALSTORE_VACUUM
+uint16_t uniffi_cdk_ffi_checksum_method_ffilocalstore_vacuum(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_ADD_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_ADD_MINT
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_add_mint(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_REMOVE_MINT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_REMOVE_MINT
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_remove_mint(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_TOTAL_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_TOTAL_BALANCE
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_total_balance(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_TRANSFER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_TRANSFER
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_transfer(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_WALLET
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_WALLET
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallet(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_WALLETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIMULTIMINTWALLET_WALLETS
+uint16_t uniffi_cdk_ffi_checksum_method_ffimultimintwallet_wallets(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFISUBSCRIPTION_UNSUBSCRIBE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFISUBSCRIPTION_UNSUBSCRIBE
+uint16_t uniffi_cdk_ffi_checksum_method_ffisubscription_unsubscribe(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ALL_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ALL_METADATA
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_all_metadata(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_AUTO_MINT_ON_PAYMENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_AUTO_MINT_ON_PAYMENT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_auto_mint_on_payment(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_BALANCE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_BALANCE
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_balance(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_BALANCE_SNAPSHOT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_BALANCE_SNAPSHOT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_balance_snapshot(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CANCEL_PREPARED_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CANCEL_PREPARED_SEND
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_cancel_prepared_send(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CHECK_PROOF_STATES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CHECK_PROOF_STATES
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_check_proof_states(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CONFIRM_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_CONFIRM_SEND
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_confirm_send(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_DENOMINATION_BREAKDOWN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_DENOMINATION_BREAKDOWN
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_denomination_breakdown(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_DIAGNOSTIC_REPORT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_DIAGNOSTIC_REPORT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_diagnostic_report(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ESTIMATE_MELT_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ESTIMATE_MELT_FEE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_melt_fee(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ESTIMATE_RECEIVE_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_ESTIMATE_RECEIVE_FEE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_estimate_receive_fee(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_EXPORT_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_EXPORT_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_export_proofs(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_FEE_RESERVE_PERCENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_FEE_RESERVE_PERCENT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_fee_reserve_percent(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_GET_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_GET_METADATA
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_get_metadata(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_GET_MINT_INFO
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_GET_MINT_INFO
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_get_mint_info(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_IMPORT_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_IMPORT_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_import_proofs(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_KEYSET_COUNTERS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_KEYSET_COUNTERS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_keyset_counters(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_KEYSETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_KEYSETS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_list_keysets(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_list_proofs(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_TRANSACTIONS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_TRANSACTIONS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_TRANSACTIONS_PAGE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LIST_TRANSACTIONS_PAGE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_list_transactions_page(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LOCK_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_LOCK_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_lock_proofs(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_melt(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_BATCH
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_BATCH
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_melt_batch(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_QUOTE
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_QUOTE
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_QUOTE_MPP
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_QUOTE_MPP
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_melt_quote_mpp(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_WITH_MAX_FEE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MELT_WITH_MAX_FEE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_melt_with_max_fee(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_AND_WAIT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_AND_WAIT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_and_wait(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_DETAILED
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_DETAILED
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_detailed(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_INFO
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_INFO
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_info(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE
@@ -806,54 +1706,330 @@ uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote(void
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE_STATE
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_state(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE_STATES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE_STATES
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_states(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE_WITH_OPTIONS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_QUOTE_WITH_OPTIONS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_quote_with_options(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_URL
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_URL
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_url(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_WITH_AMOUNTS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_MINT_WITH_AMOUNTS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_mint_with_amounts(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_NET_FLOW
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_NET_FLOW
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_net_flow(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_OBSERVE_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_OBSERVE_BALANCE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_observe_balance(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_OPERATION_AVAILABILITY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_OPERATION_AVAILABILITY
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_operation_availability(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PAY_PAYMENT_REQUEST
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PAY_PAYMENT_REQUEST
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pay_payment_request(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_BALANCE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pending_balance(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_MELT_QUOTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_MELT_QUOTES
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pending_melt_quotes(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_MINT_QUOTES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_MINT_QUOTES
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pending_mint_quotes(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_QUOTE_EXPIRIES
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PENDING_QUOTE_EXPIRIES
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pending_quote_expiries(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PREPARE_SEND
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PREPARE_SEND
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_prepare_send(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PUBKEY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_PUBKEY
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_pubkey(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_receive(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE_BATCH
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE_BATCH
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_receive_batch(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE_OFFLINE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECEIVE_OFFLINE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_receive_offline(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECLAIM_SEND
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECLAIM_SEND
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_reclaim_send(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECONCILE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RECONCILE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_reconcile(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_REFRESH_KEYSETS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_REFRESH_KEYSETS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_refresh_keysets(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RESERVED_BALANCE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_RESERVED_BALANCE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_reserved_balance(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SEND
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SEND
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_send(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_AUTH_TOKEN
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_AUTH_TOKEN
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_auth_token(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_DETERMINISTIC
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_DETERMINISTIC
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_deterministic(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_FEE_RESERVE_PERCENT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_FEE_RESERVE_PERCENT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_fee_reserve_percent(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_KEYSET_COUNTER
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_KEYSET_COUNTER
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_keyset_counter(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_METADATA
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_METADATA
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_metadata(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_PREPARED_SEND_TTL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_PREPARED_SEND_TTL
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_prepared_send_ttl(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_REQUEST_TIMEOUT
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_REQUEST_TIMEOUT
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_request_timeout(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_RETRY_POLICY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SET_RETRY_POLICY
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_set_retry_policy(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SUBSCRIBE_MINT_QUOTE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SUBSCRIBE_MINT_QUOTE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_subscribe_mint_quote(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SWAP
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_SWAP
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_swap(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_TOKEN_STATE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_TOKEN_STATE
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_token_state(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UNIT
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UNIT
 uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_unit(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UNLOCK_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UNLOCK_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_unlock_proofs(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UPDATE_MINT_URL
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_UPDATE_MINT_URL
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_update_mint_url(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_VERIFY_TOKEN_DLEQ
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_VERIFY_TOKEN_DLEQ
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_verify_token_dleq(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_WAIT_FOR_MINT_QUOTE_PAID
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_FFIWALLET_WAIT_FOR_MINT_QUOTE_PAID
+uint16_t uniffi_cdk_ffi_checksum_method_ffiwallet_wait_for_mint_quote_paid(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW
 uint16_t uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW_IN_MEMORY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW_IN_MEMORY
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_in_memory(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW_WITH_PATH
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFILOCALSTORE_NEW_WITH_PATH
 uint16_t uniffi_cdk_ffi_checksum_constructor_ffilocalstore_new_with_path(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIMULTIMINTWALLET_NEW
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIMULTIMINTWALLET_NEW
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffimultimintwallet_new(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC
 uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROOFS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROOFS
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proofs(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROXY
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_MNEMONIC_WITH_PROXY
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_mnemonic_with_proxy(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_SEED
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_FROM_SEED
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_from_seed(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC
 #define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC
 uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC_WITH_PROGRESS
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_CONSTRUCTOR_FFIWALLET_RESTORE_FROM_MNEMONIC_WITH_PROGRESS
+uint16_t uniffi_cdk_ffi_checksum_constructor_ffiwallet_restore_from_mnemonic_with_progress(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_BALANCEOBSERVER_ON_CHANGE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_BALANCEOBSERVER_ON_CHANGE
+uint16_t uniffi_cdk_ffi_checksum_method_balanceobserver_on_change(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_LOGOBSERVER_LOG
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_LOGOBSERVER_LOG
+uint16_t uniffi_cdk_ffi_checksum_method_logobserver_log(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTQUOTEOBSERVER_ON_UPDATE
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTQUOTEOBSERVER_ON_UPDATE
+uint16_t uniffi_cdk_ffi_checksum_method_mintquoteobserver_on_update(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTEDOBSERVER_ON_MINTED
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTEDOBSERVER_ON_MINTED
+uint16_t uniffi_cdk_ffi_checksum_method_mintedobserver_on_minted(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTEDOBSERVER_ON_ERROR
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_MINTEDOBSERVER_ON_ERROR
+uint16_t uniffi_cdk_ffi_checksum_method_mintedobserver_on_error(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_RESTOREPROGRESS_ON_BATCH
+#define UNIFFI_FFIDEF_UNIFFI_CDK_FFI_CHECKSUM_METHOD_RESTOREPROGRESS_ON_BATCH
+uint16_t uniffi_cdk_ffi_checksum_method_restoreprogress_on_batch(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_FFI_CDK_FFI_UNIFFI_CONTRACT_VERSION
@@ -863,3 +2039,15 @@ uint32_t ffi_cdk_ffi_uniffi_contract_version(void
 );
 #endif
 
+
+void cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverMethod0(uint64_t uniffi_handle, RustBuffer balance, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceBalanceObserverFree(uint64_t handle);
+void cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverMethod0(uint64_t uniffi_handle, uint8_t level, RustBuffer target, RustBuffer message, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceLogObserverFree(uint64_t handle);
+void cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverMethod0(uint64_t uniffi_handle, RustBuffer state, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceMintQuoteObserverFree(uint64_t handle);
+void cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod0(uint64_t uniffi_handle, RustBuffer amount, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverMethod1(uint64_t uniffi_handle, RustBuffer err, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceMintedObserverFree(uint64_t handle);
+void cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressMethod0(uint64_t uniffi_handle, RustBuffer keyset_id, uint32_t restored, uint32_t total, void* uniffi_out_return, RustCallStatus* callStatus );
+void cdk_ffi_cgo_dispatchCallbackInterfaceRestoreProgressFree(uint64_t handle);
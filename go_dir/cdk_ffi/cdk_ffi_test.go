@@ -0,0 +1,151 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBytesRustBufferRoundTrip(t *testing.T) {
+	for _, value := range [][]byte{{}, {0x00}, {0x63, 0x72, 0x61, 0x77, 0x42, 0xff, 0x00, 0x10}} {
+		got := FfiConverterBytesINSTANCE.Lift(GoRustBuffer{
+			inner: FfiConverterBytesINSTANCE.Lower(value),
+		})
+		if !bytes.Equal(got, value) {
+			t.Fatalf("roundtrip mismatch: got %x, want %x", got, value)
+		}
+	}
+}
+
+func TestSequenceFfiAmountRustBufferRoundTrip(t *testing.T) {
+	for _, value := range [][]FfiAmount{{}, {{Value: 1}}, {{Value: 1}, {Value: 4}, {Value: 8}, {Value: 1 << 63}}} {
+		got := FfiConverterSequenceFfiAmountINSTANCE.Lift(GoRustBuffer{
+			inner: FfiConverterSequenceFfiAmountINSTANCE.Lower(value),
+		})
+		if len(got) != len(value) {
+			t.Fatalf("roundtrip length mismatch: got %v, want %v", got, value)
+		}
+		for i := range got {
+			if got[i] != value[i] {
+				t.Fatalf("roundtrip mismatch: got %v, want %v", got, value)
+			}
+		}
+	}
+}
+
+func TestOptionalFfiAmountRustBufferRoundTrip(t *testing.T) {
+	for _, value := range []*FfiAmount{nil, {Value: 0}, {Value: 21}, {Value: 1 << 63}} {
+		got := FfiConverterOptionalFfiAmountINSTANCE.Lift(GoRustBuffer{
+			inner: FfiConverterOptionalFfiAmountINSTANCE.Lower(value),
+		})
+		if (got == nil) != (value == nil) || (got != nil && *got != *value) {
+			t.Fatalf("roundtrip mismatch: got %v, want %v", got, value)
+		}
+	}
+}
+
+func TestSequenceStringRustBufferRoundTrip(t *testing.T) {
+	for _, value := range [][]string{{}, {""}, {"009a1f293253e41e", "00ad268c4d1f5826"}} {
+		got := FfiConverterSequenceStringINSTANCE.Lift(GoRustBuffer{
+			inner: FfiConverterSequenceStringINSTANCE.Lower(value),
+		})
+		if len(got) != len(value) {
+			t.Fatalf("roundtrip length mismatch: got %q, want %q", got, value)
+		}
+		for i := range got {
+			if got[i] != value[i] {
+				t.Fatalf("roundtrip mismatch: got %q, want %q", got, value)
+			}
+		}
+	}
+}
+
+// liftFfiError sends err through the same RustBuffer path errors returned by Rust take
+func liftFfiError(err *FfiError) error {
+	return FfiConverterFfiErrorINSTANCE.Lift(GoRustBuffer{
+		inner: FfiConverterFfiErrorINSTANCE.Lower(err),
+	})
+}
+
+func TestFfiErrorAs(t *testing.T) {
+	t.Run("WalletError", func(t *testing.T) {
+		err := fmt.Errorf("send: %w", liftFfiError(NewFfiErrorWalletError("wallet", 11001)))
+		var target *FfiErrorWalletError
+		if !errors.As(err, &target) || target.Msg != "wallet" || target.Code != 11001 {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		err := fmt.Errorf("send: %w", liftFfiError(NewFfiErrorInvalidInput("input", 0)))
+		var target *FfiErrorInvalidInput
+		if !errors.As(err, &target) || target.Msg != "input" {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+	})
+	t.Run("NetworkError", func(t *testing.T) {
+		err := fmt.Errorf("send: %w", liftFfiError(NewFfiErrorNetworkError("network", 0)))
+		var target *FfiErrorNetworkError
+		if !errors.As(err, &target) || target.Msg != "network" {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+	})
+	t.Run("InternalError", func(t *testing.T) {
+		err := fmt.Errorf("send: %w", liftFfiError(NewFfiErrorInternalError("internal", 0)))
+		var target *FfiErrorInternalError
+		if !errors.As(err, &target) || target.Msg != "internal" {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+	})
+	t.Run("InsufficientFunds", func(t *testing.T) {
+		err := liftFfiError(NewFfiErrorInsufficientFunds(FfiAmount{Value: 5}, FfiAmount{Value: 21}, 0))
+		var target *FfiErrorInsufficientFunds
+		if !errors.As(err, &target) || target.Available.Value != 5 || target.Required.Value != 21 {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+		if !errors.Is(err, ErrFfiErrorInsufficientFunds) {
+			t.Fatalf("errors.Is failed: %v", err)
+		}
+	})
+	t.Run("TransferFailed", func(t *testing.T) {
+		err := liftFfiError(NewFfiErrorTransferFailed(FfiTransferLegMint, "not paid", 20001))
+		var target *FfiErrorTransferFailed
+		if !errors.As(err, &target) || target.Leg != FfiTransferLegMint || target.Msg != "not paid" {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+	})
+	t.Run("OfflineSendImpossible", func(t *testing.T) {
+		err := liftFfiError(NewFfiErrorOfflineSendImpossible(FfiAmount{Value: 7}, FfiAmount{Value: 4}, 0))
+		var target *FfiErrorOfflineSendImpossible
+		if !errors.As(err, &target) || target.Requested.Value != 7 || target.Nearest.Value != 4 {
+			t.Fatalf("errors.As failed: %v, %#v", err, target)
+		}
+		if !errors.Is(err, ErrFfiErrorOfflineSendImpossible) {
+			t.Fatalf("errors.Is failed: %v", err)
+		}
+	})
+}
+
+func TestFfiErrorCode(t *testing.T) {
+	cases := []struct {
+		err  *FfiError
+		want uint32
+	}{
+		{NewFfiErrorWalletError("Token already spent", 11001), 11001},
+		{NewFfiErrorNetworkError("Token already spent", 11001), 11001},
+		{NewFfiErrorOperationDisabled("Minting is disabled at this mint", 20003), 20003},
+		{NewFfiErrorTransferFailed(FfiTransferLegMint, "Quote not paid", 20001), 20001},
+		{NewFfiErrorInsufficientFunds(FfiAmount{Value: 5}, FfiAmount{Value: 21}, 0), 0},
+		{NewFfiErrorDleqVerificationFailed("no DLEQ proof", 0), 0},
+	}
+	for _, c := range cases {
+		err := fmt.Errorf("wrapped: %w", liftFfiError(c.err))
+		var target *FfiError
+		if !errors.As(err, &target) {
+			t.Fatalf("errors.As failed: %v", err)
+		}
+		if code := target.Code(); code != c.want {
+			t.Fatalf("%v: got code %d, want %d", err, code, c.want)
+		}
+	}
+}
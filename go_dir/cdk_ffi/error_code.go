@@ -0,0 +1,33 @@
+package cdk_ffi
+
+// This file is written by hand, uniffi-bindgen-go does not generate it
+
+// Code returns the NUT-00 error code carried by any FfiError variant, such as 11001 for
+// spent proofs, and 0 when the failure has none
+func (err *FfiError) Code() uint32 {
+	switch variant := err.err.(type) {
+	case *FfiErrorWalletError:
+		return variant.Code
+	case *FfiErrorInvalidInput:
+		return variant.Code
+	case *FfiErrorNetworkError:
+		return variant.Code
+	case *FfiErrorInternalError:
+		return variant.Code
+	case *FfiErrorOperationDisabled:
+		return variant.Code
+	case *FfiErrorTimeout:
+		return variant.Code
+	case *FfiErrorInsufficientFunds:
+		return variant.Code
+	case *FfiErrorTransferFailed:
+		return variant.Code
+	case *FfiErrorFeeTooHigh:
+		return variant.Code
+	case *FfiErrorOfflineSendImpossible:
+		return variant.Code
+	case *FfiErrorDleqVerificationFailed:
+		return variant.Code
+	}
+	return 0
+}
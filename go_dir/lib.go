@@ -1,12 +1,99 @@
 package main
 
-import "go_dir/cdk_ffi"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"sync"
+	"time"
+
+	"go_dir/cdk_ffi"
+)
 
 // Amount represents a monetary amount with a uint64 value
 type Amount struct {
 	Value uint64
 }
 
+// MarshalJSON encodes the amount as a bare JSON number
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Value)
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &a.Value)
+}
+
+// ErrAmountUnderflow is returned by Amount.Sub when the result would be negative
+var ErrAmountUnderflow = errors.New("amount underflow")
+
+// ErrAmountOverflow is returned by Amount.CheckedAdd and Amount.CheckedMul when the result
+// does not fit in a uint64
+var ErrAmountOverflow = errors.New("amount overflow")
+
+// Add returns a + b, wrapping around on overflow. Use CheckedAdd for untrusted amounts
+func (a Amount) Add(b Amount) Amount {
+	return Amount{Value: a.Value + b.Value}
+}
+
+// CheckedAdd returns a + b, or ErrAmountOverflow when the sum does not fit in a uint64
+func (a Amount) CheckedAdd(b Amount) (Amount, error) {
+	sum, carry := bits.Add64(a.Value, b.Value, 0)
+	if carry != 0 {
+		return Amount{}, fmt.Errorf("%w: %d + %d", ErrAmountOverflow, a.Value, b.Value)
+	}
+	return Amount{Value: sum}, nil
+}
+
+// Sub returns a - b, or ErrAmountUnderflow when b is larger than a
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if b.Value > a.Value {
+		return Amount{}, fmt.Errorf("%w: %d - %d", ErrAmountUnderflow, a.Value, b.Value)
+	}
+	return Amount{Value: a.Value - b.Value}, nil
+}
+
+// Mul returns a multiplied by factor, wrapping around on overflow. Use CheckedMul for
+// untrusted amounts
+func (a Amount) Mul(factor uint64) Amount {
+	return Amount{Value: a.Value * factor}
+}
+
+// CheckedMul returns a multiplied by factor, or ErrAmountOverflow when the product does not
+// fit in a uint64
+func (a Amount) CheckedMul(factor uint64) (Amount, error) {
+	hi, product := bits.Mul64(a.Value, factor)
+	if hi != 0 {
+		return Amount{}, fmt.Errorf("%w: %d * %d", ErrAmountOverflow, a.Value, factor)
+	}
+	return Amount{Value: product}, nil
+}
+
+// Less reports whether a is smaller than b
+func (a Amount) Less(b Amount) bool {
+	return a.Value < b.Value
+}
+
+// Equal reports whether a and b are the same amount
+func (a Amount) Equal(b Amount) bool {
+	return a.Value == b.Value
+}
+
+// Split decomposes the amount into power-of-two denominations, smallest first, as the mint
+// signs them for either SplitTarget
+func (a Amount) Split() []Amount {
+	var parts []Amount
+	for bit := uint64(1); bit != 0 && bit <= a.Value; bit <<= 1 {
+		if a.Value&bit != 0 {
+			parts = append(parts, Amount{Value: bit})
+		}
+	}
+	return parts
+}
+
 // SplitTarget represents the target for splitting proofs
 type SplitTarget uint
 
@@ -15,187 +102,2168 @@ const (
 	SplitTargetDefault SplitTarget = SplitTarget(cdk_ffi.FfiSplitTargetDefault)
 )
 
+// ErrWalletClosed is returned by Wallet methods called after Close
+var ErrWalletClosed = errors.New("wallet is closed")
+
+// ErrStorageClosed is returned when a closed Storage is used to create a wallet
+var ErrStorageClosed = errors.New("storage is closed")
+
+// NUT-00 error codes returned by ErrorCode, the Cashu NUT-00 spec lists the others
+const (
+	ErrorCodeTokenAlreadySpent     uint32 = 11001
+	ErrorCodeTransactionUnbalanced uint32 = 11002
+	ErrorCodeUnitUnsupported       uint32 = 11005
+	ErrorCodeAmountOutsideLimit    uint32 = 11006
+	ErrorCodeKeysetUnknown         uint32 = 12001
+	ErrorCodeKeysetInactive        uint32 = 12002
+	ErrorCodeQuoteNotPaid          uint32 = 20001
+	ErrorCodeTokensAlreadyIssued   uint32 = 20002
+	ErrorCodeMintingDisabled       uint32 = 20003
+	ErrorCodeQuotePending          uint32 = 20005
+	ErrorCodeInvoiceAlreadyPaid    uint32 = 20006
+	ErrorCodeQuoteExpired          uint32 = 20007
+)
+
+// ErrorCode returns the NUT-00 error code carried by an error from the mint or CDK, whatever
+// its type, so callers can switch on it instead of matching messages. It returns 0 for errors
+// without one, which are told apart by their type with errors.Is
+func ErrorCode(err error) uint32 {
+	var ffiError *cdk_ffi.FfiError
+	if errors.As(err, &ffiError) {
+		return ffiError.Code()
+	}
+	return 0
+}
+
+// Wallet is safe for concurrent use. Calls that move proofs (sending, receiving, minting,
+// melting, swapping) are serialized, while read-only calls run alongside them
 type Wallet struct {
 	wallet cdk_ffi.FfiWalletInterface
+	// mu is held for reading by every call and for writing by Close
+	mu sync.RWMutex
+	// proofsMu serializes the calls that move proofs
+	proofsMu sync.Mutex
+	closed   bool
+}
+
+// Close releases the underlying Rust wallet instead of waiting for the finalizer, once the
+// calls in progress have returned. Calling it again is a no-op, and every other method
+// returns ErrWalletClosed afterwards
+func (w *Wallet) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if wallet, ok := w.wallet.(interface{ Destroy() }); ok {
+		wallet.Destroy()
+	}
+	return nil
 }
 
+// Storage is a handle on a local store, copies share it so closing one closes all of them
 type Storage struct {
-	storage *cdk_ffi.FfiLocalStore
+	storage *storageHandle
+}
+
+type storageHandle struct {
+	mu     sync.RWMutex
+	closed bool
+	store  *cdk_ffi.FfiLocalStore
+}
+
+func newStorage(store *cdk_ffi.FfiLocalStore) Storage {
+	return Storage{storage: &storageHandle{store: store}}
+}
+
+// acquire returns the local store and keeps it open until release is called
+func (s Storage) acquire() (store *cdk_ffi.FfiLocalStore, release func(), err error) {
+	if s.storage == nil {
+		return nil, nil, ErrStorageClosed
+	}
+	s.storage.mu.RLock()
+	if s.storage.closed {
+		s.storage.mu.RUnlock()
+		return nil, nil, ErrStorageClosed
+	}
+	return s.storage.store, s.storage.mu.RUnlock, nil
+}
+
+// Close releases the local store for every copy of this Storage, wallets already created
+// from it keep working. Calling it again is a no-op
+func (s *Storage) Close() error {
+	if s.storage == nil {
+		return nil
+	}
+	s.storage.mu.Lock()
+	defer s.storage.mu.Unlock()
+	if s.storage.closed {
+		return nil
+	}
+	s.storage.closed = true
+	s.storage.store.Destroy()
+	return nil
+}
+
+// Vacuum removes spent proofs and expired quotes from the local store
+func (s *Storage) Vacuum() (VacuumResult, error) {
+	store, release, err := s.acquire()
+	if err != nil {
+		return VacuumResult{}, err
+	}
+	defer release()
+	result, err := store.Vacuum()
+	if err != nil {
+		return VacuumResult{}, err
+	}
+	return VacuumResultFromFFI(result), nil
 }
 
 func NewStorage() (Storage, error) {
 	storage, err := cdk_ffi.NewFfiLocalStore()
 	if err != nil {
-		return Storage{storage: storage}, err
+		return Storage{}, err
+	}
+
+	return newStorage(storage), nil
+}
+
+// NewInMemoryStorage creates a store that never touches disk, its contents are lost on Close
+func NewInMemoryStorage() (Storage, error) {
+	storage, err := cdk_ffi.FfiLocalStoreNewInMemory()
+	if err != nil {
+		return Storage{}, err
 	}
 
-	return Storage{storage: storage}, nil
+	return newStorage(storage), nil
 }
 
 func NewStorageFromPath(path string) (Storage, error) {
 	storage, err := cdk_ffi.FfiLocalStoreNewWithPath(&path)
 	if err != nil {
-		return Storage{storage: storage}, err
+		return Storage{}, err
 	}
 
-	return Storage{storage: storage}, nil
+	return newStorage(storage), nil
 }
 
-type Unit = cdk_ffi.FfiCurrencyUnit
+// ParseToken decodes a Cashu token string without a wallet or a mint connection
+func ParseToken(token string) (Token, error) {
+	f, err := cdk_ffi.DecodeToken(token)
+	if err != nil {
+		return Token{}, err
+	}
+	return TokenFromFFI(f), nil
+}
 
-const Sat Unit = Unit(cdk_ffi.FfiCurrencyUnitSat)
+// NormalizeMintUrl returns the canonical form wallets store a mint URL in, with a lowercase
+// scheme and host and no trailing slash. Input that is not an absolute URL returns an error
+func NormalizeMintUrl(url string) (string, error) {
+	return cdk_ffi.NormalizeMintUrl(url)
+}
 
-func RestoreFromMnemonic(minturl string, unit Unit, storage Storage, mnemonic string) (*Wallet, error) {
-	wallet, err := cdk_ffi.FfiWalletRestoreFromMnemonic(minturl, cdk_ffi.FfiCurrencyUnit(unit), storage.storage, mnemonic)
+// PingMint fetches a mint's info to check that url points to a reachable Cashu mint, without
+// creating a wallet or a store, e.g. to validate a mint URL typed during onboarding
+func PingMint(url string) (MintInfo, error) {
+	f, err := cdk_ffi.PingMint(url)
 	if err != nil {
-		return nil, err
+		return MintInfo{}, err
 	}
-	return &Wallet{
-		wallet: wallet,
-	}, nil
+	return MintInfoFromFFI(f), nil
 }
 
-func NewWalletFromMnemonic(minturl string, unit Unit, storage Storage, mnemonic string) (*Wallet, error) {
-	wallet, err := cdk_ffi.FfiWalletFromMnemonic(minturl, cdk_ffi.FfiCurrencyUnit(unit), storage.storage, mnemonic)
-	if err != nil {
-		return nil, err
+// CurrentMintTime returns the unix time in seconds that the native wallet compares quote
+// expiries against, so quote helpers agree with the wallet about what has expired
+func CurrentMintTime() uint64 {
+	return cdk_ffi.CurrentMintTime()
+}
+
+// TimeUntilExpiry returns how long the quote can still be paid, or zero once it has expired
+func (m MintQuote) TimeUntilExpiry() time.Duration {
+	return timeUntil(m.Expiry, CurrentMintTime())
+}
+
+// IsExpired reports whether the quote expiry has passed
+func (m MeltQuote) IsExpired() bool {
+	return timeUntil(m.Expiry, CurrentMintTime()) == 0
+}
+
+// timeUntil returns the time from now to expiry, both unix seconds, floored at zero
+func timeUntil(expiry, now uint64) time.Duration {
+	if expiry <= now {
+		return 0
 	}
-	return &Wallet{
-		wallet: wallet,
-	}, nil
+	return time.Duration(expiry-now) * time.Second
 }
 
-// Balance returns the wallet's balance
-func (w *Wallet) Balance() (Amount, error) {
-	amount, err := w.wallet.Balance()
+// EncodePaymentRequest returns the creqA string of a payment request (NUT-18), as shown in a QR code
+func EncodePaymentRequest(request PaymentRequest) (string, error) {
+	return cdk_ffi.EncodePaymentRequest(request.ToFFI())
+}
+
+// DecodePaymentRequest parses a creqA payment request (NUT-18) emitted by any Cashu wallet
+func DecodePaymentRequest(request string) (PaymentRequest, error) {
+	f, err := cdk_ffi.DecodePaymentRequest(request)
 	if err != nil {
-		return Amount{}, err
+		return PaymentRequest{}, err
 	}
-	return Amount{Value: amount.Value}, nil
+	return PaymentRequestFromFFI(f), nil
 }
 
-// GetMintInfo fetches and initializes mint information
-// This should be called after wallet creation to set up the mint in the database
-func (w *Wallet) GetMintInfo() (string, error) {
-	return w.wallet.GetMintInfo()
+// ReencodeToken re-encodes a token as V3 (cashuA) or V4 (cashuB) without a wallet, e.g. for a
+// relay serving older clients, and a zero version means V4. The memo is dropped unless
+// includeMemo. V4 tokens of v2 keysets cannot become V3 without the mint's keysets
+func ReencodeToken(token string, version TokenVersion, includeMemo bool) (string, error) {
+	if version == 0 {
+		version = TokenVersionV4
+	}
+	return cdk_ffi.ReencodeToken(token, cdk_ffi.FfiTokenVersion(version), includeMemo)
 }
 
-// MintUrl returns the mint URL
-func (w *Wallet) MintUrl() string {
-	return w.wallet.MintUrl()
+// TokenToRawBytes returns the binary encoding of a V4 token, as used over NFC
+func TokenToRawBytes(token string) ([]byte, error) {
+	return cdk_ffi.TokenToRawBytes(token)
 }
 
-// PreparedSend is a Go-native representation of cdk_ffi.FfiPreparedSend
-type PreparedSend struct {
-	Amount   Amount
-	SwapFee  Amount
-	SendFee  Amount
-	TotalFee Amount
+// Serialize encodes the token, proofs included, into bytes DeserializeToken reads back
+// V4 tokens use their binary encoding and V3 tokens their cashuA string
+func (t Token) Serialize() ([]byte, error) {
+	return cdk_ffi.TokenToBytes(t.tokenString)
 }
 
-// PrepareSend prepares a send operation using Go-native SendOptions
-func (w *Wallet) PrepareSend(amount Amount, options SendOptions) (PreparedSend, error) {
-	ffiOptions := options.ToFFI()
-	ffiPrepared, err := w.wallet.PrepareSend(cdk_ffi.FfiAmount{Value: amount.Value}, ffiOptions)
+// DeserializeToken decodes bytes written by Token.Serialize
+func DeserializeToken(data []byte) (Token, error) {
+	f, err := cdk_ffi.TokenFromBytes(data)
 	if err != nil {
-		return PreparedSend{}, err
+		return Token{}, err
 	}
-	return PreparedSend{
-		Amount:   Amount{Value: ffiPrepared.Amount.Value},
-		SwapFee:  Amount{Value: ffiPrepared.SwapFee.Value},
-		SendFee:  Amount{Value: ffiPrepared.SendFee.Value},
-		TotalFee: Amount{Value: ffiPrepared.TotalFee.Value},
-	}, nil
+	return TokenFromFFI(f), nil
 }
 
-// Send sends tokens using Go-native SendOptions and SendMemo
-func (w *Wallet) Send(amount Amount, options SendOptions) (Token, error) {
-	ffiOptions := options.ToFFI()
-	ffiToken, err := w.wallet.Send(cdk_ffi.FfiAmount(amount), ffiOptions, options.Memo.ToFFI())
+// VerifyTokenDleq checks the mint's DLEQ proofs (NUT-12) on every proof of a token without
+// contacting the mint. keys maps each keyset id to the mint's public key (hex) per amount.
+// It returns false if any signature does not match, and an InvalidInput error if a proof has no DLEQ
+func VerifyTokenDleq(token string, keys map[string]map[uint64]string) (bool, error) {
+	return cdk_ffi.VerifyTokenDleq(token, keys)
+}
+
+// ValidateMnemonic reports whether phrase is a valid BIP39 mnemonic, checking every word and the
+// checksum, so a restore form can flag typos before creating a wallet. Empty phrases return an error
+func ValidateMnemonic(phrase string) (bool, error) {
+	return cdk_ffi.ValidateMnemonic(phrase)
+}
+
+// Unit is a currency unit as named by the mint, e.g. "sat" or "usd"
+// Units other than the exported constants are passed to the mint as custom units
+type Unit string
+
+const (
+	Sat  Unit = "sat"
+	Msat Unit = "msat"
+	Usd  Unit = "usd"
+	Eur  Unit = "eur"
+)
+
+// ParseUnit parses a currency unit name, case insensitively
+// Unknown names are accepted as custom units, only an empty name is an error
+func ParseUnit(unit string) (Unit, error) {
+	normalized := strings.ToLower(strings.TrimSpace(unit))
+	if normalized == "" {
+		return "", fmt.Errorf("currency unit cannot be empty")
+	}
+	return Unit(normalized), nil
+}
+
+// String returns the unit name as used by the mint
+func (u Unit) String() string {
+	return string(u)
+}
+
+// msatPerSat is the factor between the sat and msat units
+const msatPerSat = 1000
+
+// ErrNotWholeSat is returned when a msat amount does not convert to a whole number of sats
+var ErrNotWholeSat = errors.New("amount is not a whole number of sats")
+
+// ErrUnsupportedConversion is returned by ConvertAmount for units without a fixed factor
+var ErrUnsupportedConversion = errors.New("unsupported unit conversion")
+
+// ToMsat converts a sat amount to msat. Like Mul, it does not check for overflow
+func (a Amount) ToMsat() Amount {
+	return a.Mul(msatPerSat)
+}
+
+// ToSat converts a msat amount to sat, or returns ErrNotWholeSat if it has a fraction of a sat
+func (a Amount) ToSat() (Amount, error) {
+	if a.Value%msatPerSat != 0 {
+		return Amount{}, fmt.Errorf("%w: %d msat", ErrNotWholeSat, a.Value)
+	}
+	return Amount{Value: a.Value / msatPerSat}, nil
+}
+
+// ConvertAmount converts an amount between sat and msat, or returns it unchanged when both
+// units are the same. Other conversions, such as sat to usd, need an exchange rate and return
+// ErrUnsupportedConversion
+func ConvertAmount(a Amount, from Unit, to Unit) (Amount, error) {
+	switch {
+	case from == to:
+		return a, nil
+	case from == Sat && to == Msat:
+		return a.ToMsat(), nil
+	case from == Msat && to == Sat:
+		return a.ToSat()
+	default:
+		return Amount{}, fmt.Errorf("%w: %s to %s", ErrUnsupportedConversion, from, to)
+	}
+}
+
+// ToFFI converts the unit for the bindings, normalized like ParseUnit, so "USD" maps to the
+// usd variant rather than a custom unit. An empty unit returns an error
+func (u Unit) ToFFI() (cdk_ffi.FfiCurrencyUnit, error) {
+	unit, err := ParseUnit(string(u))
 	if err != nil {
-		return Token{}, err
+		return nil, err
+	}
+	switch unit {
+	case Sat:
+		return cdk_ffi.FfiCurrencyUnitSat{}, nil
+	case Msat:
+		return cdk_ffi.FfiCurrencyUnitMsat{}, nil
+	case Usd:
+		return cdk_ffi.FfiCurrencyUnitUsd{}, nil
+	case Eur:
+		return cdk_ffi.FfiCurrencyUnitEur{}, nil
+	default:
+		return cdk_ffi.FfiCurrencyUnitCustom{Value: string(unit)}, nil
 	}
-	return TokenFromFFI(ffiToken), nil
 }
 
-// MeltQuote is a Go-native representation of cdk_ffi.FfiMeltQuote
-type MeltQuote struct {
-	Id              string
-	Unit            string
-	Amount          Amount
-	Request         string
-	FeeReserve      Amount
-	Expiry          uint64
-	PaymentPreimage *string
+func UnitFromFFI(f cdk_ffi.FfiCurrencyUnit) Unit {
+	switch v := f.(type) {
+	case cdk_ffi.FfiCurrencyUnitSat:
+		return Sat
+	case cdk_ffi.FfiCurrencyUnitMsat:
+		return Msat
+	case cdk_ffi.FfiCurrencyUnitUsd:
+		return Usd
+	case cdk_ffi.FfiCurrencyUnitEur:
+		return Eur
+	case cdk_ffi.FfiCurrencyUnitCustom:
+		return Unit(v.Value)
+	default:
+		return ""
+	}
 }
 
-// MeltQuote creates a melt quote for paying a Lightning invoice
-func (w *Wallet) MeltQuote(request string) (MeltQuote, error) {
-	f, err := w.wallet.MeltQuote(request)
+func RestoreFromMnemonic(minturl string, unit Unit, storage Storage, mnemonic string) (*Wallet, error) {
+	store, release, err := storage.acquire()
 	if err != nil {
-		return MeltQuote{}, err
+		return nil, err
 	}
-	return MeltQuote{
-		Id:              f.Id,
-		Unit:            f.Unit,
-		Amount:          Amount{Value: f.Amount.Value},
-		Request:         f.Request,
-		FeeReserve:      Amount{Value: f.FeeReserve.Value},
-		Expiry:          f.Expiry,
-		PaymentPreimage: f.PaymentPreimage,
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletRestoreFromMnemonic(minturl, ffiUnit, store, mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
 	}, nil
 }
 
-// MintQuote creates a mint quote for a specific amount and returns a Go-native MintQuote
-func (w *Wallet) MintQuote(amount Amount, description *string) (MintQuote, error) {
-	f, err := w.wallet.MintQuote(cdk_ffi.FfiAmount{Value: amount.Value}, description)
+// LogObserver receives the log events of the Rust library and CDK
+type LogObserver = cdk_ffi.LogObserver
+
+// LogObserverFunc adapts a plain function to the LogObserver interface
+type LogObserverFunc func(level uint8, target string, message string)
+
+// Log calls f with the event
+func (f LogObserverFunc) Log(level uint8, target string, message string) {
+	f(level, target, message)
+}
+
+// SetLogCallback sends the log events of the Rust library and CDK to cb, with levels from
+// 1 (error) to 5 (trace). It can only be set once per process, later calls return an error
+func SetLogCallback(cb LogObserver) error {
+	return cdk_ffi.SetLogCallback(cb)
+}
+
+// RestoreProgress receives progress updates while a wallet is restored
+type RestoreProgress = cdk_ffi.RestoreProgress
+
+// RestoreProgressFunc adapts a plain function to the RestoreProgress interface
+type RestoreProgressFunc func(keysetId string, restored uint32, total uint32)
+
+// OnBatch calls f with the progress of the last restore batch
+func (f RestoreProgressFunc) OnBatch(keysetId string, restored uint32, total uint32) {
+	f(keysetId, restored, total)
+}
+
+// RestoreFromMnemonicWithProgress restores a wallet like RestoreFromMnemonic, calling progress
+// after every batch with the number of keysets finished so far and the number of keysets to scan
+func RestoreFromMnemonicWithProgress(minturl string, unit Unit, storage Storage, mnemonic string, progress RestoreProgress) (*Wallet, error) {
+	store, release, err := storage.acquire()
 	if err != nil {
-		return MintQuote{}, err
+		return nil, err
 	}
-	return MintQuoteFromFFI(f), nil
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletRestoreFromMnemonicWithProgress(minturl, ffiUnit, store, mnemonic, progress)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
+	}, nil
 }
 
-// MintQuoteState gets the state of a mint quote and returns a Go-native MintQuoteBolt11
-func (w *Wallet) MintQuoteState(quoteId string) (MintQuoteBolt11, error) {
-	f, err := w.wallet.MintQuoteState(quoteId)
+func NewWalletFromMnemonic(minturl string, unit Unit, storage Storage, mnemonic string) (*Wallet, error) {
+	store, release, err := storage.acquire()
 	if err != nil {
-		return MintQuoteBolt11{}, err
+		return nil, err
 	}
-	return MintQuoteBolt11FromFFI(f), nil
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletFromMnemonic(minturl, ffiUnit, store, mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
+	}, nil
 }
 
-// Melted is a Go-native representation of cdk_ffi.FfiMelted
-type Melted struct {
-	State    string
-	Preimage *string
-	Amount   Amount
-	FeePaid  Amount
+// NewWalletFromMnemonicWithProofs creates a wallet like NewWalletFromMnemonic and stores proofs
+// in it as unspent. Every proof must belong to a keyset of the mint for unit, otherwise an
+// InvalidInput error is returned and nothing is stored
+func NewWalletFromMnemonicWithProofs(minturl string, unit Unit, storage Storage, mnemonic string, proofs []Proof) (*Wallet, error) {
+	store, release, err := storage.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	f := make([]cdk_ffi.FfiProof, 0, len(proofs))
+	for _, proof := range proofs {
+		f = append(f, proof.ToFFI())
+	}
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletFromMnemonicWithProofs(minturl, ffiUnit, store, mnemonic, f)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
+	}, nil
 }
 
-// Melt executes a melt operation (pay Lightning invoice)
-func (w *Wallet) Melt(quoteId string) (Melted, error) {
-	m, err := w.wallet.Melt(quoteId)
+// NewWalletFromMnemonicWithProxy creates a wallet like NewWalletFromMnemonic that sends every
+// mint request, and every NUT-18 payment post, through a socks5, socks5h or http proxy, e.g.
+// "socks5h://127.0.0.1:9050" for Tor
+func NewWalletFromMnemonicWithProxy(minturl string, unit Unit, storage Storage, mnemonic string, proxyUrl string) (*Wallet, error) {
+	store, release, err := storage.acquire()
 	if err != nil {
-		return Melted{}, err
+		return nil, err
 	}
-	return Melted{
-		State:    m.State,
-		Preimage: m.Preimage,
-		Amount:   Amount{Value: m.Amount.Value},
-		FeePaid:  Amount{Value: m.FeePaid.Value},
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletFromMnemonicWithProxy(minturl, ffiUnit, store, mnemonic, proxyUrl)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
 	}, nil
 }
 
-// Mint mints tokens from a quote
-func (w *Wallet) Mint(quoteId string, splitTarget SplitTarget) (Amount, error) {
-	amount, err := w.wallet.Mint(quoteId, cdk_ffi.FfiSplitTarget(splitTarget))
+// NewWalletFromMnemonicWithAuth creates a wallet like NewWalletFromMnemonic for a mint that
+// requires auth (NUT-21/22), setting its clear auth token before the wallet is returned
+func NewWalletFromMnemonicWithAuth(minturl string, unit Unit, storage Storage, mnemonic string, authToken string) (*Wallet, error) {
+	wallet, err := NewWalletFromMnemonic(minturl, unit, storage, mnemonic)
 	if err != nil {
-		return Amount{}, err
+		return nil, err
 	}
-	return Amount{Value: amount.Value}, nil
+	if err := wallet.SetAuthToken(authToken); err != nil {
+		wallet.Close()
+		return nil, err
+	}
+	return wallet, nil
 }
 
-// Unit returns the wallet's currency unit
-func (w *Wallet) Unit() string {
-	return w.wallet.Unit()
+// NewWalletFromSeed creates a wallet from a raw 64-byte BIP39 seed, or from 16 to 32 bytes
+// of mnemonic entropy, for callers that already derived the seed elsewhere
+func NewWalletFromSeed(minturl string, unit Unit, storage Storage, seed []byte) (*Wallet, error) {
+	store, release, err := storage.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.FfiWalletFromSeed(minturl, ffiUnit, store, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: wallet,
+	}, nil
+}
+
+// NewWalletFromFFI wraps a wallet created through the cdk_ffi bindings, such as a
+// *cdk_ffi.FfiWallet, so both layers can be used on the same wallet
+func NewWalletFromFFI(wallet cdk_ffi.FfiWalletInterface) *Wallet {
+	return &Wallet{
+		wallet: wallet,
+	}
+}
+
+// MultiMintWallet is one logical wallet spanning several mints, with a Wallet per mint
+// sharing the unit, mnemonic and storage it was created with
+type MultiMintWallet struct {
+	wallet *cdk_ffi.FfiMultiMintWallet
+	// mu is held for reading by every call and for writing by Close
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewMultiMintWallet(unit Unit, storage Storage, mnemonic string) (*MultiMintWallet, error) {
+	store, release, err := storage.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	ffiUnit, err := unit.ToFFI()
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := cdk_ffi.NewFfiMultiMintWallet(ffiUnit, store, mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiMintWallet{
+		wallet: wallet,
+	}, nil
+}
+
+// Close releases the underlying Rust wallet, Wallets returned by Wallet keep working
+// Calling it again is a no-op, and every other method returns ErrWalletClosed afterwards
+func (m *MultiMintWallet) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	if m.wallet != nil {
+		m.wallet.Destroy()
+	}
+	return nil
+}
+
+// AddMint adds a wallet for the mint, adding a mint that is already present has no effect
+func (m *MultiMintWallet) AddMint(url string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return ErrWalletClosed
+	}
+	return m.wallet.AddMint(url)
+}
+
+// RemoveMint stops tracking the mint and reports whether it had been added
+// Its proofs stay in storage and come back if the mint is added again
+func (m *MultiMintWallet) RemoveMint(url string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return false, ErrWalletClosed
+	}
+	return m.wallet.RemoveMint(url)
+}
+
+// Wallet returns the wallet of an added mint, or nil if the mint was not added
+func (m *MultiMintWallet) Wallet(url string) (*Wallet, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil, ErrWalletClosed
+	}
+	wallet, err := m.wallet.Wallet(url)
+	if err != nil || wallet == nil {
+		return nil, err
+	}
+	return &Wallet{
+		wallet: *wallet,
+	}, nil
+}
+
+// Wallets returns the sorted mint URLs of the added mints, nil once closed
+func (m *MultiMintWallet) Wallets() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil
+	}
+	return m.wallet.Wallets()
+}
+
+// Transfer moves amount from one added mint to another by melting at fromMint to pay a mint
+// quote of toMint, returning the amount minted there. The Lightning fee is paid on top by
+// fromMint. Failures of either leg are returned as *cdk_ffi.FfiErrorTransferFailed
+func (m *MultiMintWallet) Transfer(fromMint string, toMint string, amount Amount) (Amount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	received, err := m.wallet.Transfer(fromMint, toMint, cdk_ffi.FfiAmount(amount))
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: received.Value}, nil
+}
+
+// TotalBalance returns the sum of the balances of every added mint
+func (m *MultiMintWallet) TotalBalance() (Amount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := m.wallet.TotalBalance()
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// Pubkey returns the hex public key of the wallet's P2PK receiving key, derived from its seed
+// Tokens sent with SendOptions.Pubkey set to it are unlocked automatically by Receive. The key
+// is on a path of this library's own, other wallets restored from the same mnemonic lack it
+func (w *Wallet) Pubkey() (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return "", ErrWalletClosed
+	}
+	return w.wallet.Pubkey()
+}
+
+// Balance returns the wallet's balance
+func (w *Wallet) Balance() (Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.Balance()
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// PendingBalance returns the total of proofs in flight, such as the inputs of an unfinished melt
+func (w *Wallet) PendingBalance() (Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.PendingBalance()
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// ReservedBalance returns the total of proofs reserved by sends that were not redeemed yet
+func (w *Wallet) ReservedBalance() (Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.ReservedBalance()
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// DenominationBreakdown returns how many unspent proofs the wallet holds of each
+// denomination. Many small proofs mean sends swap more often and the wallet could consolidate
+func (w *Wallet) DenominationBreakdown() (map[uint64]uint32, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return w.wallet.DenominationBreakdown()
+}
+
+// KeysetCounters returns the NUT-13 derivation counter of every stored keyset of the wallet's
+// unit, by keyset id. A counter is the index of the next secret derived for that keyset
+func (w *Wallet) KeysetCounters() (map[string]uint32, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return w.wallet.KeysetCounters()
+}
+
+// SetKeysetCounter moves the derivation counter of a keyset forward, e.g. past secrets a
+// restore missed. Lowering a counter would reuse secrets and returns an InvalidInput error,
+// as does a keyset id the wallet has not stored
+func (w *Wallet) SetKeysetCounter(keysetId string, counter uint32) error {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.SetKeysetCounter(keysetId, counter)
+}
+
+// BalanceSnapshot is a Go-native representation of cdk_ffi.FfiBalanceSnapshot
+type BalanceSnapshot struct {
+	// Total is the sum of the three figures below
+	Total Amount `json:"total"`
+	// Spendable is the same figure as Balance
+	Spendable Amount `json:"spendable"`
+	Pending   Amount `json:"pending"`
+	Reserved  Amount `json:"reserved"`
+}
+
+func BalanceSnapshotFromFFI(f cdk_ffi.FfiBalanceSnapshot) BalanceSnapshot {
+	return BalanceSnapshot{
+		Total:     Amount{Value: f.Total.Value},
+		Spendable: Amount{Value: f.Spendable.Value},
+		Pending:   Amount{Value: f.Pending.Value},
+		Reserved:  Amount{Value: f.Reserved.Value},
+	}
+}
+
+// BalanceSnapshot returns the spendable, pending and reserved balance read together, so the
+// figures agree with each other, in one call instead of three
+func (w *Wallet) BalanceSnapshot() (BalanceSnapshot, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return BalanceSnapshot{}, ErrWalletClosed
+	}
+	f, err := w.wallet.BalanceSnapshot()
+	if err != nil {
+		return BalanceSnapshot{}, err
+	}
+	return BalanceSnapshotFromFFI(f), nil
+}
+
+// ReconcileResult is a Go-native representation of cdk_ffi.FfiReconcileResult
+type ReconcileResult struct {
+	BalanceBefore Amount `json:"balance_before"`
+	BalanceAfter  Amount `json:"balance_after"`
+	// ProofsRemoved counts proofs the mint reported spent, deleted from the store
+	ProofsRemoved uint64 `json:"proofs_removed"`
+	// ProofsRestored counts pending proofs the mint reported unspent, spendable again
+	ProofsRestored uint64 `json:"proofs_restored"`
+}
+
+func ReconcileResultFromFFI(f cdk_ffi.FfiReconcileResult) ReconcileResult {
+	return ReconcileResult{
+		BalanceBefore:  Amount{Value: f.BalanceBefore.Value},
+		BalanceAfter:   Amount{Value: f.BalanceAfter.Value},
+		ProofsRemoved:  f.ProofsRemoved,
+		ProofsRestored: f.ProofsRestored,
+	}
+}
+
+// Reconcile checks every stored proof against the mint to repair the store after a crash,
+// such as one during a melt. Proofs the mint reports spent are deleted and pending proofs it
+// reports unspent become spendable again. Proofs reserved by a send or LockProofs are kept
+func (w *Wallet) Reconcile() (ReconcileResult, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ReconcileResult{}, ErrWalletClosed
+	}
+	f, err := w.wallet.Reconcile()
+	if err != nil {
+		return ReconcileResult{}, err
+	}
+	return ReconcileResultFromFFI(f), nil
+}
+
+// GetMintInfo fetches and initializes mint information
+// This should be called after wallet creation to set up the mint in the database
+func (w *Wallet) GetMintInfo() (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return "", ErrWalletClosed
+	}
+	return w.wallet.GetMintInfo()
+}
+
+// VerifyTokenDleq checks a token's DLEQ proofs against the keys stored for the wallet's mint
+// No request is made, so the mint's keysets must have been loaded before
+func (w *Wallet) VerifyTokenDleq(token string) (bool, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return false, ErrWalletClosed
+	}
+	return w.wallet.VerifyTokenDleq(token)
+}
+
+// ListKeysets lists every keyset the mint has announced, active or not, with its input fee
+func (w *Wallet) ListKeysets() ([]KeysetInfo, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.ListKeysets()
+	if err != nil {
+		return nil, err
+	}
+	keysets := make([]KeysetInfo, 0, len(f))
+	for _, keyset := range f {
+		keysets = append(keysets, KeysetInfoFromFFI(keyset))
+	}
+	return keysets, nil
+}
+
+// RefreshKeysets fetches the mint's keysets and swaps unspent proofs of keysets it no longer
+// keeps active into the active one, paying their input fee, so they stay spendable after a
+// key rotation
+func (w *Wallet) RefreshKeysets() (KeysetRefreshResult, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return KeysetRefreshResult{}, ErrWalletClosed
+	}
+	f, err := w.wallet.RefreshKeysets()
+	if err != nil {
+		return KeysetRefreshResult{}, err
+	}
+	return KeysetRefreshResultFromFFI(f), nil
+}
+
+// MintInfo fetches the mint's NUT-06 info as a Go-native MintInfo
+func (w *Wallet) MintInfo() (MintInfo, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintInfo{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MintInfo()
+	if err != nil {
+		return MintInfo{}, err
+	}
+	return MintInfoFromFFI(f), nil
+}
+
+// MintUrl returns the mint URL, or an empty string after Close
+func (w *Wallet) MintUrl() string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ""
+	}
+	return w.wallet.MintUrl()
+}
+
+// UpdateMintUrl moves the wallet's stored proofs, quotes and mint info to the URL its mint
+// migrated to. It returns an InvalidInput error unless the mint answering at newUrl has the
+// pubkey stored for the current mint. Every later call fails, reopen the wallet at newUrl
+func (w *Wallet) UpdateMintUrl(newUrl string) error {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.UpdateMintUrl(newUrl)
+}
+
+// PreparedSend is a Go-native representation of cdk_ffi.FfiPreparedSend
+type PreparedSend struct {
+	Amount   Amount
+	SwapFee  Amount
+	SendFee  Amount
+	TotalFee Amount
+	// ProofCount is the number of stored proofs the send will spend
+	ProofCount uint32
+	// RequiresSwap is true when the proofs must be swapped before they can be sent
+	RequiresSwap bool
+	// InputFee is the NUT-02 fee for spending every selected proof. It is not added to
+	// TotalFee because SwapFee and SendFee are already made of these input fees
+	InputFee Amount
+	// ReservationId identifies the reserved proofs for ConfirmSend, empty for a dry run
+	ReservationId string
+}
+
+func PreparedSendFromFFI(f cdk_ffi.FfiPreparedSend) PreparedSend {
+	return PreparedSend{
+		Amount:        Amount{Value: f.Amount.Value},
+		SwapFee:       Amount{Value: f.SwapFee.Value},
+		SendFee:       Amount{Value: f.SendFee.Value},
+		TotalFee:      Amount{Value: f.TotalFee.Value},
+		ProofCount:    f.ProofCount,
+		RequiresSwap:  f.RequiresSwap,
+		InputFee:      Amount{Value: f.InputFee.Value},
+		ReservationId: f.ReservationId,
+	}
+}
+
+func (p PreparedSend) ToFFI() cdk_ffi.FfiPreparedSend {
+	return cdk_ffi.FfiPreparedSend{
+		Amount:        cdk_ffi.FfiAmount{Value: p.Amount.Value},
+		SwapFee:       cdk_ffi.FfiAmount{Value: p.SwapFee.Value},
+		SendFee:       cdk_ffi.FfiAmount{Value: p.SendFee.Value},
+		TotalFee:      cdk_ffi.FfiAmount{Value: p.TotalFee.Value},
+		ProofCount:    p.ProofCount,
+		RequiresSwap:  p.RequiresSwap,
+		InputFee:      cdk_ffi.FfiAmount{Value: p.InputFee.Value},
+		ReservationId: p.ReservationId,
+	}
+}
+
+// PrepareSend reserves the proofs for a send and reports its fees, ConfirmSend finishes it
+// With options.DryRun set it only previews the fees and reserves nothing. The reservation is
+// recorded in the storage, so other wallets on it leave the proofs alone until it expires.
+// Proofs of a send neither confirmed nor canceled in time return to the balance the next time
+// a wallet is opened on the storage
+func (w *Wallet) PrepareSend(amount Amount, options SendOptions) (PreparedSend, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return PreparedSend{}, ErrWalletClosed
+	}
+	ffiOptions := options.ToFFI()
+	ffiPrepared, err := w.wallet.PrepareSend(cdk_ffi.FfiAmount{Value: amount.Value}, ffiOptions)
+	if err != nil {
+		return PreparedSend{}, err
+	}
+	return PreparedSendFromFFI(ffiPrepared), nil
+}
+
+// ConfirmSend finishes a send from PrepareSend with the proofs it reserved, for the fee it
+// reported. A reservation that is unknown, already finished or older than the TTL set with
+// SetPreparedSendTtl, ten minutes by default, returns an InvalidInput error
+func (w *Wallet) ConfirmSend(prepared PreparedSend) (Token, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Token{}, ErrWalletClosed
+	}
+	ffiToken, err := w.wallet.ConfirmSend(prepared.ToFFI())
+	if err != nil {
+		return Token{}, err
+	}
+	return TokenFromFFI(ffiToken), nil
+}
+
+// CancelPreparedSend releases the proofs PrepareSend reserved back to the balance, without a
+// swap. A reservation that is unknown, already finished or expired returns an InvalidInput error
+func (w *Wallet) CancelPreparedSend(prepared PreparedSend) error {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.CancelPreparedSend(prepared.ToFFI())
+}
+
+// Send sends tokens using Go-native SendOptions and SendMemo
+// A balance too small for the amount fails with *cdk_ffi.FfiErrorInsufficientFunds, and an
+// offline Kind that stored proofs cannot meet without a swap with *cdk_ffi.FfiErrorOfflineSendImpossible
+func (w *Wallet) Send(amount Amount, options SendOptions) (Token, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Token{}, ErrWalletClosed
+	}
+	ffiOptions := options.ToFFI()
+	ffiToken, err := w.wallet.Send(cdk_ffi.FfiAmount(amount), ffiOptions, options.Memo.ToFFI())
+	if err != nil {
+		return Token{}, err
+	}
+	return TokenFromFFI(ffiToken), nil
+}
+
+// PayPaymentRequest pays a creqA payment request (NUT-18) with a token for the requested
+// amount, which must be in this wallet's unit and, when the request lists mints, from one of
+// them. With an HTTP POST transport the token is also delivered to the payee and reclaimed if
+// that fails; otherwise the caller hands over the returned token. Proofs are selected as Send
+// selects them with options. The POST is bounded by SetRequestTimeout, or 30 seconds by
+// default
+func (w *Wallet) PayPaymentRequest(request string, options SendOptions) (Token, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Token{}, ErrWalletClosed
+	}
+	ffiToken, err := w.wallet.PayPaymentRequest(request, options.ToFFI())
+	if err != nil {
+		return Token{}, err
+	}
+	return TokenFromFFI(ffiToken), nil
+}
+
+// Receive receives an encoded token using Go-native ReceiveOptions, returning the amount
+// received along with the token's memo and unit
+func (w *Wallet) Receive(token string, options ReceiveOptions) (ReceiveResult, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ReceiveResult{}, ErrWalletClosed
+	}
+	result, err := w.wallet.Receive(token, options.ToFFI())
+	if err != nil {
+		return ReceiveResult{}, err
+	}
+	return ReceiveResultFromFFI(result), nil
+}
+
+// ReceiveBatch receives several tokens, swapping those of the wallet's mint and unit together
+// in one request where it can. Tokens that could not be received are listed in Failed by
+// their index in tokens, and the others add up to Amount
+func (w *Wallet) ReceiveBatch(tokens []string, options ReceiveOptions) (ReceiveBatchResult, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ReceiveBatchResult{}, ErrWalletClosed
+	}
+	result, err := w.wallet.ReceiveBatch(tokens, options.ToFFI())
+	if err != nil {
+		return ReceiveBatchResult{}, err
+	}
+	return ReceiveBatchResultFromFFI(result), nil
+}
+
+// ReceiveOffline stores a token's proofs as they are, without contacting the mint. Use it only
+// between wallets of the same owner: the sender keeps the secrets, and proofs that were already
+// spent are only noticed when spending them fails. Tokens from another mint or unit, locked with
+// P2PK or HTLC, or with a keyset this wallet has not loaded return an InvalidInput error
+func (w *Wallet) ReceiveOffline(token string) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.ReceiveOffline(token)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// Swap consolidates stored proofs into the target split without sending anything
+// A nil amount swaps every unspent proof, the returned amount is what is left after fees
+func (w *Wallet) Swap(amount *Amount, target SplitTarget) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	var ffiAmount *cdk_ffi.FfiAmount
+	if amount != nil {
+		ffiAmount = &cdk_ffi.FfiAmount{Value: amount.Value}
+	}
+	swapped, err := w.wallet.Swap(ffiAmount, cdk_ffi.FfiSplitTarget(target))
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: swapped.Value}, nil
+}
+
+// ReclaimSend swaps the proofs of a sent token back into the wallet if the recipient has not
+// redeemed it yet, returning the amount reclaimed after fees. A token that was already redeemed
+// fails with an InvalidInput error, a failed swap with a NetworkError
+func (w *Wallet) ReclaimSend(token string) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.ReclaimSend(token)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// CheckProofStates asks the mint whether the stored proofs are still unspent
+func (w *Wallet) CheckProofStates() ([]ProofState, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.CheckProofStates()
+	if err != nil {
+		return nil, err
+	}
+	states := make([]ProofState, 0, len(f))
+	for _, state := range f {
+		states = append(states, ProofStateFromFFI(state))
+	}
+	return states, nil
+}
+
+// ExportProofs returns the wallet's unspent proofs as a blob encrypted with passphrase, for
+// storing off-device. Reserved and pending proofs are not included
+func (w *Wallet) ExportProofs(passphrase string) ([]byte, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return w.wallet.ExportProofs(passphrase)
+}
+
+// ImportProofs receives the proofs of a blob written by ExportProofs and returns the amount
+// recovered. A wrong passphrase or a corrupt blob returns an InvalidInput error
+func (w *Wallet) ImportProofs(blob []byte, passphrase string) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.ImportProofs(blob, passphrase)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// ListProofs returns the stored proofs of the wallet, a nil state returns them all
+func (w *Wallet) ListProofs(state *ProofStateFilter) ([]Proof, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.ListProofs(state.ToFFI())
+	if err != nil {
+		return nil, err
+	}
+	proofs := make([]Proof, 0, len(f))
+	for _, proof := range f {
+		proofs = append(proofs, ProofFromFFI(proof))
+	}
+	return proofs, nil
+}
+
+// LockProofs keeps the proofs with the given secrets out of coin selection, e.g. to hold them
+// for a later offline payment. Locked proofs count as reserved until UnlockProofs. A secret
+// that is not an unspent proof of the wallet returns an InvalidInput error
+func (w *Wallet) LockProofs(secrets []string) error {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.LockProofs(secrets)
+}
+
+// UnlockProofs returns proofs locked with LockProofs to coin selection. A secret of a proof
+// that is not locked returns an InvalidInput error
+func (w *Wallet) UnlockProofs(secrets []string) error {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.UnlockProofs(secrets)
+}
+
+// TokenState asks the mint whether the proofs of a received token are still unspent, so an
+// already redeemed token can be refused. Tokens from another mint return an InvalidInput error
+func (w *Wallet) TokenState(token string) (TokenState, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return 0, ErrWalletClosed
+	}
+	state, err := w.wallet.TokenState(token)
+	if err != nil {
+		return 0, err
+	}
+	return TokenState(state), nil
+}
+
+// MeltQuote is a Go-native representation of cdk_ffi.FfiMeltQuote
+type MeltQuote struct {
+	Id              string  `json:"id"`
+	Unit            string  `json:"unit"`
+	Amount          Amount  `json:"amount"`
+	Request         string  `json:"request"`
+	FeeReserve      Amount  `json:"fee_reserve"`
+	Expiry          uint64  `json:"expiry"`
+	PaymentPreimage *string `json:"payment_preimage,omitempty"`
+	// PaymentHash is the hex payment hash of the bolt11 request, empty for other request types
+	PaymentHash string `json:"payment_hash"`
+}
+
+// MeltQuote creates a melt quote for paying a Lightning invoice
+func (w *Wallet) MeltQuote(request string) (MeltQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MeltQuote{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MeltQuote(request)
+	if err != nil {
+		return MeltQuote{}, err
+	}
+	return MeltQuoteFromFFI(f), nil
+}
+
+// MeltQuoteMpp creates a NUT-15 multi-path melt quote that pays only partialAmount of the invoice
+// The caller must quote and pay the remaining parts at other mints, the invoice settles once all
+// parts are paid. Mints that do not advertise NUT-15 for the wallet unit return an InvalidInput error
+func (w *Wallet) MeltQuoteMpp(request string, partialAmount Amount) (MeltQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MeltQuote{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MeltQuoteMpp(request, cdk_ffi.FfiAmount(partialAmount))
+	if err != nil {
+		return MeltQuote{}, err
+	}
+	return MeltQuoteFromFFI(f), nil
+}
+
+// EstimateReceiveFee returns the input fee the mint charges to swap a token's proofs when
+// Receive redeems it, e.g. to show "you'll receive 98 of 100 sat". The mint is not contacted,
+// so a token from another mint or with a keyset the wallet has not loaded is InvalidInput
+func (w *Wallet) EstimateReceiveFee(token string) (Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.EstimateReceiveFee(token)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// EstimateMeltFee approximates the fee reserve a melt quote for the invoice would ask for,
+// without contacting the mint or creating a quote. Unparseable invoices return an InvalidInput error
+func (w *Wallet) EstimateMeltFee(request string) (Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.EstimateMeltFee(request)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// SetFeeReservePercent makes EstimateMeltFee reserve percent of the amount instead of the rate
+// learned from earlier melt quotes. It only changes that estimate: Melt still reserves what the
+// mint quotes. It is saved in the storage for the wallet's mint and unit, so it survives
+// reopening the wallet. Values outside 0 to 100 return an InvalidInput error
+func (w *Wallet) SetFeeReservePercent(percent float64) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.SetFeeReservePercent(percent)
+}
+
+// FeeReservePercent returns the estimate rate set with SetFeeReservePercent, or nil while the
+// learned rate is used
+func (w *Wallet) FeeReservePercent() *float64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil
+	}
+	return w.wallet.FeeReservePercent()
+}
+
+// SetRequestTimeout makes quote, state and mint info calls that take longer than timeout fail
+// with an error matching cdk_ffi.ErrFfiErrorTimeout, rounded up to whole seconds. Zero or less
+// removes the limit. Calls that move proofs, such as Send, Receive, Melt, Swap and Mint, always
+// run to completion: cutting them short could lose outputs the mint already signed
+func (w *Wallet) SetRequestTimeout(timeout time.Duration) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return
+	}
+	var secs uint64
+	if timeout > 0 {
+		secs = uint64((timeout + time.Second - 1) / time.Second)
+	}
+	w.wallet.SetRequestTimeout(secs)
+}
+
+// SetPreparedSendTtl sets how long sends prepared from now on hold their proofs before
+// ConfirmSend refuses them, rounded up to whole seconds. Zero or less expires them at once
+func (w *Wallet) SetPreparedSendTtl(ttl time.Duration) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return
+	}
+	var secs uint64
+	if ttl > 0 {
+		secs = uint64((ttl + time.Second - 1) / time.Second)
+	}
+	w.wallet.SetPreparedSendTtl(secs)
+}
+
+// SetDeterministic orders coin selection by seed, so the same proofs and seed always select
+// the same proofs and yield the same token. It is meant for tests: secrets already follow the
+// wallet seed, and a predictable selection makes payments easier to link, so never call it in
+// production
+func (w *Wallet) SetDeterministic(seed uint64) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return
+	}
+	w.wallet.SetDeterministic(seed)
+}
+
+// SetRetryPolicy retries failed requests of GetMintInfo, MintInfo, MintQuoteState,
+// MintQuoteStates and ListKeysets up to maxRetries times, waiting baseDelay and then twice as
+// long before each further retry. Only transport failures and responses that are not a mint
+// error, such as a 502, are retried. Calls that move funds, like Mint or Melt, never are
+func (w *Wallet) SetRetryPolicy(maxRetries uint8, baseDelay time.Duration) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return
+	}
+	var delayMs uint64
+	if baseDelay > 0 {
+		delayMs = uint64(baseDelay / time.Millisecond)
+	}
+	w.wallet.SetRetryPolicy(maxRetries, delayMs)
+}
+
+// SetAuthToken sets the clear auth token (NUT-21) sent to mints that protect endpoints, which
+// the wallet also spends to mint blind auth tokens (NUT-22). Mints without auth ignore it
+func (w *Wallet) SetAuthToken(token string) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.SetAuthToken(token)
+}
+
+// SetMetadata stores an app defined value, such as a label, for this wallet's mint and unit
+// in the local store, replacing an earlier value under the same key
+func (w *Wallet) SetMetadata(key, value string) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrWalletClosed
+	}
+	return w.wallet.SetMetadata(key, value)
+}
+
+// GetMetadata returns the value stored with SetMetadata under key, or nil if there is none
+func (w *Wallet) GetMetadata(key string) (*string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return w.wallet.GetMetadata(key)
+}
+
+// AllMetadata returns every value stored with SetMetadata for this wallet's mint and unit
+func (w *Wallet) AllMetadata() (map[string]string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return w.wallet.AllMetadata()
+}
+
+func MeltQuoteFromFFI(f cdk_ffi.FfiMeltQuote) MeltQuote {
+	return MeltQuote{
+		Id:              f.Id,
+		Unit:            f.Unit,
+		Amount:          Amount{Value: f.Amount.Value},
+		Request:         f.Request,
+		FeeReserve:      Amount{Value: f.FeeReserve.Value},
+		Expiry:          f.Expiry,
+		PaymentPreimage: f.PaymentPreimage,
+		PaymentHash:     f.PaymentHash,
+	}
+}
+
+// MintQuote creates a mint quote for a specific amount and returns a Go-native MintQuote
+func (w *Wallet) MintQuote(amount Amount, description *string) (MintQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintQuote{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MintQuote(cdk_ffi.FfiAmount{Value: amount.Value}, description)
+	if err != nil {
+		return MintQuote{}, err
+	}
+	return MintQuoteFromFFI(f), nil
+}
+
+// MintQuoteWithOptions creates a mint quote whose lifetime can be shortened by the wallet
+// An expiry in the past returns an InvalidInput error
+func (w *Wallet) MintQuoteWithOptions(amount Amount, options MintQuoteOptions) (MintQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintQuote{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MintQuoteWithOptions(cdk_ffi.FfiAmount{Value: amount.Value}, options.ToFFI())
+	if err != nil {
+		return MintQuote{}, err
+	}
+	return MintQuoteFromFFI(f), nil
+}
+
+// MintQuoteState gets the state of a mint quote and returns a Go-native MintQuoteBolt11
+func (w *Wallet) MintQuoteState(quoteId string) (MintQuoteBolt11, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintQuoteBolt11{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MintQuoteState(quoteId)
+	if err != nil {
+		return MintQuoteBolt11{}, err
+	}
+	return MintQuoteBolt11FromFFI(f), nil
+}
+
+// MintQuoteStates gets the states of several mint quotes in one call, keyed by quote id
+func (w *Wallet) MintQuoteStates(quoteIds []string) (map[string]MintQuoteBolt11, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.MintQuoteStates(quoteIds)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string]MintQuoteBolt11, len(f))
+	for i, state := range f {
+		states[quoteIds[i]] = MintQuoteBolt11FromFFI(state)
+	}
+	return states, nil
+}
+
+// WaitForMintQuotePaid blocks until a mint quote is paid or d elapses, rounded up to whole seconds
+// When the quote is still unpaid the error matches cdk_ffi.ErrFfiErrorTimeout with errors.Is
+func (w *Wallet) WaitForMintQuotePaid(quoteId string, d time.Duration) (MintQuoteBolt11, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintQuoteBolt11{}, ErrWalletClosed
+	}
+	timeoutSecs := uint64((d + time.Second - 1) / time.Second)
+	f, err := w.wallet.WaitForMintQuotePaid(quoteId, timeoutSecs)
+	if err != nil {
+		return MintQuoteBolt11{}, err
+	}
+	return MintQuoteBolt11FromFFI(f), nil
+}
+
+// MintQuoteObserver receives state changes of a subscribed mint quote
+type MintQuoteObserver interface {
+	OnUpdate(state MintQuoteState)
+}
+
+// MintQuoteObserverFunc adapts a plain function to the MintQuoteObserver interface
+type MintQuoteObserverFunc func(state MintQuoteState)
+
+// OnUpdate calls f with the new quote state
+func (f MintQuoteObserverFunc) OnUpdate(state MintQuoteState) {
+	f(state)
+}
+
+// mintQuoteObserver converts the FFI quote state before handing it to a MintQuoteObserver
+type mintQuoteObserver struct {
+	observer MintQuoteObserver
+}
+
+func (o mintQuoteObserver) OnUpdate(state cdk_ffi.FfiMintQuoteState) {
+	o.observer.OnUpdate(MintQuoteState(state))
+}
+
+// Subscription is an active mint quote or balance subscription
+type Subscription struct {
+	subscription *cdk_ffi.FfiSubscription
+}
+
+// Unsubscribe stops the updates, calling it again has no effect
+func (s *Subscription) Unsubscribe() {
+	s.subscription.Unsubscribe()
+}
+
+// SubscribeMintQuote calls observer with the state of a mint quote now and after every change,
+// until Unsubscribe is called. Updates are delivered from a background thread
+func (w *Wallet) SubscribeMintQuote(quoteId string, observer MintQuoteObserver) (*Subscription, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	subscription, err := w.wallet.SubscribeMintQuote(quoteId, mintQuoteObserver{observer: observer})
+	if err != nil {
+		return nil, err
+	}
+	return &Subscription{subscription: subscription}, nil
+}
+
+// MintedObserver receives the outcome of AutoMintOnPayment, exactly one method is called
+type MintedObserver interface {
+	OnMinted(amount Amount)
+	OnError(err string)
+}
+
+// autoMinter mints a quote with Wallet.Mint once it is reported paid, so the mint holds the
+// same lock as every other call that moves proofs
+type autoMinter struct {
+	wallet      *Wallet
+	quoteId     string
+	splitTarget SplitTarget
+	observer    MintedObserver
+	once        sync.Once
+	// Receives the subscription once SubscribeMintQuote returned, to end it after the mint
+	subscription chan *Subscription
+}
+
+func (m *autoMinter) OnUpdate(state MintQuoteState) {
+	if state == MintQuoteStateUnpaid {
+		return
+	}
+	m.once.Do(func() {
+		// Updates arrive on a thread of the Rust library, which must not block on the wallet
+		go func() {
+			defer (<-m.subscription).Unsubscribe()
+			if state == MintQuoteStateIssued {
+				m.observer.OnError("Mint quote was already issued")
+				return
+			}
+			amount, err := m.wallet.Mint(m.quoteId, m.splitTarget)
+			if err != nil {
+				m.observer.OnError(err.Error())
+				return
+			}
+			m.observer.OnMinted(amount)
+		}()
+	})
+}
+
+// AutoMintOnPayment watches a mint quote like SubscribeMintQuote and mints it with Mint as
+// soon as it is paid, then calls observer once with the minted amount or the error.
+// Unsubscribe before the payment cancels it. observer is called from its own goroutine and
+// may use the wallet
+func (w *Wallet) AutoMintOnPayment(quoteId string, splitTarget SplitTarget, observer MintedObserver) (*Subscription, error) {
+	minter := &autoMinter{
+		wallet:       w,
+		quoteId:      quoteId,
+		splitTarget:  splitTarget,
+		observer:     observer,
+		subscription: make(chan *Subscription, 1),
+	}
+	subscription, err := w.SubscribeMintQuote(quoteId, minter)
+	if err != nil {
+		return nil, err
+	}
+	minter.subscription <- subscription
+	return subscription, nil
+}
+
+// BalanceObserver receives the wallet balance after a call changed it
+type BalanceObserver interface {
+	OnChange(balance Amount)
+}
+
+// BalanceObserverFunc adapts a plain function to the BalanceObserver interface
+type BalanceObserverFunc func(balance Amount)
+
+// OnChange calls f with the new balance
+func (f BalanceObserverFunc) OnChange(balance Amount) {
+	f(balance)
+}
+
+// balanceObserver converts the FFI amount before handing it to a BalanceObserver
+type balanceObserver struct {
+	observer BalanceObserver
+}
+
+func (o balanceObserver) OnChange(balance cdk_ffi.FfiAmount) {
+	o.observer.OnChange(Amount{Value: balance.Value})
+}
+
+// ObserveBalance calls observer with the spendable balance whenever a call on the wallet, such
+// as a mint, melt, send, receive or swap, changed it, until Unsubscribe is called. Updates are
+// delivered from a background thread, and observer must not call the wallet synchronously
+// from OnChange: hand the balance to another goroutine instead
+func (w *Wallet) ObserveBalance(observer BalanceObserver) (*Subscription, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	subscription, err := w.wallet.ObserveBalance(balanceObserver{observer: observer})
+	if err != nil {
+		return nil, err
+	}
+	return &Subscription{subscription: subscription}, nil
+}
+
+// ListTransactions lists the wallet's transaction history, newest first
+// A nil filter returns every transaction for the wallet's mint
+func (w *Wallet) ListTransactions(filter *TransactionFilter) ([]Transaction, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.ListTransactions(filter.ToFFI())
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]Transaction, 0, len(f))
+	for _, transaction := range f {
+		transactions = append(transactions, TransactionFromFFI(transaction))
+	}
+	return transactions, nil
+}
+
+// ListTransactionsPage returns up to limit transactions of the unfiltered history, newest
+// first, skipping the offset newest ones. A file storage reads only that page, an in-memory
+// storage sorts its whole history for every page
+func (w *Wallet) ListTransactionsPage(offset uint32, limit uint32) ([]Transaction, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.ListTransactionsPage(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]Transaction, 0, len(f))
+	for _, transaction := range f {
+		transactions = append(transactions, TransactionFromFFI(transaction))
+	}
+	return transactions, nil
+}
+
+// TransactionIterator walks the transaction history newest first, holding one page at a time
+// Each page is a single ListTransactionsPage call, so on a file storage a long history is
+// never loaded whole. On an in-memory storage every page sorts the whole history
+type TransactionIterator struct {
+	wallet   *Wallet
+	pageSize uint32
+	offset   uint32
+	page     []Transaction
+	// Ids of the last page fetched, skipped if a new transaction pushes them into the next one
+	previous map[string]struct{}
+	done     bool
+}
+
+// TransactionIterator returns an iterator over the transaction history that fetches pageSize
+// transactions per call
+func (w *Wallet) TransactionIterator(pageSize uint32) (*TransactionIterator, error) {
+	if pageSize == 0 {
+		return nil, errors.New("page size must be positive")
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	return &TransactionIterator{wallet: w, pageSize: pageSize}, nil
+}
+
+// Next returns the next older transaction, or false once the history is exhausted
+func (it *TransactionIterator) Next() (Transaction, bool, error) {
+	for len(it.page) == 0 {
+		if it.done {
+			return Transaction{}, false, nil
+		}
+		if err := it.fetch(); err != nil {
+			return Transaction{}, false, err
+		}
+	}
+	transaction := it.page[0]
+	it.page = it.page[1:]
+	return transaction, true, nil
+}
+
+func (it *TransactionIterator) fetch() error {
+	page, err := it.wallet.ListTransactionsPage(it.offset, it.pageSize)
+	if err != nil {
+		return err
+	}
+	it.offset += uint32(len(page))
+	it.done = uint32(len(page)) < it.pageSize
+
+	previous := it.previous
+	it.previous = make(map[string]struct{}, len(page))
+	for _, transaction := range page {
+		it.previous[transaction.Id] = struct{}{}
+		if _, seen := previous[transaction.Id]; !seen {
+			it.page = append(it.page, transaction)
+		}
+	}
+	return nil
+}
+
+// NetFlow summarizes the transaction history between since and until (unix seconds, inclusive)
+// An empty window returns a zero NetFlow
+func (w *Wallet) NetFlow(since uint64, until uint64) (NetFlow, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return NetFlow{}, ErrWalletClosed
+	}
+	f, err := w.wallet.NetFlow(since, until)
+	if err != nil {
+		return NetFlow{}, err
+	}
+	return NetFlowFromFFI(f), nil
+}
+
+// OperationAvailability reports which operations the mint currently advertises as enabled
+// MintQuote and MeltQuote fail early with cdk_ffi.ErrFfiErrorOperationDisabled when the cached mint info disables them
+func (w *Wallet) OperationAvailability() (OperationAvailability, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return OperationAvailability{}, ErrWalletClosed
+	}
+	f, err := w.wallet.OperationAvailability()
+	if err != nil {
+		return OperationAvailability{}, err
+	}
+	return OperationAvailabilityFromFFI(f), nil
+}
+
+// DiagnosticReport returns a redacted snapshot of the wallet for support bundles
+func (w *Wallet) DiagnosticReport() (Diagnostics, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Diagnostics{}, ErrWalletClosed
+	}
+	f, err := w.wallet.DiagnosticReport()
+	if err != nil {
+		return Diagnostics{}, err
+	}
+	return DiagnosticsFromFFI(f), nil
+}
+
+// PendingMintQuotes lists the stored mint quotes still to be finished, unpaid ones that have
+// not expired and paid ones not yet minted, so an app can resume them after a restart
+func (w *Wallet) PendingMintQuotes() ([]MintQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.PendingMintQuotes()
+	if err != nil {
+		return nil, err
+	}
+	quotes := make([]MintQuote, 0, len(f))
+	for _, quote := range f {
+		quotes = append(quotes, MintQuoteFromFFI(quote))
+	}
+	return quotes, nil
+}
+
+// PendingMeltQuotes lists the stored melt quotes still to be finished, unpaid ones that have
+// not expired and ones whose payment is still in flight. CDK does not record which mint a melt
+// quote belongs to, so a store shared across mints lists the melt quotes of every mint in the unit
+func (w *Wallet) PendingMeltQuotes() ([]MeltQuote, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.PendingMeltQuotes()
+	if err != nil {
+		return nil, err
+	}
+	quotes := make([]MeltQuote, 0, len(f))
+	for _, quote := range f {
+		quotes = append(quotes, MeltQuoteFromFFI(quote))
+	}
+	return quotes, nil
+}
+
+// PendingQuoteExpiries lists unpaid mint and melt quotes with the seconds left until they expire, soonest first
+// Melt quotes are matched on unit only, as CDK does not record which mint they belong to
+func (w *Wallet) PendingQuoteExpiries() ([]QuoteExpiry, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.PendingQuoteExpiries()
+	if err != nil {
+		return nil, err
+	}
+	expiries := make([]QuoteExpiry, 0, len(f))
+	for _, expiry := range f {
+		expiries = append(expiries, QuoteExpiryFromFFI(expiry))
+	}
+	return expiries, nil
+}
+
+// Melted is a Go-native representation of cdk_ffi.FfiMelted
+type Melted struct {
+	State    string  `json:"state"`
+	Preimage *string `json:"preimage,omitempty"`
+	Amount   Amount  `json:"amount"`
+	// FeePaid is the Lightning fee actually spent, so the melt cost Amount + FeePaid
+	FeePaid Amount `json:"fee_paid"`
+	// Change is the unused fee reserve the mint returned as new proofs (NUT-08), already
+	// taken off FeePaid
+	Change Amount `json:"change"`
+}
+
+func MeltedFromFFI(m cdk_ffi.FfiMelted) Melted {
+	return Melted{
+		State:    m.State,
+		Preimage: m.Preimage,
+		Amount:   Amount{Value: m.Amount.Value},
+		FeePaid:  Amount{Value: m.FeePaid.Value},
+		Change:   Amount{Value: m.Change.Value},
+	}
+}
+
+// MeltBatchEntry is a Go-native representation of cdk_ffi.FfiMeltBatchEntry
+type MeltBatchEntry struct {
+	// Index is the position of the invoice in the batch
+	Index uint32 `json:"index"`
+	// Melted is set once the invoice is paid
+	Melted *Melted `json:"melted,omitempty"`
+	// Error says why the invoice was not paid, empty when it was
+	Error string `json:"error,omitempty"`
+}
+
+func MeltBatchEntryFromFFI(f cdk_ffi.FfiMeltBatchEntry) MeltBatchEntry {
+	entry := MeltBatchEntry{Index: f.Index}
+	if f.Melted != nil {
+		melted := MeltedFromFFI(*f.Melted)
+		entry.Melted = &melted
+	}
+	if f.Error != nil {
+		entry.Error = *f.Error
+	}
+	return entry
+}
+
+// Melt executes a melt operation (pay Lightning invoice)
+func (w *Wallet) Melt(quoteId string) (Melted, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Melted{}, ErrWalletClosed
+	}
+	m, err := w.wallet.Melt(quoteId)
+	if err != nil {
+		return Melted{}, err
+	}
+	return MeltedFromFFI(m), nil
+}
+
+// MeltWithMaxFee executes a melt only if the quote's fee reserve is at most maxFee
+// Otherwise nothing is paid and the error matches cdk_ffi.ErrFfiErrorFeeTooHigh with errors.Is
+func (w *Wallet) MeltWithMaxFee(quoteId string, maxFee Amount) (Melted, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Melted{}, ErrWalletClosed
+	}
+	m, err := w.wallet.MeltWithMaxFee(quoteId, cdk_ffi.FfiAmount(maxFee))
+	if err != nil {
+		return Melted{}, err
+	}
+	return MeltedFromFFI(m), nil
+}
+
+// MeltBatch pays several Lightning invoices in order, returning one entry per invoice with
+// either the melt or the error that kept it from being paid. A failed invoice does not stop
+// the others, except when it runs out of funds: the invoices after it are skipped.
+// Nothing is paid when melting is disabled at the mint, a *cdk_ffi.FfiErrorOperationDisabled,
+// or when the balance cannot cover the invoices with their fee reserves and input fees, a
+// *cdk_ffi.FfiErrorInsufficientFunds with the amount the batch needs. No quote is left behind
+func (w *Wallet) MeltBatch(invoices []string) ([]MeltBatchEntry, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return nil, ErrWalletClosed
+	}
+	f, err := w.wallet.MeltBatch(invoices)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]MeltBatchEntry, 0, len(f))
+	for _, entry := range f {
+		entries = append(entries, MeltBatchEntryFromFFI(entry))
+	}
+	return entries, nil
+}
+
+// MintAndWait creates a mint quote, waits up to d (rounded up to whole seconds) for it to be
+// paid and mints it, for scripts and test mints that pay their own invoices. The quote is only
+// returned at the end, so apps that show the invoice use MintQuote and WaitForMintQuotePaid.
+// Only the final Mint holds the proofs lock, so sends and receives run during the wait.
+// When the quote is still unpaid the error matches cdk_ffi.ErrFfiErrorTimeout with errors.Is
+func (w *Wallet) MintAndWait(amount Amount, description *string, d time.Duration, splitTarget SplitTarget) (MintQuote, Amount, error) {
+	quote, err := w.MintQuote(amount, description)
+	if err != nil {
+		return MintQuote{}, Amount{}, err
+	}
+	if _, err := w.WaitForMintQuotePaid(quote.Id, d); err != nil {
+		return MintQuote{}, Amount{}, err
+	}
+	minted, err := w.Mint(quote.Id, splitTarget)
+	if err != nil {
+		return MintQuote{}, Amount{}, err
+	}
+	quote.State = MintQuoteStateIssued
+	return quote, minted, nil
+}
+
+// Mint mints tokens from a quote
+func (w *Wallet) Mint(quoteId string, splitTarget SplitTarget) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	amount, err := w.wallet.Mint(quoteId, cdk_ffi.FfiSplitTarget(splitTarget))
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// MintResult is a Go-native representation of cdk_ffi.FfiMintResult
+type MintResult struct {
+	Total Amount `json:"total"`
+	// Amounts holds the denomination of every minted proof
+	Amounts []Amount `json:"amounts"`
+}
+
+func MintResultFromFFI(f cdk_ffi.FfiMintResult) MintResult {
+	amounts := make([]Amount, 0, len(f.Amounts))
+	for _, amount := range f.Amounts {
+		amounts = append(amounts, Amount{Value: amount.Value})
+	}
+	return MintResult{
+		Total:   Amount{Value: f.Total.Value},
+		Amounts: amounts,
+	}
+}
+
+// MintDetailed mints tokens from a quote like Mint, also reporting the denomination of every
+// proof issued, e.g. for a receipt
+func (w *Wallet) MintDetailed(quoteId string, splitTarget SplitTarget) (MintResult, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return MintResult{}, ErrWalletClosed
+	}
+	f, err := w.wallet.MintDetailed(quoteId, cdk_ffi.FfiSplitTarget(splitTarget))
+	if err != nil {
+		return MintResult{}, err
+	}
+	return MintResultFromFFI(f), nil
+}
+
+// MintWithAmounts mints a paid quote into proofs of exactly the given denominations
+// The amounts must add up to the quote amount, otherwise an InvalidInput error is returned
+func (w *Wallet) MintWithAmounts(quoteId string, amounts []Amount) (Amount, error) {
+	w.proofsMu.Lock()
+	defer w.proofsMu.Unlock()
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return Amount{}, ErrWalletClosed
+	}
+	ffiAmounts := make([]cdk_ffi.FfiAmount, 0, len(amounts))
+	for _, amount := range amounts {
+		ffiAmounts = append(ffiAmounts, cdk_ffi.FfiAmount(amount))
+	}
+	amount, err := w.wallet.MintWithAmounts(quoteId, ffiAmounts)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{Value: amount.Value}, nil
+}
+
+// Unit returns the wallet's currency unit, or an empty string after Close
+func (w *Wallet) Unit() string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ""
+	}
+	return w.wallet.Unit()
+}
+
+// FFI returns the underlying cdk_ffi wallet, for methods this package does not wrap yet
+// Calls made through it skip the Wallet's locking and must not follow Close
+func (w *Wallet) FFI() cdk_ffi.FfiWalletInterface {
+	return w.wallet
+}
+
+// callWithContext runs fn on its own goroutine and returns as soon as either fn
+// finishes or ctx is done. Cancellation does not abort the in-flight Rust request
+// yet: fn keeps running to completion in the background and its result is dropped.
+func callWithContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, fmt.Errorf("wallet call not started: %w", err)
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := fn()
+		done <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return zero, fmt.Errorf("wallet call abandoned: %w", ctx.Err())
+	}
+}
+
+// MintQuoteContext is MintQuote with cancellation, see callWithContext for the caveats
+func (w *Wallet) MintQuoteContext(ctx context.Context, amount Amount, description *string) (MintQuote, error) {
+	return callWithContext(ctx, func() (MintQuote, error) {
+		return w.MintQuote(amount, description)
+	})
+}
+
+// MintContext is Mint with cancellation, see callWithContext for the caveats
+func (w *Wallet) MintContext(ctx context.Context, quoteId string, splitTarget SplitTarget) (Amount, error) {
+	return callWithContext(ctx, func() (Amount, error) {
+		return w.Mint(quoteId, splitTarget)
+	})
+}
+
+// MeltQuoteContext is MeltQuote with cancellation, see callWithContext for the caveats
+func (w *Wallet) MeltQuoteContext(ctx context.Context, request string) (MeltQuote, error) {
+	return callWithContext(ctx, func() (MeltQuote, error) {
+		return w.MeltQuote(request)
+	})
+}
+
+// MeltContext is Melt with cancellation, see callWithContext for the caveats.
+// A melt that is abandoned may still pay the invoice, check the quote state afterwards.
+func (w *Wallet) MeltContext(ctx context.Context, quoteId string) (Melted, error) {
+	return callWithContext(ctx, func() (Melted, error) {
+		return w.Melt(quoteId)
+	})
+}
+
+// GetMintInfoContext is GetMintInfo with cancellation, see callWithContext for the caveats
+func (w *Wallet) GetMintInfoContext(ctx context.Context) (string, error) {
+	return callWithContext(ctx, func() (string, error) {
+		return w.GetMintInfo()
+	})
+}
+
+// SendContext is Send with cancellation, see callWithContext for the caveats
+func (w *Wallet) SendContext(ctx context.Context, amount Amount, options SendOptions) (Token, error) {
+	return callWithContext(ctx, func() (Token, error) {
+		return w.Send(amount, options)
+	})
 }
@@ -0,0 +1,1177 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go_dir/cdk_ffi"
+)
+
+func TestCallWithContextReturnsResult(t *testing.T) {
+	got, err := callWithContext(context.Background(), func() (int, error) {
+		return 42, nil
+	})
+	if err != nil || got != 42 {
+		t.Fatalf("unexpected result: %v, %v", got, err)
+	}
+}
+
+func TestCallWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	_, err := callWithContext(ctx, func() (int, error) {
+		<-release
+		return 0, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestCallWithContextAlreadyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	_, err := callWithContext(ctx, func() (int, error) {
+		called = true
+		return 0, nil
+	})
+	if !errors.Is(err, context.Canceled) || called {
+		t.Fatalf("expected canceled without calling, got %v (called=%v)", err, called)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	a, b := Amount{Value: 10}, Amount{Value: 3}
+	if got := a.Add(b); got.Value != 13 {
+		t.Fatalf("Add: got %d", got.Value)
+	}
+	if got, err := a.Sub(b); err != nil || got.Value != 7 {
+		t.Fatalf("Sub: got %d, %v", got.Value, err)
+	}
+	if got := b.Mul(4); got.Value != 12 {
+		t.Fatalf("Mul: got %d", got.Value)
+	}
+	if !b.Less(a) || a.Less(b) || a.Less(a) {
+		t.Fatalf("Less: unexpected ordering")
+	}
+	if !a.Equal(Amount{Value: 10}) || a.Equal(b) {
+		t.Fatalf("Equal: unexpected result")
+	}
+}
+
+func TestAmountSubUnderflow(t *testing.T) {
+	_, err := Amount{Value: 3}.Sub(Amount{Value: 10})
+	if !errors.Is(err, ErrAmountUnderflow) {
+		t.Fatalf("expected ErrAmountUnderflow, got %v", err)
+	}
+}
+
+func TestAmountCheckedOverflow(t *testing.T) {
+	if got, err := (Amount{Value: 10}).CheckedAdd(Amount{Value: 3}); err != nil || got.Value != 13 {
+		t.Fatalf("CheckedAdd: got %d, %v", got.Value, err)
+	}
+	if got, err := (Amount{Value: 3}).CheckedMul(4); err != nil || got.Value != 12 {
+		t.Fatalf("CheckedMul: got %d, %v", got.Value, err)
+	}
+	if _, err := (Amount{Value: math.MaxUint64}).CheckedAdd(Amount{Value: 1}); !errors.Is(err, ErrAmountOverflow) {
+		t.Fatalf("CheckedAdd: expected ErrAmountOverflow, got %v", err)
+	}
+	if _, err := (Amount{Value: math.MaxUint64/2 + 1}).CheckedMul(2); !errors.Is(err, ErrAmountOverflow) {
+		t.Fatalf("CheckedMul: expected ErrAmountOverflow, got %v", err)
+	}
+}
+
+func TestAmountUnitConversion(t *testing.T) {
+	if got := (Amount{Value: 21}).ToMsat(); got.Value != 21_000 {
+		t.Fatalf("ToMsat: got %d", got.Value)
+	}
+	if got, err := (Amount{Value: 21_000}).ToSat(); err != nil || got.Value != 21 {
+		t.Fatalf("ToSat: got %d, %v", got.Value, err)
+	}
+	if _, err := (Amount{Value: 1_500}).ToSat(); !errors.Is(err, ErrNotWholeSat) {
+		t.Fatalf("ToSat(1500 msat): expected ErrNotWholeSat, got %v", err)
+	}
+}
+
+func TestConvertAmount(t *testing.T) {
+	cases := []struct {
+		value    uint64
+		from, to Unit
+		want     uint64
+		err      error
+	}{
+		{5, Sat, Msat, 5_000, nil},
+		{5_000, Msat, Sat, 5, nil},
+		{1_500, Msat, Sat, 0, ErrNotWholeSat},
+		{7, Usd, Usd, 7, nil},
+		{7, Sat, Usd, 0, ErrUnsupportedConversion},
+		{7, Eur, Usd, 0, ErrUnsupportedConversion},
+	}
+	for _, c := range cases {
+		got, err := ConvertAmount(Amount{Value: c.value}, c.from, c.to)
+		if !errors.Is(err, c.err) || got.Value != c.want {
+			t.Fatalf("ConvertAmount(%d, %s, %s): got %d, %v; want %d, %v", c.value, c.from, c.to, got.Value, err, c.want, c.err)
+		}
+	}
+}
+
+func TestAmountSplit(t *testing.T) {
+	cases := []struct {
+		amount uint64
+		want   []uint64
+	}{
+		{0, nil},
+		{1, []uint64{1}},
+		{13, []uint64{1, 4, 8}},
+		{64, []uint64{64}},
+		{1 << 63, []uint64{1 << 63}},
+	}
+	for _, c := range cases {
+		got := Amount{Value: c.amount}.Split()
+		if len(got) != len(c.want) {
+			t.Fatalf("Split(%d): got %v, want %v", c.amount, got, c.want)
+		}
+		for i := range got {
+			if got[i].Value != c.want[i] {
+				t.Fatalf("Split(%d): got %v, want %v", c.amount, got, c.want)
+			}
+		}
+	}
+}
+
+func TestWalletCloseTwice(t *testing.T) {
+	w := &Wallet{}
+	if err := w.Close(); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("second Close should be a no-op, got %v", err)
+	}
+	if _, err := w.Balance(); !errors.Is(err, ErrWalletClosed) {
+		t.Fatalf("Balance after Close: expected ErrWalletClosed, got %v", err)
+	}
+	if url := w.MintUrl(); url != "" {
+		t.Fatalf("MintUrl after Close: expected empty string, got %q", url)
+	}
+}
+
+func TestMultiMintWalletCloseTwice(t *testing.T) {
+	m := &MultiMintWallet{}
+	if err := m.Close(); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("second Close should be a no-op, got %v", err)
+	}
+	if err := m.AddMint("https://mint.example"); !errors.Is(err, ErrWalletClosed) {
+		t.Fatalf("AddMint after Close: expected ErrWalletClosed, got %v", err)
+	}
+	if _, err := m.TotalBalance(); !errors.Is(err, ErrWalletClosed) {
+		t.Fatalf("TotalBalance after Close: expected ErrWalletClosed, got %v", err)
+	}
+	if wallets := m.Wallets(); wallets != nil {
+		t.Fatalf("Wallets after Close: expected nil, got %v", wallets)
+	}
+}
+
+func TestClosedStorageRejected(t *testing.T) {
+	var storage Storage
+	if err := storage.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := NewWalletFromMnemonic("https://mint.example", Sat, storage, ""); !errors.Is(err, ErrStorageClosed) {
+		t.Fatalf("expected ErrStorageClosed, got %v", err)
+	}
+	if _, err := NewMultiMintWallet(Sat, storage, ""); !errors.Is(err, ErrStorageClosed) {
+		t.Fatalf("expected ErrStorageClosed from NewMultiMintWallet, got %v", err)
+	}
+	if _, err := storage.Vacuum(); !errors.Is(err, ErrStorageClosed) {
+		t.Fatalf("expected ErrStorageClosed from Vacuum, got %v", err)
+	}
+}
+
+func TestStorageCopiesShareClose(t *testing.T) {
+	storage, err := NewInMemoryStorage()
+	if err != nil {
+		t.Fatalf("NewInMemoryStorage: %v", err)
+	}
+	copied := storage
+	if err := storage.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := NewWalletFromMnemonic("https://mint.example", Sat, copied, ""); !errors.Is(err, ErrStorageClosed) {
+		t.Fatalf("copy after Close: expected ErrStorageClosed, got %v", err)
+	}
+	if err := copied.Close(); err != nil {
+		t.Fatalf("Close on a copy: %v", err)
+	}
+}
+
+func TestInMemoryStorageWallet(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+	t.Chdir(dir)
+
+	storage := newTestStorage(t)
+
+	wallet, err := NewWalletFromMnemonic("https://mint.example", Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer wallet.Close()
+
+	balance, err := wallet.Balance()
+	if err != nil || balance.Value != 0 {
+		t.Fatalf("unexpected balance: %v, %v", balance, err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("in-memory storage created files: %v", entries)
+	}
+}
+
+func TestListTransactionsPage(t *testing.T) {
+	// A file storage pages in SQL, an in-memory one sorts its whole history
+	storages := []struct {
+		name string
+		open func(t *testing.T) (Storage, error)
+	}{
+		{"in memory", func(*testing.T) (Storage, error) { return NewInMemoryStorage() }},
+		{"file", func(t *testing.T) (Storage, error) {
+			return NewStorageFromPath(filepath.Join(t.TempDir(), "wallet.db"))
+		}},
+	}
+	for _, c := range storages {
+		t.Run(c.name, func(t *testing.T) {
+			storage, err := c.open(t)
+			if err != nil {
+				t.Fatalf("open storage: %v", err)
+			}
+			defer storage.Close()
+			wallet, mintUrl := fundedWallet(t, storage, 1)
+			defer wallet.Close()
+			held, err := wallet.ListProofs(nil)
+			if err != nil || len(held) != 1 {
+				t.Fatalf("ListProofs: got %d proofs, %v", len(held), err)
+			}
+
+			// Each offline receive records one incoming transaction
+			for _, proof := range fakeProofs(t, held[0].KeysetId, 1, 1, 2, 2, 4, 4)[1:] {
+				if _, err := wallet.ReceiveOffline(fakeToken(t, mintUrl, "sat", proof)); err != nil {
+					t.Fatalf("ReceiveOffline: %v", err)
+				}
+			}
+			all, err := wallet.ListTransactions(nil)
+			if err != nil || len(all) < 5 {
+				t.Fatalf("ListTransactions: got %d transactions, %v", len(all), err)
+			}
+
+			const limit = 2
+			var paged []Transaction
+			for offset := uint32(0); ; offset += limit {
+				page, err := wallet.ListTransactionsPage(offset, limit)
+				if err != nil {
+					t.Fatalf("ListTransactionsPage(%d): %v", offset, err)
+				}
+				if len(page) > limit {
+					t.Fatalf("ListTransactionsPage(%d): got %d transactions, limit %d", offset, len(page), limit)
+				}
+				if len(page) == 0 {
+					break
+				}
+				paged = append(paged, page...)
+			}
+			if !reflect.DeepEqual(paged, all) {
+				t.Fatalf("pages joined give %v, want the full history %v", paged, all)
+			}
+		})
+	}
+}
+
+func TestWalletProxyScheme(t *testing.T) {
+	storage := newTestStorage(t)
+
+	for _, proxy := range []string{"ftp://127.0.0.1:9050", "https://127.0.0.1:9050", "127.0.0.1:9050"} {
+		_, err := NewWalletFromMnemonicWithProxy("https://mint.example", Sat, storage, testMnemonic, proxy)
+		var invalid *cdk_ffi.FfiErrorInvalidInput
+		if !errors.As(err, &invalid) {
+			t.Fatalf("%s: got %v, want InvalidInput", proxy, err)
+		}
+	}
+}
+
+func TestWalletMetadata(t *testing.T) {
+	storage := newTestStorage(t)
+
+	wallet, err := NewWalletFromMnemonic("https://mint.example", Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer wallet.Close()
+
+	if value, err := wallet.GetMetadata("label"); err != nil || value != nil {
+		t.Fatalf("unset key: got %v, %v", value, err)
+	}
+	if err := wallet.SetMetadata("label", "savings"); err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+	if err := wallet.SetMetadata("label", "spending"); err != nil {
+		t.Fatalf("SetMetadata overwrite: %v", err)
+	}
+	if value, err := wallet.GetMetadata("label"); err != nil || value == nil || *value != "spending" {
+		t.Fatalf("GetMetadata: got %v, %v", value, err)
+	}
+	if all, err := wallet.AllMetadata(); err != nil || !reflect.DeepEqual(all, map[string]string{"label": "spending"}) {
+		t.Fatalf("AllMetadata: got %v, %v", all, err)
+	}
+}
+
+func TestMeltQuoteFromFFIPaymentHash(t *testing.T) {
+	hash := "0001020304050607080900010203040506070809000102030405060708090102"
+	got := MeltQuoteFromFFI(cdk_ffi.FfiMeltQuote{Id: "q1", Request: "lnbc1", PaymentHash: hash})
+	if got.Id != "q1" || got.PaymentHash != hash {
+		t.Fatalf("unexpected melt quote conversion: %#v", got)
+	}
+}
+
+func TestQuoteExpiry(t *testing.T) {
+	const expiry = 1_700_000_600
+	if got := timeUntil(expiry, expiry-90); got != 90*time.Second {
+		t.Fatalf("timeUntil before expiry: got %v", got)
+	}
+	if got := timeUntil(expiry, expiry); got != 0 {
+		t.Fatalf("timeUntil at expiry: got %v", got)
+	}
+	if got := timeUntil(expiry, expiry+1); got != 0 {
+		t.Fatalf("timeUntil after expiry: got %v", got)
+	}
+}
+
+func TestQuoteExpiryAgainstMintTime(t *testing.T) {
+	now := CurrentMintTime()
+	if got := (MintQuote{Expiry: now + 3600}).TimeUntilExpiry(); got <= 3590*time.Second || got > time.Hour {
+		t.Fatalf("mint quote an hour out: got %v", got)
+	}
+	if !(MeltQuote{Expiry: 1}).IsExpired() {
+		t.Fatal("melt quote expired in 1970 reported as live")
+	}
+	if got := (MintQuote{Expiry: 1}).TimeUntilExpiry(); got != 0 {
+		t.Fatalf("mint quote expired in 1970: got %v", got)
+	}
+}
+
+type timeoutWallet struct {
+	cdk_ffi.FfiWalletInterface
+	secs []uint64
+}
+
+func (w *timeoutWallet) SetRequestTimeout(timeoutSecs uint64) {
+	w.secs = append(w.secs, timeoutSecs)
+}
+
+func TestSetRequestTimeout(t *testing.T) {
+	fake := &timeoutWallet{}
+	wallet := NewWalletFromFFI(fake)
+	wallet.SetRequestTimeout(30 * time.Second)
+	wallet.SetRequestTimeout(1500 * time.Millisecond)
+	wallet.SetRequestTimeout(0)
+	if want := []uint64{30, 2, 0}; !reflect.DeepEqual(fake.secs, want) {
+		t.Fatalf("timeouts passed to the native wallet: got %v, want %v", fake.secs, want)
+	}
+}
+
+func TestBalanceSnapshotFromFFI(t *testing.T) {
+	got := BalanceSnapshotFromFFI(cdk_ffi.FfiBalanceSnapshot{
+		Total:     cdk_ffi.FfiAmount{Value: 160},
+		Spendable: cdk_ffi.FfiAmount{Value: 100},
+		Pending:   cdk_ffi.FfiAmount{Value: 50},
+		Reserved:  cdk_ffi.FfiAmount{Value: 10},
+	})
+	want := BalanceSnapshot{Total: Amount{160}, Spendable: Amount{100}, Pending: Amount{50}, Reserved: Amount{10}}
+	if got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+type batchWallet struct {
+	cdk_ffi.FfiWalletInterface
+}
+
+func (batchWallet) ReceiveBatch(tokens []string, options cdk_ffi.FfiReceiveOptions) (cdk_ffi.FfiReceiveBatchResult, error) {
+	return cdk_ffi.FfiReceiveBatchResult{
+		Amount: cdk_ffi.FfiAmount{Value: uint64(len(tokens)-1) * 8},
+		Failed: []cdk_ffi.FfiReceiveError{{Index: 1, Error: "Token already spent"}},
+	}, nil
+}
+
+func TestReceiveBatch(t *testing.T) {
+	wallet := NewWalletFromFFI(batchWallet{})
+	got, err := wallet.ReceiveBatch([]string{"cashuBa", "cashuBb", "cashuBc"}, ReceiveOptions{})
+	if err != nil {
+		t.Fatalf("ReceiveBatch: %v", err)
+	}
+	want := ReceiveBatchResult{
+		Amount: Amount{16},
+		Failed: []ReceiveError{{Index: 1, Error: "Token already spent"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+// pagedWallet serves a transaction history newest first, one page per call
+type pagedWallet struct {
+	cdk_ffi.FfiWalletInterface
+	history []cdk_ffi.FfiTransaction
+	calls   int
+	// Recorded as the newest transaction after the first page, as a concurrent receive would
+	arriving *cdk_ffi.FfiTransaction
+}
+
+func (p *pagedWallet) ListTransactionsPage(offset uint32, limit uint32) ([]cdk_ffi.FfiTransaction, error) {
+	p.calls++
+	if p.calls == 2 && p.arriving != nil {
+		p.history = append([]cdk_ffi.FfiTransaction{*p.arriving}, p.history...)
+	}
+	start := min(int(offset), len(p.history))
+	end := min(start+int(limit), len(p.history))
+	return p.history[start:end], nil
+}
+
+func TestTransactionIterator(t *testing.T) {
+	history := make([]cdk_ffi.FfiTransaction, 7)
+	for i := range history {
+		history[i] = cdk_ffi.FfiTransaction{Id: fmt.Sprintf("tx%d", i), Timestamp: uint64(100 - i)}
+	}
+
+	for _, arriving := range []*cdk_ffi.FfiTransaction{nil, {Id: "new", Timestamp: 200}} {
+		ffi := &pagedWallet{history: history, arriving: arriving}
+		iterator, err := NewWalletFromFFI(ffi).TransactionIterator(3)
+		if err != nil {
+			t.Fatalf("TransactionIterator: %v", err)
+		}
+
+		var ids []string
+		for {
+			transaction, ok, err := iterator.Next()
+			if err != nil {
+				t.Fatalf("Next: %v", err)
+			}
+			if !ok {
+				break
+			}
+			ids = append(ids, transaction.Id)
+		}
+		want := []string{"tx0", "tx1", "tx2", "tx3", "tx4", "tx5", "tx6"}
+		if !reflect.DeepEqual(ids, want) {
+			t.Fatalf("got %v, want %v", ids, want)
+		}
+		if ffi.calls != 3 {
+			t.Fatalf("fetched %d pages, want 3", ffi.calls)
+		}
+	}
+
+	if _, err := NewWalletFromFFI(&pagedWallet{}).TransactionIterator(0); err == nil {
+		t.Fatal("expected an error for a zero page size")
+	}
+}
+
+func TestMeltedChange(t *testing.T) {
+	got := MeltedFromFFI(cdk_ffi.FfiMelted{
+		State:   "PAID",
+		Amount:  cdk_ffi.FfiAmount{Value: 1000},
+		FeePaid: cdk_ffi.FfiAmount{Value: 3},
+		Change:  cdk_ffi.FfiAmount{Value: 17},
+	})
+	if got.Change.Value != 17 || got.FeePaid.Value != 3 {
+		t.Fatalf("unexpected melted conversion: %#v", got)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var back Melted
+	if err := json.Unmarshal(data, &back); err != nil || back != got {
+		t.Fatalf("json roundtrip mismatch: %s, %v", data, err)
+	}
+}
+
+func TestPreparedSendRoundTrip(t *testing.T) {
+	f := cdk_ffi.FfiPreparedSend{
+		Amount:        cdk_ffi.FfiAmount{Value: 100},
+		SwapFee:       cdk_ffi.FfiAmount{Value: 1},
+		TotalFee:      cdk_ffi.FfiAmount{Value: 1},
+		ProofCount:    3,
+		RequiresSwap:  true,
+		InputFee:      cdk_ffi.FfiAmount{Value: 1},
+		ReservationId: "7b0c5c8e-6a4f-4f1e-9d59-2f1f5c0c1a2b",
+	}
+	if back := PreparedSendFromFFI(f).ToFFI(); back != f {
+		t.Fatalf("roundtrip mismatch:\n got %#v\nwant %#v", back, f)
+	}
+}
+
+// fakeMintKeys are the keys of the one sat keyset fakeMint serves, in amount order. They are
+// multiples of the secp256k1 generator: valid points, but the mint could sign nothing with them
+var fakeMintKeys = []struct {
+	amount uint64
+	key    string
+}{
+	{1, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+	{2, "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"},
+	{4, "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9"},
+}
+
+// fakeMint serves the keysets and keys of a mint charging inputFeePpk per proof, enough to
+// store proofs and send them as they are. It refuses every swap with the NUT-00 error for spent proofs, quotes
+// every melt with a fee reserve of 20, and anything else that needs a signature gets a 404
+func fakeMint(t *testing.T, inputFeePpk uint64) (*httptest.Server, string) {
+	t.Helper()
+	var concatenated []byte
+	keys := map[string]string{}
+	for _, k := range fakeMintKeys {
+		b, err := hex.DecodeString(k.key)
+		if err != nil {
+			t.Fatalf("bad key: %v", err)
+		}
+		concatenated = append(concatenated, b...)
+		keys[fmt.Sprint(k.amount)] = k.key
+	}
+	hash := sha256.Sum256(concatenated)
+	keysetID := "00" + hex.EncodeToString(hash[:7])
+
+	reply := func(body any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}
+	keysets := map[string]any{"keysets": []map[string]any{{"id": keysetID, "unit": "sat", "keys": keys}}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/info", reply(map[string]any{"name": "Fake mint", "nuts": map[string]any{}}))
+	mux.HandleFunc("/v1/keysets", reply(map[string]any{"keysets": []map[string]any{
+		{"id": keysetID, "unit": "sat", "active": true, "input_fee_ppk": inputFeePpk},
+	}}))
+	mux.HandleFunc("/v1/keys", reply(keysets))
+	mux.HandleFunc("/v1/keys/"+keysetID, reply(keysets))
+	mux.HandleFunc("/v1/melt/quote/bolt11", reply(map[string]any{
+		"quote": "fake-melt-quote", "amount": 10, "fee_reserve": 20, "paid": false,
+		"state": "UNPAID", "expiry": time.Now().Add(time.Hour).Unix(),
+	}))
+	mux.HandleFunc("/v1/swap", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": 11001, "detail": "Token already spent"})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, keysetID
+}
+
+// fakeProofs makes one proof per amount of the fakeMint keyset, without DLEQ proofs
+func fakeProofs(t *testing.T, keysetID string, amounts ...uint64) []Proof {
+	proofs := make([]Proof, 0, len(amounts))
+	for i, amount := range amounts {
+		secret := sha256.Sum256([]byte(fmt.Sprintf("%s secret %d", t.Name(), i)))
+		proofs = append(proofs, Proof{
+			Amount:   Amount{Value: amount},
+			KeysetId: keysetID,
+			Secret:   hex.EncodeToString(secret[:]),
+			C:        fakeMintKeys[0].key,
+		})
+	}
+	return proofs
+}
+
+// fakeToken encodes proofs as a V3 token of mintUrl in unit
+func fakeToken(t *testing.T, mintUrl string, unit string, proofs ...Proof) string {
+	encoded := make([]map[string]any, 0, len(proofs))
+	for _, proof := range proofs {
+		encoded = append(encoded, map[string]any{"amount": proof.Amount.Value, "id": proof.KeysetId, "secret": proof.Secret, "C": proof.C})
+	}
+	v3, err := json.Marshal(map[string]any{
+		"token": []map[string]any{{"mint": mintUrl, "proofs": encoded}},
+		"unit":  unit,
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return "cashuA" + base64.URLEncoding.EncodeToString(v3)
+}
+
+// fundedWallet opens a wallet on a fakeMint holding one unspent proof per amount
+// testMnemonic is the BIP39 test vector mnemonic every test wallet is created from
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// newTestStorage opens an in-memory store that is closed when the test ends
+func newTestStorage(t *testing.T) Storage {
+	t.Helper()
+	storage, err := NewInMemoryStorage()
+	if err != nil {
+		t.Fatalf("NewInMemoryStorage: %v", err)
+	}
+	t.Cleanup(func() { storage.Close() })
+	return storage
+}
+
+func fundedWallet(t *testing.T, storage Storage, amounts ...uint64) (*Wallet, string) {
+	t.Helper()
+	server, keysetID := fakeMint(t, 0)
+	proofs := fakeProofs(t, keysetID, amounts...)
+	wallet, err := NewWalletFromMnemonicWithProofs(server.URL, Sat, storage, testMnemonic, proofs)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonicWithProofs: %v", err)
+	}
+	t.Cleanup(func() { wallet.Close() })
+	return wallet, server.URL
+}
+
+func TestPreparedSendInputFee(t *testing.T) {
+	storage := newTestStorage(t)
+	server, keysetID := fakeMint(t, 100)
+	wallet, err := NewWalletFromMnemonicWithProofs(server.URL, Sat, storage, testMnemonic, fakeProofs(t, keysetID, 1, 1, 1))
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonicWithProofs: %v", err)
+	}
+	defer wallet.Close()
+
+	// Three proofs at 100 ppk each cost 300/1000 of a sat, rounded up
+	for _, dryRun := range []bool{true, false} {
+		options := SendOptions{ProofSelection: ProofSelectionSmallestFirst, DryRun: dryRun}
+		prepared, err := wallet.PrepareSend(Amount{Value: 3}, options)
+		if err != nil {
+			t.Fatalf("PrepareSend (dry run %v): %v", dryRun, err)
+		}
+		if prepared.ProofCount != 3 || prepared.InputFee.Value != 1 {
+			t.Fatalf("dry run %v: got %d proofs and input fee %d, want 3 and 1", dryRun, prepared.ProofCount, prepared.InputFee.Value)
+		}
+		if !dryRun {
+			if err := wallet.CancelPreparedSend(prepared); err != nil {
+				t.Fatalf("CancelPreparedSend: %v", err)
+			}
+		}
+	}
+}
+
+func TestPreparedSendReservations(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, mintUrl := fundedWallet(t, storage, 1, 2, 4)
+	options := SendOptions{ProofSelection: ProofSelectionLargestFirst}
+
+	if _, err := wallet.ConfirmSend(PreparedSend{ReservationId: "unknown"}); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) {
+		t.Fatalf("unknown reservation: %v", err)
+	}
+
+	prepared, err := wallet.PrepareSend(Amount{Value: 4}, options)
+	if err != nil {
+		t.Fatalf("PrepareSend: %v", err)
+	}
+	if reserved, err := wallet.ReservedBalance(); err != nil || reserved.Value != 4 {
+		t.Fatalf("reserved balance %v, %v, want 4", reserved, err)
+	}
+	if _, err := wallet.ConfirmSend(prepared); err != nil {
+		t.Fatalf("ConfirmSend: %v", err)
+	}
+	if _, err := wallet.ConfirmSend(prepared); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) {
+		t.Fatalf("second confirm: %v", err)
+	}
+
+	wallet.SetPreparedSendTtl(0)
+	prepared, err = wallet.PrepareSend(Amount{Value: 2}, options)
+	if err != nil {
+		t.Fatalf("PrepareSend: %v", err)
+	}
+	if _, err := wallet.ConfirmSend(prepared); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) {
+		t.Fatalf("expired reservation: %v", err)
+	}
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 3 {
+		t.Fatalf("balance after expiry %v, %v, want 3", balance, err)
+	}
+
+	// A second wallet on the same storage leaves a live reservation alone
+	wallet.SetPreparedSendTtl(time.Minute)
+	prepared, err = wallet.PrepareSend(Amount{Value: 2}, options)
+	if err != nil {
+		t.Fatalf("PrepareSend: %v", err)
+	}
+	second, err := NewWalletFromMnemonic(mintUrl, Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer second.Close()
+	if reserved, err := second.ReservedBalance(); err != nil || reserved.Value != 2 {
+		t.Fatalf("reserved balance seen by a second wallet %v, %v, want 2", reserved, err)
+	}
+	if _, err := wallet.ConfirmSend(prepared); err != nil {
+		t.Fatalf("ConfirmSend after a second wallet opened: %v", err)
+	}
+
+	// An expired reservation left behind by a closed wallet is released when one is opened
+	wallet.SetPreparedSendTtl(0)
+	if _, err := wallet.PrepareSend(Amount{Value: 1}, options); err != nil {
+		t.Fatalf("PrepareSend: %v", err)
+	}
+	wallet.Close()
+	reopened, err := NewWalletFromMnemonic(mintUrl, Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer reopened.Close()
+	if balance, err := reopened.Balance(); err != nil || balance.Value != 1 {
+		t.Fatalf("balance after reopening %v, %v, want 1", balance, err)
+	}
+}
+
+func TestLockProofs(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, mintUrl := fundedWallet(t, storage, 1, 4)
+
+	unspent := ProofStateFilterUnspent
+	proofs, err := wallet.ListProofs(&unspent)
+	if err != nil {
+		t.Fatalf("ListProofs: %v", err)
+	}
+	var four string
+	for _, proof := range proofs {
+		if proof.Amount.Value == 4 {
+			four = proof.Secret
+		}
+	}
+
+	if err := wallet.LockProofs([]string{"unknown"}); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) {
+		t.Fatalf("lock of an unknown secret: %v", err)
+	}
+	if err := wallet.LockProofs([]string{four}); err != nil {
+		t.Fatalf("LockProofs: %v", err)
+	}
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 1 {
+		t.Fatalf("balance with the 4 locked %v, %v, want 1", balance, err)
+	}
+	options := SendOptions{ProofSelection: ProofSelectionLargestFirst}
+	if _, err := wallet.PrepareSend(Amount{Value: 4}, options); !errors.Is(err, cdk_ffi.ErrFfiErrorInsufficientFunds) {
+		t.Fatalf("send of a locked proof: %v", err)
+	}
+
+	// The lock outlives the wallet, unlike a prepared send
+	wallet.Close()
+	wallet, err = NewWalletFromMnemonic(mintUrl, Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer wallet.Close()
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 1 {
+		t.Fatalf("balance after reopening %v, %v, want 1", balance, err)
+	}
+
+	if err := wallet.UnlockProofs([]string{four}); err != nil {
+		t.Fatalf("UnlockProofs: %v", err)
+	}
+	if err := wallet.UnlockProofs([]string{four}); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) {
+		t.Fatalf("unlock of a proof not locked: %v", err)
+	}
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 5 {
+		t.Fatalf("balance after unlocking %v, %v, want 5", balance, err)
+	}
+}
+
+func TestTokenSerializeRoundTrip(t *testing.T) {
+	v3, err := json.Marshal(map[string]any{
+		"token": []map[string]any{{
+			"mint": "https://mint.example",
+			"proofs": []map[string]any{{
+				"amount": 8,
+				"id":     "009a1f293253e41e",
+				"secret": "407915bc212be61a77e3e6d2aeb4c727980bda51cd06a6afc29e2861768a7837",
+				"C":      "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			}},
+		}},
+		"unit": "sat",
+		"memo": "thanks",
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	token, err := ParseToken("cashuA" + base64.URLEncoding.EncodeToString(v3))
+	if err != nil {
+		t.Fatalf("ParseToken: %v", err)
+	}
+
+	data, err := token.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	back, err := DeserializeToken(data)
+	if err != nil {
+		t.Fatalf("DeserializeToken: %v", err)
+	}
+	if !reflect.DeepEqual(back, token) {
+		t.Fatalf("roundtrip mismatch:\n got %#v\nwant %#v", back, token)
+	}
+}
+
+func TestNormalizeMintUrl(t *testing.T) {
+	cases := []struct {
+		name    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{"trailing slash", "http://localhost:8081/", "http://localhost:8081", false},
+		{"uppercase host", "https://MINT.Example.com", "https://mint.example.com", false},
+		{"path kept", "https://mint.example.com/cashu/", "https://mint.example.com/cashu", false},
+		{"missing scheme", "mint.example.com", "", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := NormalizeMintUrl(c.url)
+			if c.wantErr {
+				var invalid *cdk_ffi.FfiErrorInvalidInput
+				if !errors.As(err, &invalid) {
+					t.Fatalf("expected InvalidInput, got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil || got != c.want {
+				t.Fatalf("NormalizeMintUrl(%q) = %q, %v, want %q", c.url, got, err, c.want)
+			}
+		})
+	}
+}
+
+func TestMeltWithMaxFee(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, _ := fundedWallet(t, storage, 1)
+	// 10 sat, the fake mint quotes it with a fee reserve of 20
+	invoice := "lnbc100n1p5tmvnlpp5luw5fra3zgpnugrh0vuss9hzy9m6xr5uf3mnw6n2xlcv06srqmhqdqqcqzzsxqyz5vqrzjqvueefmrckfdwyyu39m0lf24sqzcr9vcrmxrvgfn6empxz7phrjxvrttncqq0lcqqyqqqqlgqqqqqqgq2qsp5rsr6jf4ukg8h7u96hfjxspukxswyam90q5pqc0pssnlw403hq8us9qxpqysgq4jnaqd35ly4jtw243533wcae6kk9dsue9sxz0uu042exg4u7m4hn2vkq94m4u8j9ph93fplv7v7q22h994qw6pruy3ywcg9jltcfzhgprwe8d2"
+	quote, err := wallet.MeltQuote(invoice)
+	if err != nil {
+		t.Fatalf("MeltQuote: %v", err)
+	}
+
+	_, err = wallet.MeltWithMaxFee(quote.Id, Amount{Value: 19})
+	var feeTooHigh *cdk_ffi.FfiErrorFeeTooHigh
+	if !errors.As(err, &feeTooHigh) || feeTooHigh.FeeReserve.Value != 20 || feeTooHigh.MaxFee.Value != 19 {
+		t.Fatalf("expected FeeTooHigh for 20 over 19, got %v", err)
+	}
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 1 {
+		t.Fatalf("balance after a refused melt %v, %v, want 1", balance, err)
+	}
+
+	// Within the cap the melt goes ahead and fails for lack of funds instead
+	_, err = wallet.MeltWithMaxFee(quote.Id, Amount{Value: 20})
+	if err == nil || errors.Is(err, cdk_ffi.ErrFfiErrorFeeTooHigh) {
+		t.Fatalf("melt within the cap: got %v, want a failure other than FeeTooHigh", err)
+	}
+}
+
+func TestFeeReservePercentPersists(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, mintUrl := fundedWallet(t, storage, 1)
+	// 10 sat
+	invoice := "lnbc100n1p5tmvnlpp5luw5fra3zgpnugrh0vuss9hzy9m6xr5uf3mnw6n2xlcv06srqmhqdqqcqzzsxqyz5vqrzjqvueefmrckfdwyyu39m0lf24sqzcr9vcrmxrvgfn6empxz7phrjxvrttncqq0lcqqyqqqqlgqqqqqqgq2qsp5rsr6jf4ukg8h7u96hfjxspukxswyam90q5pqc0pssnlw403hq8us9qxpqysgq4jnaqd35ly4jtw243533wcae6kk9dsue9sxz0uu042exg4u7m4hn2vkq94m4u8j9ph93fplv7v7q22h994qw6pruy3ywcg9jltcfzhgprwe8d2"
+
+	// Without a rate or earlier quotes the estimate is CDK's minimum of 2
+	if fee, err := wallet.EstimateMeltFee(invoice); err != nil || fee.Value != 2 {
+		t.Fatalf("default estimate %v, %v, want 2", fee, err)
+	}
+	if err := wallet.SetFeeReservePercent(0); err != nil {
+		t.Fatalf("SetFeeReservePercent: %v", err)
+	}
+	wallet.Close()
+
+	reopened, err := NewWalletFromMnemonic(mintUrl, Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer reopened.Close()
+	if percent := reopened.FeeReservePercent(); percent == nil || *percent != 0 {
+		t.Fatalf("FeeReservePercent after reopening: %v, want 0", percent)
+	}
+	// An explicit 0% is not raised to the minimum
+	if fee, err := reopened.EstimateMeltFee(invoice); err != nil || fee.Value != 0 {
+		t.Fatalf("estimate at 0%% %v, %v, want 0", fee, err)
+	}
+}
+
+func TestMeltBatchChecksBalanceFirst(t *testing.T) {
+	// 10 sat, the fake mint quotes it with a fee reserve of 20
+	invoice := "lnbc100n1p5tmvnlpp5luw5fra3zgpnugrh0vuss9hzy9m6xr5uf3mnw6n2xlcv06srqmhqdqqcqzzsxqyz5vqrzjqvueefmrckfdwyyu39m0lf24sqzcr9vcrmxrvgfn6empxz7phrjxvrttncqq0lcqqyqqqqlgqqqqqqgq2qsp5rsr6jf4ukg8h7u96hfjxspukxswyam90q5pqc0pssnlw403hq8us9qxpqysgq4jnaqd35ly4jtw243533wcae6kk9dsue9sxz0uu042exg4u7m4hn2vkq94m4u8j9ph93fplv7v7q22h994qw6pruy3ywcg9jltcfzhgprwe8d2"
+	cases := []struct {
+		name        string
+		inputFeePpk uint64
+		amounts     []uint64
+		required    uint64
+	}{
+		// The invoice amount alone is over the balance, so no quote is requested
+		{"amount", 0, []uint64{1, 2, 4}, 10},
+		// The amount fits but not the 1 sat fee on each of the three proofs it spends
+		{"input fee", 1000, []uint64{4, 4, 2}, 13},
+		// The amount fits but not the fee reserve, which is only known once quoted
+		{"fee reserve", 0, []uint64{4, 4, 4, 2, 1}, 30},
+	}
+	for _, c := range cases {
+		storage := newTestStorage(t)
+		server, keysetID := fakeMint(t, c.inputFeePpk)
+		wallet, err := NewWalletFromMnemonicWithProofs(server.URL, Sat, storage, testMnemonic, fakeProofs(t, keysetID, c.amounts...))
+		if err != nil {
+			t.Fatalf("NewWalletFromMnemonicWithProofs: %v", err)
+		}
+		defer wallet.Close()
+
+		_, err = wallet.MeltBatch([]string{invoice, "not an invoice"})
+		var insufficient *cdk_ffi.FfiErrorInsufficientFunds
+		if !errors.As(err, &insufficient) || insufficient.Required.Value != c.required {
+			t.Fatalf("%s: got %v, want InsufficientFunds for %d", c.name, err, c.required)
+		}
+		// A refused batch keeps none of the quotes it asked for
+		if quotes, err := wallet.PendingMeltQuotes(); err != nil || len(quotes) != 0 {
+			t.Fatalf("%s: got %d melt quotes, %v, want none", c.name, len(quotes), err)
+		}
+	}
+}
+
+// failingWallet fails every call the way the Rust wallet reports those failures
+type failingWallet struct {
+	cdk_ffi.FfiWalletInterface
+}
+
+func (failingWallet) Receive(string, cdk_ffi.FfiReceiveOptions) (cdk_ffi.FfiReceiveResult, error) {
+	return cdk_ffi.FfiReceiveResult{}, cdk_ffi.NewFfiErrorWalletError("Token already spent", 11001)
+}
+
+func (failingWallet) Mint(string, cdk_ffi.FfiSplitTarget) (cdk_ffi.FfiAmount, error) {
+	return cdk_ffi.FfiAmount{}, cdk_ffi.NewFfiErrorWalletError("Quote not paid", 20001)
+}
+
+func (failingWallet) Melt(string) (cdk_ffi.FfiMelted, error) {
+	return cdk_ffi.FfiMelted{}, cdk_ffi.NewFfiErrorInvalidInput("unknown melt quote", 0)
+}
+
+func TestErrorCode(t *testing.T) {
+	w := NewWalletFromFFI(failingWallet{})
+
+	_, err := w.Receive("cashuBspent", ReceiveOptions{})
+	if code := ErrorCode(fmt.Errorf("receive: %w", err)); code != ErrorCodeTokenAlreadySpent {
+		t.Fatalf("Receive: got code %d, want %d", code, ErrorCodeTokenAlreadySpent)
+	}
+	_, err = w.Mint("unpaid", SplitTargetDefault)
+	if code := ErrorCode(err); code != ErrorCodeQuoteNotPaid {
+		t.Fatalf("Mint: got code %d, want %d", code, ErrorCodeQuoteNotPaid)
+	}
+	_, err = w.Melt("unknown")
+	if code := ErrorCode(err); code != 0 {
+		t.Fatalf("Melt: got code %d for an error without one", code)
+	}
+	if code := ErrorCode(nil); code != 0 {
+		t.Fatalf("nil: got code %d", code)
+	}
+}
+
+func TestErrorCodeFromMint(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, _ := fundedWallet(t, storage, 1, 2)
+
+	_, err := wallet.Swap(nil, SplitTargetDefault)
+	if code := ErrorCode(err); code != ErrorCodeTokenAlreadySpent {
+		t.Fatalf("Swap: got code %d from %v, want %d", code, err, ErrorCodeTokenAlreadySpent)
+	}
+	err = wallet.LockProofs([]string{"unknown"})
+	if code := ErrorCode(err); !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) || code != 0 {
+		t.Fatalf("LockProofs: got code %d from %v, want 0", code, err)
+	}
+}
+
+func TestReceiveRequireDleq(t *testing.T) {
+	storage := newTestStorage(t)
+	server, keysetID := fakeMint(t, 0)
+	wallet, err := NewWalletFromMnemonic(server.URL, Sat, storage, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic: %v", err)
+	}
+	defer wallet.Close()
+
+	token := func(dleq map[string]string) string {
+		proof := fakeProofs(t, keysetID, 1)[0]
+		encoded := map[string]any{"amount": 1, "id": proof.KeysetId, "secret": proof.Secret, "C": proof.C}
+		if dleq != nil {
+			encoded["dleq"] = dleq
+		}
+		v3, err := json.Marshal(map[string]any{
+			"token": []map[string]any{{"mint": server.URL, "proofs": []map[string]any{encoded}}},
+			"unit":  "sat",
+		})
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return "cashuA" + base64.URLEncoding.EncodeToString(v3)
+	}
+	scalar := "0101010101010101010101010101010101010101010101010101010101010101"
+	forged := map[string]string{"e": scalar, "s": scalar, "r": scalar}
+
+	for _, tok := range []string{token(nil), token(forged)} {
+		_, err := wallet.Receive(tok, ReceiveOptions{RequireDleq: true})
+		if !errors.Is(err, cdk_ffi.ErrFfiErrorDleqVerificationFailed) {
+			t.Fatalf("got %v, want a DLEQ verification error", err)
+		}
+	}
+	// Without the flag the token reaches the mint, which refuses the swap as already spent
+	_, err = wallet.Receive(token(nil), ReceiveOptions{})
+	if errors.Is(err, cdk_ffi.ErrFfiErrorDleqVerificationFailed) || ErrorCode(err) != ErrorCodeTokenAlreadySpent {
+		t.Fatalf("Receive without RequireDleq: got %v, want the mint's spent error", err)
+	}
+}
+
+func TestReceiveOfflineRejectsUnswappable(t *testing.T) {
+	storage := newTestStorage(t)
+	wallet, mintUrl := fundedWallet(t, storage, 1)
+	defer wallet.Close()
+	held, err := wallet.ListProofs(nil)
+	if err != nil || len(held) != 1 {
+		t.Fatalf("ListProofs: got %d proofs, %v", len(held), err)
+	}
+
+	fresh := fakeProofs(t, held[0].KeysetId, 1, 2)[1]
+	token := func(unit string, secret string) string {
+		proof := fresh
+		proof.Secret = secret
+		return fakeToken(t, mintUrl, unit, proof)
+	}
+	locked := `["P2PK",{"nonce":"` + fresh.Secret + `","data":"` + fakeMintKeys[0].key + `","tags":[]}]`
+
+	cases := []struct {
+		name, unit, secret, want string
+	}{
+		{"other unit", "usd", fresh.Secret, "unit"},
+		{"p2pk locked", "sat", locked, "locked"},
+	}
+	for _, c := range cases {
+		_, err := wallet.ReceiveOffline(token(c.unit, c.secret))
+		if !errors.Is(err, cdk_ffi.ErrFfiErrorInvalidInput) || !strings.Contains(err.Error(), c.want) {
+			t.Fatalf("%s: got %v, want an InvalidInput error about the %s", c.name, err, c.want)
+		}
+	}
+	if balance, err := wallet.Balance(); err != nil || balance.Value != 1 {
+		t.Fatalf("rejected tokens changed the balance to %d, %v", balance.Value, err)
+	}
+
+	if amount, err := wallet.ReceiveOffline(token("sat", fresh.Secret)); err != nil || amount.Value != 2 {
+		t.Fatalf("ReceiveOffline: got %d, %v", amount.Value, err)
+	}
+}
+
+// sendingWallet stands in for the Rust wallet, spending from its balance with a
+// read-modify-write that loses updates unless Send calls are serialized
+type sendingWallet struct {
+	cdk_ffi.FfiWalletInterface
+	balance  atomic.Uint64
+	inFlight atomic.Int32
+	overlaps atomic.Int32
+}
+
+func (f *sendingWallet) Balance() (cdk_ffi.FfiAmount, error) {
+	return cdk_ffi.FfiAmount{Value: f.balance.Load()}, nil
+}
+
+func (f *sendingWallet) Send(amount cdk_ffi.FfiAmount, _ cdk_ffi.FfiSendOptions, _ *cdk_ffi.FfiSendMemo) (cdk_ffi.FfiToken, error) {
+	if f.inFlight.Add(1) > 1 {
+		f.overlaps.Add(1)
+	}
+	defer f.inFlight.Add(-1)
+
+	balance := f.balance.Load()
+	runtime.Gosched()
+	if balance < amount.Value {
+		return cdk_ffi.FfiToken{}, cdk_ffi.NewFfiErrorInsufficientFunds(cdk_ffi.FfiAmount{Value: balance}, amount, 0)
+	}
+	f.balance.Store(balance - amount.Value)
+	return cdk_ffi.FfiToken{TokenString: "cashuBtok", Unit: "sat", Amount: amount}, nil
+}
+
+func TestWalletConcurrentSendAndBalance(t *testing.T) {
+	const calls, start = 50, 1000
+	fake := &sendingWallet{}
+	fake.balance.Store(start)
+	w := &Wallet{wallet: fake}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := w.Send(Amount{Value: 1}, SendOptions{}); err != nil {
+				errs <- err
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			balance, err := w.Balance()
+			if err != nil {
+				errs <- err
+			} else if balance.Value > start || balance.Value < start-calls {
+				errs <- fmt.Errorf("balance out of range: %d", balance.Value)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	if n := fake.overlaps.Load(); n != 0 {
+		t.Fatalf("%d Send calls overlapped", n)
+	}
+	if balance, err := w.Balance(); err != nil || balance.Value != start-calls {
+		t.Fatalf("final balance: got %d, %v, want %d", balance.Value, err, start-calls)
+	}
+}
+
+// unitWallet stands in for a wallet created through the cdk_ffi bindings
+type unitWallet struct {
+	cdk_ffi.FfiWalletInterface
+	unit string
+}
+
+func (f unitWallet) Unit() string {
+	return f.unit
+}
+
+func TestNewWalletFromFFI(t *testing.T) {
+	ffi := unitWallet{unit: "sat"}
+	w := NewWalletFromFFI(ffi)
+	if unit := w.Unit(); unit != "sat" {
+		t.Fatalf("Unit: got %q", unit)
+	}
+	if w.FFI() != cdk_ffi.FfiWalletInterface(ffi) {
+		t.Fatalf("FFI should return the wrapped wallet")
+	}
+}
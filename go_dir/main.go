@@ -3,6 +3,7 @@ package main
 import (
 	"go_dir/cdk_ffi"
 	"log"
+	"time"
 )
 
 // example on how to run:
@@ -31,18 +32,12 @@ func main() {
 	}
 	log.Printf("\n  balance before minting %+v", balance)
 
-	log.Println("trying to get mint quote")
-	mintquote, err := wallet.MintQuote(Amount{Value: 100}, nil)
-	if err != nil {
-		log.Panicf("wallet.MintQuote(Amount{Value: 100}, nil). %+v", err)
-	}
-
 	log.Println("Minting...")
-	amount, err := wallet.Mint(mintquote.Id, SplitTargetDefault)
+	mintquote, amount, err := wallet.MintAndWait(Amount{Value: 100}, nil, time.Minute, SplitTargetDefault)
 	if err != nil {
-		log.Panicf("wallet.Mint(mintquote.Id, SplitTargetDefault). %+v", err)
+		log.Panicf("wallet.MintAndWait(Amount{Value: 100}, nil, time.Minute, SplitTargetDefault). %+v", err)
 	}
-	log.Printf("minted amount: %+v", amount)
+	log.Printf("minted amount: %+v from quote %s", amount, mintquote.Id)
 
 	balance, err = wallet.Balance()
 	if err != nil {
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+
 	"go_dir/cdk_ffi"
 )
 
@@ -19,13 +21,13 @@ func (s MintQuoteState) ToFFI() cdk_ffi.FfiMintQuoteState {
 
 // MintQuote is a Go-native representation of cdk_ffi.FfiMintQuote
 type MintQuote struct {
-	Id      string
-	MintUrl string
-	Amount  Amount
-	Unit    string
-	Request string
-	State   MintQuoteState
-	Expiry  uint64
+	Id      string         `json:"id"`
+	MintUrl string         `json:"mint_url"`
+	Amount  Amount         `json:"amount"`
+	Unit    string         `json:"unit"`
+	Request string         `json:"request"`
+	State   MintQuoteState `json:"state"`
+	Expiry  uint64         `json:"expiry"`
 }
 
 func MintQuoteFromFFI(f cdk_ffi.FfiMintQuote) MintQuote {
@@ -52,6 +54,24 @@ func (m MintQuote) ToFFI() cdk_ffi.FfiMintQuote {
 	}
 }
 
+// MintQuoteOptions is a Go-native representation of cdk_ffi.FfiMintQuoteOptions
+type MintQuoteOptions struct {
+	Description *string
+	// ExpirySecs is a unix time after which the wallet treats the quote as expired, used only
+	// when it is earlier than the mint's own expiry
+	ExpirySecs *uint64
+	// SingleUse must be nil or true, mint quotes can only be used once
+	SingleUse *bool
+}
+
+func (o MintQuoteOptions) ToFFI() cdk_ffi.FfiMintQuoteOptions {
+	return cdk_ffi.FfiMintQuoteOptions{
+		Description: o.Description,
+		ExpirySecs:  o.ExpirySecs,
+		SingleUse:   o.SingleUse,
+	}
+}
+
 // MintQuoteBolt11 is a Go-native representation of cdk_ffi.FfiMintQuoteBolt11Response
 type MintQuoteBolt11 struct {
 	Quote   string
@@ -114,6 +134,9 @@ type Token struct {
 	Mint        string
 	Memo        *string
 	Unit        string
+	Amount      Amount
+	// Conditions is the spending condition locking the proofs, nil for an unlocked token
+	Conditions *TokenConditions
 }
 
 func TokenFromFFI(f cdk_ffi.FfiToken) Token {
@@ -122,6 +145,8 @@ func TokenFromFFI(f cdk_ffi.FfiToken) Token {
 		Mint:        f.Mint,
 		Memo:        f.Memo,
 		Unit:        f.Unit,
+		Amount:      Amount{Value: f.Amount.Value},
+		Conditions:  TokenConditionsFromFFI(f.Conditions),
 	}
 }
 
@@ -131,12 +156,118 @@ func (t Token) ToFFI() cdk_ffi.FfiToken {
 		Mint:        t.Mint,
 		Memo:        t.Memo,
 		Unit:        t.Unit,
+		Amount:      cdk_ffi.FfiAmount{Value: t.Amount.Value},
+		Conditions:  t.Conditions.ToFFI(),
 	}
 }
 func (t Token) String() string {
 	return t.tokenString
 }
 
+// tokenJSON is the wire shape of Token, which keeps the encoded token unexported
+type tokenJSON struct {
+	Token      string           `json:"token"`
+	Mint       string           `json:"mint"`
+	Memo       *string          `json:"memo,omitempty"`
+	Unit       string           `json:"unit"`
+	Amount     Amount           `json:"amount"`
+	Conditions *TokenConditions `json:"conditions,omitempty"`
+}
+
+func (t Token) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tokenJSON{
+		Token:      t.tokenString,
+		Mint:       t.Mint,
+		Memo:       t.Memo,
+		Unit:       t.Unit,
+		Amount:     t.Amount,
+		Conditions: t.Conditions,
+	})
+}
+
+func (t *Token) UnmarshalJSON(data []byte) error {
+	var j tokenJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*t = Token{
+		tokenString: j.Token,
+		Mint:        j.Mint,
+		Memo:        j.Memo,
+		Unit:        j.Unit,
+		Amount:      j.Amount,
+		Conditions:  j.Conditions,
+	}
+	return nil
+}
+
+// SpendingCondition is a Go-native enum matching cdk_ffi.FfiSpendingCondition
+type SpendingCondition uint
+
+const (
+	// SpendingConditionP2PK locks the proofs to a public key (NUT-11)
+	SpendingConditionP2PK SpendingCondition = 2
+	// SpendingConditionHTLC locks the proofs to the preimage of a hash (NUT-14)
+	SpendingConditionHTLC SpendingCondition = 3
+)
+
+// TokenConditions is a Go-native representation of cdk_ffi.FfiTokenConditions
+type TokenConditions struct {
+	SpendingCondition SpendingCondition `json:"spending_condition"`
+	// Pubkey is the hex pubkey a P2PK token is locked to
+	Pubkey *string `json:"pubkey,omitempty"`
+	// Hash is the hex SHA-256 hash whose preimage unlocks an HTLC token
+	Hash *string `json:"hash,omitempty"`
+	// Locktime is the unix time after which the refund keys, or anyone if there are none,
+	// can spend the proofs
+	Locktime *uint64 `json:"locktime,omitempty"`
+}
+
+// TokenConditionsFromFFI returns nil for a token without spending conditions
+func TokenConditionsFromFFI(f cdk_ffi.FfiTokenConditions) *TokenConditions {
+	if f.SpendingCondition == cdk_ffi.FfiSpendingConditionNone {
+		return nil
+	}
+	return &TokenConditions{
+		SpendingCondition: SpendingCondition(f.SpendingCondition),
+		Pubkey:            f.Pubkey,
+		Hash:              f.Hash,
+		Locktime:          f.Locktime,
+	}
+}
+
+func (c *TokenConditions) ToFFI() cdk_ffi.FfiTokenConditions {
+	if c == nil {
+		return cdk_ffi.FfiTokenConditions{SpendingCondition: cdk_ffi.FfiSpendingConditionNone}
+	}
+	return cdk_ffi.FfiTokenConditions{
+		SpendingCondition: cdk_ffi.FfiSpendingCondition(c.SpendingCondition),
+		Pubkey:            c.Pubkey,
+		Hash:              c.Hash,
+		Locktime:          c.Locktime,
+	}
+}
+
+// ProofSelection is a Go-native enum matching cdk_ffi.FfiProofSelection
+type ProofSelection uint
+
+const (
+	ProofSelectionLargestFirst  ProofSelection = 1
+	ProofSelectionSmallestFirst ProofSelection = 2
+	// ProofSelectionMinimizeChange is CDK's default selection, used when none is set
+	ProofSelectionMinimizeChange ProofSelection = 3
+)
+
+// TokenVersion is a Go-native enum matching cdk_ffi.FfiTokenVersion
+type TokenVersion uint
+
+const (
+	// TokenVersionV3 is the legacy "cashuA" format, for wallets that cannot read V4
+	TokenVersionV3 TokenVersion = 1
+	// TokenVersionV4 is the "cashuB" format, used when none is set
+	TokenVersionV4 TokenVersion = 2
+)
+
 // SendKind wrapper types
 type SendKind interface{}
 
@@ -187,6 +318,25 @@ type SendOptions struct {
 	IncludeFee        bool
 	Metadata          map[string]string
 	MaxProofs         *uint64
+	ProofSelection    ProofSelection
+	// Pubkey locks the token so only the holder of the matching private key can spend it (NUT-11)
+	Pubkey *string
+	// LocktimeSecs is the number of seconds after which RefundPubkey can also spend the token
+	LocktimeSecs *uint64
+	RefundPubkey *string
+	TokenVersion TokenVersion
+	// DryRun makes PrepareSend only report the fees, without reserving proofs. The result
+	// cannot be finalized, and Send rejects options with DryRun set
+	DryRun bool
+	// HtlcHash locks the token to the preimage of this hex SHA-256 hash (NUT-14), instead of
+	// to a Pubkey
+	HtlcHash *string
+	// HtlcLocktime is the unix time after which RefundPubkey can also spend an HTLC token
+	HtlcLocktime *uint64
+	// PreferredDenominations are the proof amounts the token is made of, e.g. for a vending
+	// machine expecting certain coins. They must be powers of two adding up to the amount
+	// sent and override AmountSplitTarget
+	PreferredDenominations []uint64
 }
 
 func (o SendOptions) ToFFI() cdk_ffi.FfiSendOptions {
@@ -195,24 +345,524 @@ func (o SendOptions) ToFFI() cdk_ffi.FfiSendOptions {
 		ffiMemo = o.Memo.ToFFI()
 	}
 	ffiKind := SendKindToFFI(o.Kind)
+	proofSelection := o.ProofSelection
+	if proofSelection == 0 {
+		proofSelection = ProofSelectionMinimizeChange
+	}
+	tokenVersion := o.TokenVersion
+	if tokenVersion == 0 {
+		tokenVersion = TokenVersionV4
+	}
 
 	return cdk_ffi.FfiSendOptions{
-		Memo:              ffiMemo,
-		AmountSplitTarget: cdk_ffi.FfiSplitTarget(o.AmountSplitTarget),
-		SendKind:          ffiKind,
-		IncludeFee:        o.IncludeFee,
-		Metadata:          o.Metadata,
-		MaxProofs:         o.MaxProofs,
+		Memo:                   ffiMemo,
+		AmountSplitTarget:      cdk_ffi.FfiSplitTarget(o.AmountSplitTarget),
+		SendKind:               ffiKind,
+		IncludeFee:             o.IncludeFee,
+		Metadata:               o.Metadata,
+		MaxProofs:              o.MaxProofs,
+		ProofSelection:         cdk_ffi.FfiProofSelection(proofSelection),
+		Pubkey:                 o.Pubkey,
+		LocktimeSecs:           o.LocktimeSecs,
+		RefundPubkey:           o.RefundPubkey,
+		TokenVersion:           cdk_ffi.FfiTokenVersion(tokenVersion),
+		DryRun:                 o.DryRun,
+		HtlcHash:               o.HtlcHash,
+		HtlcLocktime:           o.HtlcLocktime,
+		PreferredDenominations: o.PreferredDenominations,
 	}
 }
 
 func SendOptionsFromFFI(f cdk_ffi.FfiSendOptions) SendOptions {
 	return SendOptions{
-		Memo:              SendMemoFromFFI(f.Memo),
+		Memo:                   SendMemoFromFFI(f.Memo),
+		AmountSplitTarget:      SplitTarget(f.AmountSplitTarget),
+		Kind:                   SendKindFromFFI(f.SendKind),
+		IncludeFee:             f.IncludeFee,
+		Metadata:               f.Metadata,
+		MaxProofs:              f.MaxProofs,
+		ProofSelection:         ProofSelection(f.ProofSelection),
+		Pubkey:                 f.Pubkey,
+		LocktimeSecs:           f.LocktimeSecs,
+		RefundPubkey:           f.RefundPubkey,
+		TokenVersion:           TokenVersion(f.TokenVersion),
+		DryRun:                 f.DryRun,
+		HtlcHash:               f.HtlcHash,
+		HtlcLocktime:           f.HtlcLocktime,
+		PreferredDenominations: f.PreferredDenominations,
+	}
+}
+
+// ReceiveResult is a Go-native representation of cdk_ffi.FfiReceiveResult
+type ReceiveResult struct {
+	Amount Amount `json:"amount"`
+	// Memo is the memo the sender attached to the token, if any
+	Memo *string `json:"memo,omitempty"`
+	Unit string  `json:"unit"`
+	// LockingPubkey is the hex pubkey the proofs were locked to (P2PK), nil for unlocked tokens
+	LockingPubkey *string `json:"locking_pubkey,omitempty"`
+}
+
+func ReceiveResultFromFFI(f cdk_ffi.FfiReceiveResult) ReceiveResult {
+	return ReceiveResult{
+		Amount:        Amount{Value: f.Amount.Value},
+		Memo:          f.Memo,
+		Unit:          f.Unit,
+		LockingPubkey: f.LockingPubkey,
+	}
+}
+
+// ReceiveBatchResult is a Go-native representation of cdk_ffi.FfiReceiveBatchResult
+type ReceiveBatchResult struct {
+	// Amount is the total received from the tokens not listed in Failed
+	Amount Amount         `json:"amount"`
+	Failed []ReceiveError `json:"failed"`
+}
+
+// ReceiveError is a Go-native representation of cdk_ffi.FfiReceiveError
+type ReceiveError struct {
+	// Index is the position of the token in the batch
+	Index uint32 `json:"index"`
+	Error string `json:"error"`
+}
+
+func ReceiveBatchResultFromFFI(f cdk_ffi.FfiReceiveBatchResult) ReceiveBatchResult {
+	failed := make([]ReceiveError, 0, len(f.Failed))
+	for _, receiveError := range f.Failed {
+		failed = append(failed, ReceiveError{Index: receiveError.Index, Error: receiveError.Error})
+	}
+	return ReceiveBatchResult{
+		Amount: Amount{Value: f.Amount.Value},
+		Failed: failed,
+	}
+}
+
+// ReceiveOptions is a Go-native representation of cdk_ffi.FfiReceiveOptions
+type ReceiveOptions struct {
+	AmountSplitTarget SplitTarget
+	// Idempotent makes receiving an already received token return the original amount
+	// instead of failing with an "already spent" error, so retries are safe
+	Idempotent bool
+	// TrustUnswapped stores the proofs as received, after checking they are unspent, instead
+	// of swapping them for fresh ones. This saves the swap fee, but the sender still knows the
+	// secrets and can spend the proofs too, so only use it between wallets you control.
+	// Tokens in another unit or locked with P2PK or HTLC are rejected, they need a swap.
+	TrustUnswapped bool
+	// P2PKSigningKeys are hex secret keys used to unlock P2PK-locked proofs
+	P2PKSigningKeys []string
+	// Preimages are hex preimages used to unlock HTLC-locked proofs
+	Preimages []string
+	// RequireDleq rejects a token unless every proof carries a valid DLEQ proof (NUT-12) from
+	// the mint, failing with *cdk_ffi.FfiErrorDleqVerificationFailed before it is swapped
+	RequireDleq bool
+}
+
+func (o ReceiveOptions) ToFFI() cdk_ffi.FfiReceiveOptions {
+	splitTarget := o.AmountSplitTarget
+	if splitTarget == 0 {
+		splitTarget = SplitTargetDefault
+	}
+	return cdk_ffi.FfiReceiveOptions{
+		AmountSplitTarget: cdk_ffi.FfiSplitTarget(splitTarget),
+		Idempotent:        o.Idempotent,
+		TrustUnswapped:    o.TrustUnswapped,
+		P2pkSigningKeys:   o.P2PKSigningKeys,
+		Preimages:         o.Preimages,
+		RequireDleq:       o.RequireDleq,
+	}
+}
+
+func ReceiveOptionsFromFFI(f cdk_ffi.FfiReceiveOptions) ReceiveOptions {
+	return ReceiveOptions{
 		AmountSplitTarget: SplitTarget(f.AmountSplitTarget),
-		Kind:              SendKindFromFFI(f.SendKind),
-		IncludeFee:        f.IncludeFee,
-		Metadata:          f.Metadata,
-		MaxProofs:         f.MaxProofs,
+		Idempotent:        f.Idempotent,
+		TrustUnswapped:    f.TrustUnswapped,
+		P2PKSigningKeys:   f.P2pkSigningKeys,
+		Preimages:         f.Preimages,
+		RequireDleq:       f.RequireDleq,
+	}
+}
+
+// State is a Go-native enum matching cdk_ffi.FfiState
+type State uint
+
+const (
+	StateUnspent State = 1
+	StatePending State = 2
+	StateSpent   State = 3
+)
+
+// ProofState is a Go-native representation of cdk_ffi.FfiProofState
+type ProofState struct {
+	// Y is the hex encoded public point identifying the proof
+	Y       string
+	State   State
+	Witness *string
+}
+
+func ProofStateFromFFI(f cdk_ffi.FfiProofState) ProofState {
+	return ProofState{
+		Y:       f.Y,
+		State:   State(f.State),
+		Witness: f.Witness,
+	}
+}
+
+// ProofStateFilter is a Go-native enum matching cdk_ffi.FfiProofStateFilter
+type ProofStateFilter uint
+
+const (
+	ProofStateFilterUnspent ProofStateFilter = 1
+	// ProofStateFilterPending matches proofs in an unfinished swap or melt, including those pending spent
+	ProofStateFilterPending ProofStateFilter = 2
+	// ProofStateFilterReserved matches proofs set aside for a prepared send
+	ProofStateFilterReserved ProofStateFilter = 3
+	ProofStateFilterSpent    ProofStateFilter = 4
+)
+
+func (f *ProofStateFilter) ToFFI() *cdk_ffi.FfiProofStateFilter {
+	if f == nil {
+		return nil
+	}
+	state := cdk_ffi.FfiProofStateFilter(*f)
+	return &state
+}
+
+// TokenState is a Go-native enum matching cdk_ffi.FfiTokenState
+type TokenState uint
+
+const (
+	TokenStateSpendable      TokenState = 1
+	TokenStatePartiallySpent TokenState = 2
+	TokenStateSpent          TokenState = 3
+)
+
+// Proof is a Go-native representation of cdk_ffi.FfiProof
+type Proof struct {
+	Amount   Amount
+	KeysetId string
+	// Secret allows spending the proof, it must not leave the device
+	Secret string
+	// C is the hex encoded unblinded signature point
+	C     string
+	State string
+}
+
+func ProofFromFFI(f cdk_ffi.FfiProof) Proof {
+	return Proof{
+		Amount:   Amount{Value: f.Amount.Value},
+		KeysetId: f.KeysetId,
+		Secret:   f.Secret,
+		C:        f.C,
+		State:    f.State,
+	}
+}
+
+func (p Proof) ToFFI() cdk_ffi.FfiProof {
+	return cdk_ffi.FfiProof{
+		Amount:   cdk_ffi.FfiAmount{Value: p.Amount.Value},
+		KeysetId: p.KeysetId,
+		Secret:   p.Secret,
+		C:        p.C,
+		State:    p.State,
+	}
+}
+
+// QuoteKind is a Go-native enum matching cdk_ffi.FfiQuoteKind
+type QuoteKind uint
+
+const (
+	QuoteKindMint QuoteKind = 1
+	QuoteKindMelt QuoteKind = 2
+)
+
+// QuoteExpiry is a Go-native representation of cdk_ffi.FfiQuoteExpiry
+type QuoteExpiry struct {
+	QuoteId string
+	Kind    QuoteKind
+	// ExpiresIn is the number of seconds left until the quote expires
+	ExpiresIn uint64
+}
+
+func QuoteExpiryFromFFI(f cdk_ffi.FfiQuoteExpiry) QuoteExpiry {
+	return QuoteExpiry{
+		QuoteId:   f.QuoteId,
+		Kind:      QuoteKind(f.Kind),
+		ExpiresIn: f.ExpiresIn,
+	}
+}
+
+// OperationAvailability is a Go-native representation of cdk_ffi.FfiOperationAvailability
+type OperationAvailability struct {
+	Mint bool
+	Melt bool
+	Swap bool
+}
+
+func OperationAvailabilityFromFFI(f cdk_ffi.FfiOperationAvailability) OperationAvailability {
+	return OperationAvailability{
+		Mint: f.Mint,
+		Melt: f.Melt,
+		Swap: f.Swap,
+	}
+}
+
+// Contact is a Go-native representation of cdk_ffi.FfiContact
+type Contact struct {
+	Method string
+	Info   string
+}
+
+// MintInfo is a Go-native representation of cdk_ffi.FfiMintInfo
+type MintInfo struct {
+	Name           *string
+	Pubkey         *string
+	Version        *string
+	Description    *string
+	ContactMethods []Contact
+	// Nuts lists the optional NUTs the mint supports, NUT-00 to 03 and 06 are implied
+	Nuts []uint16
+}
+
+func MintInfoFromFFI(f cdk_ffi.FfiMintInfo) MintInfo {
+	contacts := make([]Contact, 0, len(f.ContactMethods))
+	for _, contact := range f.ContactMethods {
+		contacts = append(contacts, Contact{Method: contact.Method, Info: contact.Info})
+	}
+	return MintInfo{
+		Name:           f.Name,
+		Pubkey:         f.Pubkey,
+		Version:        f.Version,
+		Description:    f.Description,
+		ContactMethods: contacts,
+		Nuts:           f.Nuts,
+	}
+}
+
+// KeysetInfo is a Go-native representation of cdk_ffi.FfiKeysetInfo
+type KeysetInfo struct {
+	Id     string
+	Unit   string
+	Active bool
+	// InputFeePpk is the fee per proof spent from this keyset, in parts per thousand of a unit
+	InputFeePpk uint64
+}
+
+func KeysetInfoFromFFI(f cdk_ffi.FfiKeysetInfo) KeysetInfo {
+	return KeysetInfo{
+		Id:          f.Id,
+		Unit:        f.Unit,
+		Active:      f.Active,
+		InputFeePpk: f.InputFeePpk,
+	}
+}
+
+// KeysetRefreshResult is a Go-native representation of cdk_ffi.FfiKeysetRefreshResult
+type KeysetRefreshResult struct {
+	// NewKeysets counts the keysets the mint announced that the store had not seen before
+	NewKeysets uint32
+	// ProofsMigrated counts the proofs swapped from inactive keysets into the active one
+	ProofsMigrated uint32
+}
+
+func KeysetRefreshResultFromFFI(f cdk_ffi.FfiKeysetRefreshResult) KeysetRefreshResult {
+	return KeysetRefreshResult{
+		NewKeysets:     f.NewKeysets,
+		ProofsMigrated: f.ProofsMigrated,
+	}
+}
+
+// VacuumResult is a Go-native representation of cdk_ffi.FfiVacuumResult
+type VacuumResult struct {
+	ProofsRemoved uint64
+	QuotesRemoved uint64
+}
+
+func VacuumResultFromFFI(f cdk_ffi.FfiVacuumResult) VacuumResult {
+	return VacuumResult{
+		ProofsRemoved: f.ProofsRemoved,
+		QuotesRemoved: f.QuotesRemoved,
+	}
+}
+
+// NetFlow is a Go-native representation of cdk_ffi.FfiNetFlow
+type NetFlow struct {
+	TotalIn   Amount
+	TotalOut  Amount
+	TotalFees Amount
+	// Net is incoming minus outgoing amounts and outgoing fees, negative when the wallet shrank
+	Net int64
+}
+
+func NetFlowFromFFI(f cdk_ffi.FfiNetFlow) NetFlow {
+	return NetFlow{
+		TotalIn:   Amount{Value: f.TotalIn.Value},
+		TotalOut:  Amount{Value: f.TotalOut.Value},
+		TotalFees: Amount{Value: f.TotalFees.Value},
+		Net:       f.Net,
+	}
+}
+
+// Diagnostics is a Go-native representation of cdk_ffi.FfiDiagnostics
+// It holds no seeds, secrets or proofs and is safe to attach to bug reports
+type Diagnostics struct {
+	MintUrl        string
+	Unit           string
+	Balance        Amount
+	ProofCount     uint64
+	KeysetIds      []string
+	LibraryVersion string
+	CdkVersion     string
+	RecentErrors   []string
+}
+
+func DiagnosticsFromFFI(f cdk_ffi.FfiDiagnostics) Diagnostics {
+	return Diagnostics{
+		MintUrl:        f.MintUrl,
+		Unit:           f.Unit,
+		Balance:        Amount{Value: f.Balance.Value},
+		ProofCount:     f.ProofCount,
+		KeysetIds:      f.KeysetIds,
+		LibraryVersion: f.LibraryVersion,
+		CdkVersion:     f.CdkVersion,
+		RecentErrors:   f.RecentErrors,
+	}
+}
+
+// TransactionDirection is a Go-native enum matching cdk_ffi.FfiTransactionDirection
+type TransactionDirection uint
+
+const (
+	TransactionDirectionIncoming TransactionDirection = 1
+	TransactionDirectionOutgoing TransactionDirection = 2
+)
+
+// Transaction is a Go-native representation of cdk_ffi.FfiTransaction
+type Transaction struct {
+	Id        string
+	Direction TransactionDirection
+	Amount    Amount
+	Unit      string
+	Timestamp uint64
+	Memo      *string
+	MintUrl   string
+}
+
+func TransactionFromFFI(f cdk_ffi.FfiTransaction) Transaction {
+	return Transaction{
+		Id:        f.Id,
+		Direction: TransactionDirection(f.Direction),
+		Amount:    Amount{Value: f.Amount.Value},
+		Unit:      f.Unit,
+		Timestamp: f.Timestamp,
+		Memo:      f.Memo,
+		MintUrl:   f.MintUrl,
+	}
+}
+
+// TransactionFilter limits ListTransactions, nil fields match everything
+type TransactionFilter struct {
+	Direction *TransactionDirection
+	Unit      *string
+}
+
+func (f *TransactionFilter) ToFFI() *cdk_ffi.FfiTransactionFilter {
+	if f == nil {
+		return nil
+	}
+	var direction *cdk_ffi.FfiTransactionDirection
+	if f.Direction != nil {
+		d := cdk_ffi.FfiTransactionDirection(*f.Direction)
+		direction = &d
+	}
+	return &cdk_ffi.FfiTransactionFilter{
+		Direction: direction,
+		Unit:      f.Unit,
+	}
+}
+
+// TransportType is a Go-native enum matching cdk_ffi.FfiTransportType
+type TransportType uint
+
+const (
+	TransportTypeNostr    TransportType = 1
+	TransportTypeHttpPost TransportType = 2
+)
+
+// Transport is where a payment request wants the token delivered
+type Transport struct {
+	Type TransportType
+	// Target is an nprofile for Nostr and a URL for HTTP POST
+	Target string
+	Tags   [][]string
+}
+
+func TransportFromFFI(f cdk_ffi.FfiTransport) Transport {
+	return Transport{
+		Type:   TransportType(f.TransportType),
+		Target: f.Target,
+		Tags:   f.Tags,
+	}
+}
+
+func (t Transport) ToFFI() cdk_ffi.FfiTransport {
+	return cdk_ffi.FfiTransport{
+		TransportType: cdk_ffi.FfiTransportType(t.Type),
+		Target:        t.Target,
+		Tags:          t.Tags,
+	}
+}
+
+// PaymentRequest is a Go-native representation of cdk_ffi.FfiPaymentRequest (NUT-18)
+type PaymentRequest struct {
+	PaymentId *string
+	// Amount is nil when the payer chooses the amount
+	Amount    *Amount
+	Unit      *string
+	SingleUse *bool
+	// Mints the payee accepts tokens from, empty for any mint
+	Mints       []string
+	Description *string
+	// Transports is empty when the payer hands the token over directly
+	Transports []Transport
+}
+
+func PaymentRequestFromFFI(f cdk_ffi.FfiPaymentRequest) PaymentRequest {
+	var amount *Amount
+	if f.Amount != nil {
+		amount = &Amount{Value: f.Amount.Value}
+	}
+	var transports []Transport
+	for _, t := range f.Transports {
+		transports = append(transports, TransportFromFFI(t))
+	}
+	return PaymentRequest{
+		PaymentId:   f.PaymentId,
+		Amount:      amount,
+		Unit:        f.Unit,
+		SingleUse:   f.SingleUse,
+		Mints:       f.Mints,
+		Description: f.Description,
+		Transports:  transports,
+	}
+}
+
+func (r PaymentRequest) ToFFI() cdk_ffi.FfiPaymentRequest {
+	var amount *cdk_ffi.FfiAmount
+	if r.Amount != nil {
+		amount = &cdk_ffi.FfiAmount{Value: r.Amount.Value}
+	}
+	var transports []cdk_ffi.FfiTransport
+	for _, t := range r.Transports {
+		transports = append(transports, t.ToFFI())
+	}
+	return cdk_ffi.FfiPaymentRequest{
+		PaymentId:   r.PaymentId,
+		Amount:      amount,
+		Unit:        r.Unit,
+		SingleUse:   r.SingleUse,
+		Mints:       r.Mints,
+		Description: r.Description,
+		Transports:  transports,
 	}
 }
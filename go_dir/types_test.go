@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 	"go_dir/cdk_ffi"
 )
@@ -15,11 +17,14 @@ func TestSendMemoConversion(t *testing.T) {
 }
 
 func TestTokenConversion(t *testing.T) {
-	f := cdk_ffi.FfiToken{TokenString: "tok", Mint: "mint1", Memo: nil, Unit: "sat"}
+	f := cdk_ffi.FfiToken{TokenString: "tok", Mint: "mint1", Memo: nil, Unit: "sat", Amount: cdk_ffi.FfiAmount{Value: 64}}
 	got := TokenFromFFI(f)
-	if got.tokenString != "tok" || got.tokenString != "mint1" || got.Unit != "sat" {
+	if got.tokenString != "tok" || got.Mint != "mint1" || got.Unit != "sat" || got.Amount.Value != 64 {
 		t.Fatalf("unexpected token conversion: %#v", got)
 	}
+	if back := got.ToFFI(); back.Amount.Value != 64 {
+		t.Fatalf("amount lost converting back: %#v", back)
+	}
 }
 
 func TestSendOptionsRoundTrip(t *testing.T) {
@@ -41,3 +46,259 @@ func TestSendOptionsRoundTrip(t *testing.T) {
 		t.Fatalf("kind lost in roundtrip")
 	}
 }
+
+func TestSendOptionsProofSelection(t *testing.T) {
+	o := SendOptions{ProofSelection: ProofSelectionSmallestFirst}
+	ffi := o.ToFFI()
+	if ffi.ProofSelection != cdk_ffi.FfiProofSelectionSmallestFirst {
+		t.Fatalf("unexpected proof selection: %v", ffi.ProofSelection)
+	}
+	if back := SendOptionsFromFFI(ffi); back.ProofSelection != ProofSelectionSmallestFirst {
+		t.Fatalf("proof selection lost in roundtrip: %v", back.ProofSelection)
+	}
+	if ffi := (SendOptions{}).ToFFI(); ffi.ProofSelection != cdk_ffi.FfiProofSelectionMinimizeChange {
+		t.Fatalf("unset proof selection should default to MinimizeChange, got %v", ffi.ProofSelection)
+	}
+}
+
+func TestSendOptionsTokenVersion(t *testing.T) {
+	o := SendOptions{TokenVersion: TokenVersionV3}
+	ffi := o.ToFFI()
+	if ffi.TokenVersion != cdk_ffi.FfiTokenVersionV3 {
+		t.Fatalf("unexpected token version: %v", ffi.TokenVersion)
+	}
+	if back := SendOptionsFromFFI(ffi); back.TokenVersion != TokenVersionV3 {
+		t.Fatalf("token version lost in roundtrip: %v", back.TokenVersion)
+	}
+	if ffi := (SendOptions{}).ToFFI(); ffi.TokenVersion != cdk_ffi.FfiTokenVersionV4 {
+		t.Fatalf("unset token version should default to V4, got %v", ffi.TokenVersion)
+	}
+}
+
+func TestSendOptionsP2PKRoundTrip(t *testing.T) {
+	pubkey := "02a9acc1e48c25eeeb9289b5031cc57da9fe72f3fe2861d264bdc074209b107ba2"
+	refund := "03f9c1d2f4a1b3e2d7c8a6b5e4f3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4"
+	locktime := uint64(3600)
+	o := SendOptions{Pubkey: &pubkey, LocktimeSecs: &locktime, RefundPubkey: &refund}
+	back := SendOptionsFromFFI(o.ToFFI())
+	if back.Pubkey == nil || *back.Pubkey != pubkey {
+		t.Fatalf("pubkey lost in roundtrip: %v", back.Pubkey)
+	}
+	if back.LocktimeSecs == nil || *back.LocktimeSecs != locktime {
+		t.Fatalf("locktime lost in roundtrip: %v", back.LocktimeSecs)
+	}
+	if back.RefundPubkey == nil || *back.RefundPubkey != refund {
+		t.Fatalf("refund pubkey lost in roundtrip: %v", back.RefundPubkey)
+	}
+
+	if unlocked := SendOptionsFromFFI(SendOptions{}.ToFFI()); unlocked.Pubkey != nil || unlocked.LocktimeSecs != nil || unlocked.RefundPubkey != nil {
+		t.Fatalf("unset P2PK fields should stay nil: %#v", unlocked)
+	}
+}
+
+func TestReceiveOptionsConversion(t *testing.T) {
+	ffi := ReceiveOptions{Idempotent: true, TrustUnswapped: true}.ToFFI()
+	if ffi.AmountSplitTarget != cdk_ffi.FfiSplitTargetDefault || !ffi.Idempotent || !ffi.TrustUnswapped {
+		t.Fatalf("unexpected receive options: %#v", ffi)
+	}
+	back := ReceiveOptionsFromFFI(ffi)
+	if back.AmountSplitTarget != SplitTargetDefault || !back.Idempotent || !back.TrustUnswapped {
+		t.Fatalf("roundtrip mismatch: %#v", back)
+	}
+}
+
+func TestTransactionFilterToFFI(t *testing.T) {
+	var nilFilter *TransactionFilter
+	if nilFilter.ToFFI() != nil {
+		t.Fatalf("nil filter should stay nil")
+	}
+
+	direction := TransactionDirectionOutgoing
+	unit := "sat"
+	ffi := (&TransactionFilter{Direction: &direction, Unit: &unit}).ToFFI()
+	if ffi == nil || ffi.Direction == nil || *ffi.Direction != cdk_ffi.FfiTransactionDirectionOutgoing || *ffi.Unit != "sat" {
+		t.Fatalf("unexpected filter conversion: %#v", ffi)
+	}
+}
+
+func TestUnitRoundTrip(t *testing.T) {
+	for _, unit := range []Unit{Sat, Msat, Usd, Eur, Unit("points")} {
+		parsed, err := ParseUnit(unit.String())
+		if err != nil || parsed != unit {
+			t.Fatalf("ParseUnit(%q) = %q, %v", unit.String(), parsed, err)
+		}
+		ffi, err := unit.ToFFI()
+		if err != nil {
+			t.Fatalf("ToFFI(%q): %v", unit, err)
+		}
+		if back := UnitFromFFI(ffi); back != unit {
+			t.Fatalf("ffi roundtrip of %q gave %q", unit, back)
+		}
+	}
+
+	if parsed, err := ParseUnit(" USD "); err != nil || parsed != Usd {
+		t.Fatalf("ParseUnit should normalize case and spaces, got %q, %v", parsed, err)
+	}
+	if ffi, _ := Unit("points").ToFFI(); ffi != (cdk_ffi.FfiCurrencyUnitCustom{Value: "points"}) {
+		t.Fatalf("unknown unit should map to the custom variant")
+	}
+	if ffi, err := Unit(" USD ").ToFFI(); err != nil || ffi != (cdk_ffi.FfiCurrencyUnitUsd{}) {
+		t.Fatalf("ToFFI should normalize case, got %#v, %v", ffi, err)
+	}
+	if _, err := Unit("").ToFFI(); err == nil {
+		t.Fatalf("empty unit should fail to convert")
+	}
+	if _, err := ParseUnit(""); err == nil {
+		t.Fatalf("empty unit should fail to parse")
+	}
+}
+
+func TestTokenJSON(t *testing.T) {
+	token := Token{tokenString: "cashuBtok", Mint: "https://mint.example", Unit: "sat", Amount: Amount{Value: 21}}
+	data, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `{"token":"cashuBtok","mint":"https://mint.example","unit":"sat","amount":21}`
+	if string(data) != want {
+		t.Fatalf("unexpected json:\n got %s\nwant %s", data, want)
+	}
+
+	var back Token
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(back, token) {
+		t.Fatalf("roundtrip mismatch: %#v", back)
+	}
+
+	memo := "thanks"
+	token.Memo = &memo
+	data, _ = json.Marshal(token)
+	if err := json.Unmarshal(data, &back); err != nil || back.Memo == nil || *back.Memo != memo {
+		t.Fatalf("memo lost in roundtrip: %s", data)
+	}
+}
+
+func TestMintQuoteJSON(t *testing.T) {
+	quote := MintQuote{Id: "q1", MintUrl: "https://mint.example", Amount: Amount{Value: 100}, Unit: "sat", Request: "lnbc1", State: MintQuoteStatePaid, Expiry: 1700000000}
+	data, err := json.Marshal(quote)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `{"id":"q1","mint_url":"https://mint.example","amount":100,"unit":"sat","request":"lnbc1","state":2,"expiry":1700000000}`
+	if string(data) != want {
+		t.Fatalf("unexpected json:\n got %s\nwant %s", data, want)
+	}
+}
+
+func TestPaymentRequestConversion(t *testing.T) {
+	unit, description := "sat", "coffee"
+	request := PaymentRequest{
+		Amount:      &Amount{Value: 21},
+		Unit:        &unit,
+		Mints:       []string{"https://mint.example"},
+		Description: &description,
+		Transports:  []Transport{{Type: TransportTypeHttpPost, Target: "https://pay.example", Tags: [][]string{{"n", "17"}}}},
+	}
+	ffi := request.ToFFI()
+	if ffi.Amount == nil || ffi.Amount.Value != 21 || ffi.Transports[0].TransportType != cdk_ffi.FfiTransportTypeHttpPost {
+		t.Fatalf("unexpected payment request conversion: %#v", ffi)
+	}
+	if back := PaymentRequestFromFFI(ffi); !reflect.DeepEqual(back, request) {
+		t.Fatalf("roundtrip mismatch:\n got %#v\nwant %#v", back, request)
+	}
+
+	if open := PaymentRequestFromFFI(PaymentRequest{}.ToFFI()); open.Amount != nil || open.Transports != nil {
+		t.Fatalf("unset fields should stay nil: %#v", open)
+	}
+}
+
+func TestSendOptionsDryRun(t *testing.T) {
+	ffi := SendOptions{DryRun: true}.ToFFI()
+	if !ffi.DryRun {
+		t.Fatalf("dry run lost converting to ffi: %#v", ffi)
+	}
+	if back := SendOptionsFromFFI(ffi); !back.DryRun {
+		t.Fatalf("dry run lost in roundtrip: %#v", back)
+	}
+	if (SendOptions{}).ToFFI().DryRun {
+		t.Fatalf("dry run should default to false")
+	}
+}
+
+func TestHTLCOptionsRoundTrip(t *testing.T) {
+	hash := "66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925"
+	locktime := uint64(1_700_000_000)
+	send := SendOptions{HtlcHash: &hash, HtlcLocktime: &locktime}
+	back := SendOptionsFromFFI(send.ToFFI())
+	if back.HtlcHash == nil || *back.HtlcHash != hash || back.HtlcLocktime == nil || *back.HtlcLocktime != locktime {
+		t.Fatalf("htlc options lost in roundtrip: %#v", back)
+	}
+
+	receive := ReceiveOptions{Preimages: []string{"0000000000000000000000000000000000000000000000000000000000000001"}}
+	if got := ReceiveOptionsFromFFI(receive.ToFFI()); !reflect.DeepEqual(got.Preimages, receive.Preimages) {
+		t.Fatalf("preimages lost in roundtrip: %#v", got)
+	}
+}
+
+func TestPreferredDenominationsRoundTrip(t *testing.T) {
+	send := SendOptions{PreferredDenominations: []uint64{1, 4, 4, 16}}
+	ffi := send.ToFFI()
+	if !reflect.DeepEqual(ffi.PreferredDenominations, send.PreferredDenominations) {
+		t.Fatalf("denominations not passed to FFI: %#v", ffi.PreferredDenominations)
+	}
+	if back := SendOptionsFromFFI(ffi); !reflect.DeepEqual(back.PreferredDenominations, send.PreferredDenominations) {
+		t.Fatalf("denominations lost in roundtrip: %#v", back.PreferredDenominations)
+	}
+}
+
+func TestProofRoundTrip(t *testing.T) {
+	p := Proof{
+		Amount:   Amount{Value: 8},
+		KeysetId: "009a1f293253e41e",
+		Secret:   "407915bc212be61a77e3e6d2aeb4c727980bda51cd06a6afc29e2861768a7837",
+		C:        "02bc9097997d81afb2cc7346b5e4345a9346bd2a506eb7958598a72f0cf85163ea",
+		State:    "UNSPENT",
+	}
+	if back := ProofFromFFI(p.ToFFI()); back != p {
+		t.Fatalf("roundtrip mismatch: %#v", back)
+	}
+}
+
+func TestReceiveResultJSON(t *testing.T) {
+	memo := "thanks for lunch"
+	got := ReceiveResultFromFFI(cdk_ffi.FfiReceiveResult{
+		Amount: cdk_ffi.FfiAmount{Value: 100},
+		Memo:   &memo,
+		Unit:   "sat",
+	})
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if want := `{"amount":100,"memo":"thanks for lunch","unit":"sat"}`; string(data) != want {
+		t.Fatalf("got %s, want %s", data, want)
+	}
+}
+
+func TestTokenConditionsConversion(t *testing.T) {
+	if got := TokenFromFFI(cdk_ffi.FfiToken{Conditions: cdk_ffi.FfiTokenConditions{SpendingCondition: cdk_ffi.FfiSpendingConditionNone}}); got.Conditions != nil {
+		t.Fatalf("unlocked token has conditions: %#v", got.Conditions)
+	}
+
+	pubkey := "02a9acc1e48c25eeeb9289b5031cc57da9fe72f3fe2861d264bdc074209b107ba2"
+	locktime := uint64(1_700_000_000)
+	f := cdk_ffi.FfiToken{Conditions: cdk_ffi.FfiTokenConditions{
+		SpendingCondition: cdk_ffi.FfiSpendingConditionP2pk,
+		Pubkey:            &pubkey,
+		Locktime:          &locktime,
+	}}
+	got := TokenFromFFI(f)
+	if got.Conditions == nil || got.Conditions.SpendingCondition != SpendingConditionP2PK || *got.Conditions.Pubkey != pubkey {
+		t.Fatalf("unexpected conditions: %#v", got.Conditions)
+	}
+	if back := got.ToFFI(); !reflect.DeepEqual(back.Conditions, f.Conditions) {
+		t.Fatalf("conditions lost converting back: %#v", back.Conditions)
+	}
+}
@@ -1,17 +1,51 @@
-use std::collections::HashMap;
-use std::sync::Arc;
+use std::cmp::Reverse;
+use std::collections::{BTreeMap, HashMap, HashSet, VecDeque};
+use std::future::Future;
+use std::net::TcpStream;
+use std::str::FromStr;
+use std::sync::{Arc, Mutex};
+use std::time::{Duration, Instant};
 
 use cdk::amount::SplitTarget;
+use cdk::dhke::construct_proofs;
+use cdk::lightning_invoice::Bolt11Invoice;
+use cdk::mint_url::MintUrl;
 use cdk::nuts::nut00::ProofsMethods;
-use cdk::nuts::{CurrencyUnit, MintQuoteState};
-use cdk::wallet::{PreparedSend, SendMemo, SendOptions, Wallet as CdkWallet};
+use cdk::nuts::nut17::NotificationPayload;
+use cdk::nuts::nut18::{PaymentRequest, PaymentRequestPayload, Transport, TransportType};
+use cdk::nuts::{
+    nut12, Conditions, CurrencyUnit, Id, KeySetInfo, Keys, MeltOptions, MeltQuoteState, MintInfo,
+    MintQuoteState, PreMintSecrets, Proof, ProofState, PublicKey, RestoreRequest, SecretKey,
+    SpendingConditions, State, Token, TokenV3,
+};
+use cdk::secret::Secret;
+use cdk::util::unix_time;
+use cdk::wallet::{
+    HttpClient, MintConnector, PreparedSend, ReceiveOptions, SendMemo, SendOptions,
+    Wallet as CdkWallet, WalletSubscription,
+};
 use cdk::Amount;
-use cdk_common::common::Melted;
+use cdk_common::bitcoin::bip32::{DerivationPath, Xpriv};
+use cdk_common::bitcoin::hashes::sha256::Hash as Sha256Hash;
+use cdk_common::bitcoin::hashes::Hash as _;
+use cdk_common::bitcoin::secp256k1::Secp256k1;
+use cdk_common::bitcoin::Network;
+use cdk_common::common::{Melted, ProofInfo};
 use cdk_common::database::WalletDatabase;
-use cdk_common::wallet::{MeltQuote, MintQuote, SendKind};
+use cdk_common::error::{ErrorCode, ErrorResponse};
+use cdk_common::wallet::{
+    MeltQuote, MintQuote, SendKind, Transaction, TransactionDirection, TransactionId,
+};
 
+use argon2::Argon2;
 use bip39::Mnemonic;
+use chacha20poly1305::aead::rand_core::RngCore;
+use chacha20poly1305::aead::{Aead, AeadCore, KeyInit, OsRng};
+use chacha20poly1305::{ChaCha20Poly1305, Nonce};
 use tokio::runtime::Runtime;
+use tokio::sync::watch;
+use tokio::task::JoinHandle;
+use tracing_subscriber::layer::{Context, Layer, SubscriberExt};
 
 // Export the uniffi bindings
 uniffi::setup_scaffolding!();
@@ -21,47 +55,630 @@ uniffi::setup_scaffolding!();
 pub fn generate_mnemonic() -> Result<String> {
     let mnemonic = Mnemonic::generate(12).map_err(|e| FFIError::InternalError {
         msg: format!("Failed to generate mnemonic: {}", e),
+        code: NO_ERROR_CODE,
     })?;
     Ok(mnemonic.to_string())
 }
 
+/// Generate a mnemonic phrase of 12, 15, 18, 21 or 24 words
+#[uniffi::export]
+pub fn generate_mnemonic_with_word_count(words: u8) -> Result<String> {
+    if !matches!(words, 12 | 15 | 18 | 21 | 24) {
+        return Err(FFIError::InvalidInput {
+            msg: format!("Mnemonic must have 12, 15, 18, 21 or 24 words, got {}", words),
+            code: NO_ERROR_CODE,
+        });
+    }
+    let mnemonic = Mnemonic::generate(words as usize).map_err(|e| FFIError::InternalError {
+        msg: format!("Failed to generate mnemonic: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    Ok(mnemonic.to_string())
+}
+
+/// Check that a phrase is a valid BIP39 mnemonic: known words, a valid word count and checksum
+/// Returns false for any of those problems, and InvalidInput only for an empty phrase
+#[uniffi::export]
+pub fn validate_mnemonic(phrase: String) -> Result<bool> {
+    if phrase.trim().is_empty() {
+        return Err(FFIError::InvalidInput {
+            msg: "Mnemonic is empty".to_string(),
+            code: NO_ERROR_CODE,
+        });
+    }
+    Ok(Mnemonic::parse(&phrase).is_ok())
+}
+
+/// Send the log events of this library and CDK to `observer`
+/// The callback is process wide and can only be set once, later calls return InvalidInput
+#[uniffi::export]
+pub fn set_log_callback(observer: Box<dyn LogObserver>) -> Result<()> {
+    let subscriber = tracing_subscriber::registry().with(LogObserverLayer { observer });
+    tracing::subscriber::set_global_default(subscriber).map_err(|_| FFIError::InvalidInput {
+        msg: "Log callback is already set".to_string(),
+        code: NO_ERROR_CODE,
+    })
+}
+
+/// Canonical form of a mint URL, as wallets store it: lowercase scheme and host and no
+/// trailing slash, so different spellings of one mint map to the same records
+#[uniffi::export]
+pub fn normalize_mint_url(mint_url: String) -> Result<String> {
+    let mint_url = MintUrl::from_str(&mint_url).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid mint URL: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    Ok(mint_url.to_string())
+}
+
+/// Current unix time in seconds on the clock the wallet checks quote expiries against
+#[uniffi::export]
+pub fn current_mint_time() -> u64 {
+    unix_time()
+}
+
+/// Decode a Cashu token string without a wallet or a mint connection
+#[uniffi::export]
+pub fn decode_token(token: String) -> Result<FFIToken> {
+    let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid token: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    token.try_into()
+}
+
+/// Re-encode a token as V3 (`cashuA`) or V4 (`cashuB`) without a wallet, e.g. for a relay
+/// serving older clients. The memo is dropped unless `include_memo`. V4 tokens with short
+/// keyset ids (NUT-02 v2 keysets) need the mint's keysets to become V3 and are InvalidInput
+#[uniffi::export]
+pub fn reencode_token(
+    token: String,
+    version: FFITokenVersion,
+    include_memo: bool,
+) -> Result<String> {
+    let invalid = |e: cdk::nuts::nut00::Error| FFIError::InvalidInput {
+        msg: format!("Invalid token: {}", e),
+        code: NO_ERROR_CODE,
+    };
+    let token = Token::from_str(&token).map_err(invalid)?;
+    let mint_url = token.mint_url().map_err(invalid)?;
+    let proofs = token.proofs(&[]).map_err(invalid)?;
+    let memo = token.memo().clone().filter(|_| include_memo);
+    let unit = token.unit().unwrap_or_default();
+
+    let reencoded = match version {
+        FFITokenVersion::V3 => Token::TokenV3(TokenV3::new(mint_url, proofs, memo, Some(unit))?),
+        FFITokenVersion::V4 => Token::new(mint_url, proofs, memo, unit),
+    };
+    Ok(reencoded.to_string())
+}
+
+/// Encode a V4 token in its binary form (`craw` prefix followed by CBOR), as used over NFC
+#[uniffi::export]
+pub fn token_to_raw_bytes(token: String) -> Result<Vec<u8>> {
+    let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid token: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    if matches!(token, Token::TokenV3(_)) {
+        return Err(FFIError::InvalidInput {
+            msg: "Only V4 tokens have a binary encoding".to_string(),
+            code: NO_ERROR_CODE,
+        });
+    }
+    Ok(token.to_raw_bytes()?)
+}
+
+/// Serialize a token into bytes that `token_from_bytes` turns back into the same token
+/// V4 tokens use their binary encoding, V3 tokens have none and are kept as the `cashuA` string
+#[uniffi::export]
+pub fn token_to_bytes(token: String) -> Result<Vec<u8>> {
+    let parsed = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid token: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    match parsed {
+        Token::TokenV3(_) => Ok(token.into_bytes()),
+        Token::TokenV4(_) => Ok(parsed.to_raw_bytes()?),
+    }
+}
+
+/// Deserialize bytes written by `token_to_bytes`, telling the formats apart by their prefix
+#[uniffi::export]
+pub fn token_from_bytes(bytes: Vec<u8>) -> Result<FFIToken> {
+    let token = if bytes.starts_with(b"craw") {
+        Token::try_from(&bytes).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid token bytes: {}", e),
+            code: NO_ERROR_CODE,
+        })?
+    } else {
+        let token = String::from_utf8(bytes).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid token bytes: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+        Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid token: {}", e),
+            code: NO_ERROR_CODE,
+        })?
+    };
+    token.try_into()
+}
+
+/// Encode a payment request (NUT-18) as the `creqA` string a payee publishes, e.g. in a QR code
+#[uniffi::export]
+pub fn encode_payment_request(request: FFIPaymentRequest) -> Result<String> {
+    let request: PaymentRequest = request.try_into()?;
+    Ok(request.to_string())
+}
+
+/// Decode a `creqA` payment request (NUT-18) emitted by any Cashu wallet
+#[uniffi::export]
+pub fn decode_payment_request(request: String) -> Result<FFIPaymentRequest> {
+    let request = PaymentRequest::from_str(&request).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid payment request: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    Ok(request.into())
+}
+
+/// Fetch a mint's info (NUT-06) to check that a URL points to a reachable Cashu mint, without
+/// a wallet or a store. Returns NetworkError if the mint cannot be reached and InvalidInput if
+/// the URL does not answer with mint info
+#[uniffi::export]
+pub fn ping_mint(mint_url: String) -> Result<FFIMintInfo> {
+    let invalid_url = |e: cdk::mint_url::Error| FFIError::InvalidInput {
+        msg: format!("Invalid mint URL: {}", e),
+        code: NO_ERROR_CODE,
+    };
+    let mint_url = MintUrl::from_str(&mint_url).map_err(invalid_url)?;
+    runtime().block_on(async {
+        Ok(fetch_mint_info(&reqwest::Client::new(), &mint_url).await?.into())
+    })
+}
+
+/// GET a mint's NUT-06 info directly with `client`, bypassing the wallet's CDK client and store
+async fn fetch_mint_info(client: &reqwest::Client, mint_url: &MintUrl) -> Result<MintInfo> {
+    let info_url = mint_url
+        .join_paths(&["v1", "info"])
+        .map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid mint URL: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+
+    let unreachable = |e: reqwest::Error| FFIError::NetworkError {
+        msg: format!("Mint unreachable: {}", e),
+        code: NO_ERROR_CODE,
+    };
+    let response = client
+        .get(info_url)
+        .timeout(Duration::from_secs(PING_TIMEOUT_SECS))
+        .send()
+        .await
+        .map_err(unreachable)?;
+    let status = response.status();
+    if status.is_server_error() {
+        return Err(FFIError::NetworkError {
+            msg: format!("Mint answered with {}", status),
+            code: NO_ERROR_CODE,
+        });
+    }
+    if !status.is_success() {
+        return Err(FFIError::InvalidInput {
+            msg: format!("Not a Cashu mint, info endpoint answered with {}", status),
+            code: NO_ERROR_CODE,
+        });
+    }
+
+    let body = response.bytes().await.map_err(unreachable)?;
+    let info: MintInfo = serde_json::from_slice(&body).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Not a Cashu mint, invalid info response: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    Ok(info)
+}
+
+/// Verify the mint's DLEQ proofs (NUT-12) on every proof of a token without contacting the mint
+/// `keys` maps each keyset id to the mint's public key per amount, as served by `/v1/keys`
+/// Returns false if any signature does not match, and InvalidInput if a proof carries no DLEQ
+#[uniffi::export]
+pub fn verify_token_dleq(
+    token: String,
+    keys: HashMap<String, HashMap<u64, String>>,
+) -> Result<bool> {
+    let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid token: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+
+    let mut mint_keys = HashMap::new();
+    for (id, amount_keys) in keys {
+        let id = Id::from_str(&id).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid keyset id: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+        let amount_keys = amount_keys
+            .into_iter()
+            .map(|(amount, pubkey)| {
+                let pubkey = PublicKey::from_hex(&pubkey).map_err(|e| FFIError::InvalidInput {
+                    msg: format!("Invalid mint key: {}", e),
+                    code: NO_ERROR_CODE,
+                })?;
+                Ok((Amount::from(amount), pubkey))
+            })
+            .collect::<Result<BTreeMap<_, _>>>()?;
+        mint_keys.insert(id, Keys::new(amount_keys));
+    }
+
+    // V4 tokens only carry short keyset ids, which are resolved against the given keysets
+    let unit = token.unit().unwrap_or_default();
+    let keysets: Vec<_> = mint_keys
+        .keys()
+        .map(|id| KeySetInfo {
+            id: *id,
+            unit: unit.clone(),
+            active: true,
+            input_fee_ppk: 0,
+            final_expiry: None,
+        })
+        .collect();
+    let proofs = token.proofs(&keysets)?;
+    verify_proofs_dleq(&proofs, &mint_keys)
+}
+
+/// Check every proof's DLEQ proof against the mint key for its keyset and amount
+fn verify_proofs_dleq(proofs: &[Proof], keys: &HashMap<Id, Keys>) -> Result<bool> {
+    for proof in proofs {
+        let mint_pubkey = keys
+            .get(&proof.keyset_id)
+            .and_then(|keys| keys.amount_key(proof.amount))
+            .ok_or_else(|| FFIError::InvalidInput {
+                msg: format!(
+                    "No mint key for keyset {} and amount {}",
+                    proof.keyset_id, proof.amount
+                ),
+                code: NO_ERROR_CODE,
+            })?;
+        match proof.verify_dleq(mint_pubkey) {
+            Ok(()) => {}
+            Err(nut12::Error::MissingDleqProof) => {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token proofs carry no DLEQ proof".to_string(),
+                    code: NO_ERROR_CODE,
+                })
+            }
+            Err(_) => return Ok(false),
+        }
+    }
+    Ok(true)
+}
+
+fn parse_pubkey(pubkey: &str) -> Result<PublicKey> {
+    PublicKey::from_hex(pubkey).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid public key: {}", e),
+        code: NO_ERROR_CODE,
+    })
+}
+
 /// Convert a mnemonic phrase to a 64-byte seed for wallet creation
 fn mnemonic_to_seed(mnemonic_words: String) -> Result<[u8; 64]> {
     let mnemonic = Mnemonic::parse(&mnemonic_words).map_err(|e| FFIError::InvalidInput {
         msg: format!("Invalid mnemonic: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+
+    Ok(mnemonic.to_seed_normalized(""))
+}
+
+/// Derive the wallet's P2PK receiving key, on its own path apart from the NUT-13 secrets
+fn p2pk_key_from_seed(seed: &[u8; 64]) -> Result<SecretKey> {
+    let secp = Secp256k1::new();
+    let path = DerivationPath::from_str(P2PK_KEY_PATH).map_err(|e| FFIError::InternalError {
+        msg: format!("Invalid P2PK key path: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    let xpriv = Xpriv::new_master(Network::Bitcoin, seed)
+        .and_then(|master| master.derive_priv(&secp, &path))
+        .map_err(|e| FFIError::InternalError {
+            msg: format!("Failed to derive P2PK key: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+    Ok(SecretKey::from(xpriv.private_key))
+}
+
+/// Derive the backup key from the passphrase with Argon2
+fn backup_cipher(passphrase: &str, salt: &[u8]) -> Result<ChaCha20Poly1305> {
+    let mut key = [0u8; 32];
+    Argon2::default()
+        .hash_password_into(passphrase.as_bytes(), salt, &mut key)
+        .map_err(|e| FFIError::InternalError {
+            msg: format!("Failed to derive backup key: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+    Ok(ChaCha20Poly1305::new(&key.into()))
+}
+
+fn encrypt_backup(plaintext: &[u8], passphrase: &str) -> Result<Vec<u8>> {
+    let mut salt = [0u8; BACKUP_SALT_LEN];
+    OsRng.fill_bytes(&mut salt);
+    let nonce = ChaCha20Poly1305::generate_nonce(&mut OsRng);
+    let ciphertext = backup_cipher(passphrase, &salt)?
+        .encrypt(&nonce, plaintext)
+        .map_err(|_| FFIError::InternalError {
+            msg: "Failed to encrypt backup".to_string(),
+            code: NO_ERROR_CODE,
+        })?;
+
+    let mut blob = Vec::with_capacity(
+        BACKUP_MAGIC.len() + 1 + BACKUP_SALT_LEN + BACKUP_NONCE_LEN + ciphertext.len(),
+    );
+    blob.extend_from_slice(BACKUP_MAGIC);
+    blob.push(BACKUP_VERSION);
+    blob.extend_from_slice(&salt);
+    blob.extend_from_slice(&nonce);
+    blob.extend_from_slice(&ciphertext);
+    Ok(blob)
+}
+
+/// A wrong passphrase and a corrupt blob both fail authentication and are not told apart
+fn decrypt_backup(blob: &[u8], passphrase: &str) -> Result<Vec<u8>> {
+    let invalid = || FFIError::InvalidInput {
+        msg: "Wrong passphrase or corrupt backup".to_string(),
+        code: NO_ERROR_CODE,
+    };
+    let header_len = BACKUP_MAGIC.len() + 1;
+    if blob.len() < header_len + BACKUP_SALT_LEN + BACKUP_NONCE_LEN
+        || !blob.starts_with(BACKUP_MAGIC)
+        || blob[BACKUP_MAGIC.len()] != BACKUP_VERSION
+    {
+        return Err(invalid());
+    }
+
+    let (salt, rest) = blob[header_len..].split_at(BACKUP_SALT_LEN);
+    let (nonce, ciphertext) = rest.split_at(BACKUP_NONCE_LEN);
+    backup_cipher(passphrase, salt)?
+        .decrypt(Nonce::from_slice(nonce), ciphertext)
+        .map_err(|_| invalid())
+}
+
+/// A send's `preferred_denominations` as sorted amounts, InvalidInput unless they are powers of
+/// two adding up to the amount sent
+fn preferred_denominations(amount: Amount, denominations: &[u64]) -> Result<Vec<Amount>> {
+    if denominations.is_empty() {
+        return Ok(Vec::new());
+    }
+    if let Some(denomination) = denominations.iter().find(|d| !d.is_power_of_two()) {
+        return Err(FFIError::InvalidInput {
+            msg: format!("Denomination {} is not a power of two", denomination),
+            code: NO_ERROR_CODE,
+        });
+    }
+    let total = denominations.iter().try_fold(0u64, |total, d| total.checked_add(*d));
+    if total != Some(u64::from(amount)) {
+        return Err(FFIError::InvalidInput {
+            msg: format!("Preferred denominations do not add up to the {} sent", amount),
+            code: NO_ERROR_CODE,
+        });
+    }
+    let mut amounts: Vec<Amount> = denominations.iter().map(|d| Amount::from(*d)).collect();
+    amounts.sort();
+    Ok(amounts)
+}
+
+// Replace tokens, payment requests, auth tokens and long hex strings, which may be secrets or
+// preimages, in an error message kept for diagnostic reports
+fn redact_error(msg: &str) -> String {
+    msg.split(' ')
+        .map(|word| {
+            let core = word.trim_matches(|c: char| !c.is_ascii_alphanumeric());
+            let secret = ["cashuA", "cashuB", "creqA", "authA", "eyJ"]
+                .iter()
+                .any(|prefix| core.starts_with(prefix))
+                || (core.len() >= 64 && core.chars().all(|c| c.is_ascii_hexdigit()));
+            if secret {
+                word.replacen(core, "[redacted]", 1)
+            } else {
+                word.to_string()
+            }
+        })
+        .collect::<Vec<_>>()
+        .join(" ")
+}
+
+/// Amount of a bolt11 invoice in `unit`, InvalidInput for bad or amountless invoices
+fn invoice_amount(request: &str, unit: &CurrencyUnit) -> Result<Amount> {
+    let invoice = Bolt11Invoice::from_str(request).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid bolt11 invoice: {}", e),
+        code: NO_ERROR_CODE,
+    })?;
+    let amount_msat = invoice
+        .amount_milli_satoshis()
+        .ok_or_else(|| FFIError::InvalidInput {
+            msg: "Invoice has no amount".to_string(),
+            code: NO_ERROR_CODE,
+        })?;
+    Amount::from(amount_msat)
+        .convert_unit(&CurrencyUnit::Msat, unit)
+        .map_err(|e| FFIError::InvalidInput {
+            msg: e.to_string(),
+            code: NO_ERROR_CODE,
+        })
+}
+
+/// FeeTooHigh when a melt quote reserves more than `max_fee` for routing
+fn check_max_fee(fee_reserve: Amount, max_fee: Amount) -> Result<()> {
+    if fee_reserve > max_fee {
+        return Err(FFIError::FeeTooHigh {
+            fee_reserve: fee_reserve.into(),
+            max_fee: max_fee.into(),
+            code: NO_ERROR_CODE,
+        });
+    }
+    Ok(())
+}
+
+/// Sort key of a proof in deterministic mode, the same for a seed whatever order the store uses
+fn seeded_rank(seed: u64, secret: &str) -> Sha256Hash {
+    let mut data = seed.to_be_bytes().to_vec();
+    data.extend_from_slice(secret.as_bytes());
+    Sha256Hash::hash(&data)
+}
+
+/// HTTP clients that reach the mint, and any other host such as a NUT-18 payment target,
+/// through a SOCKS5 or HTTP proxy, such as a local Tor daemon
+fn proxy_clients(mint_url: MintUrl, proxy_url: &str) -> Result<(HttpClient, reqwest::Client)> {
+    let proxy = url::Url::parse(proxy_url).map_err(|e| FFIError::InvalidInput {
+        msg: format!("Invalid proxy URL: {}", e),
+        code: NO_ERROR_CODE,
     })?;
+    let default_port = match proxy.scheme() {
+        "socks5" | "socks5h" => 1080,
+        "http" => 80,
+        scheme => {
+            return Err(FFIError::InvalidInput {
+                msg: format!("Proxy URL must use socks5, socks5h or http, got {}", scheme),
+                code: NO_ERROR_CODE,
+            })
+        }
+    };
+
+    let addrs = proxy
+        .socket_addrs(|| Some(default_port))
+        .map_err(|e| FFIError::NetworkError {
+            msg: format!("Failed to resolve proxy {}: {}", proxy_url, e),
+            code: NO_ERROR_CODE,
+        })?;
+    let timeout = Duration::from_secs(PROXY_CONNECT_TIMEOUT_SECS);
+    if !addrs.iter().any(|addr| TcpStream::connect_timeout(addr, timeout).is_ok()) {
+        return Err(FFIError::NetworkError {
+            msg: format!("Proxy {} is unreachable", proxy_url),
+            code: NO_ERROR_CODE,
+        });
+    }
 
+    let http = reqwest::Proxy::all(proxy.as_str())
+        .and_then(|proxy| reqwest::Client::builder().proxy(proxy).build())
+        .map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid proxy URL: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+    Ok((HttpClient::with_proxy(mint_url, proxy, None, false)?, http))
+}
+
+/// Accept either a 64-byte BIP39 seed or 16 to 32 bytes of mnemonic entropy
+fn seed_from_bytes(bytes: &[u8]) -> Result<[u8; 64]> {
+    if let Ok(seed) = <[u8; 64]>::try_from(bytes) {
+        return Ok(seed);
+    }
+    let mnemonic = Mnemonic::from_entropy(bytes).map_err(|_| FFIError::InvalidInput {
+        msg: format!(
+            "Seed must be 64 bytes or 16 to 32 bytes of entropy, got {} bytes",
+            bytes.len()
+        ),
+        code: NO_ERROR_CODE,
+    })?;
     Ok(mnemonic.to_seed_normalized(""))
 }
 
 // Error handling
 #[derive(Debug, thiserror::Error, uniffi::Error)]
 pub enum FFIError {
+    // Every variant's `code` is the NUT-00 error code of the mint or CDK error behind it, such
+    // as 11001 for spent proofs, 0 when there is none
     #[error("Wallet error: {msg}")]
-    WalletError { msg: String },
+    WalletError { msg: String, code: u32 },
 
     #[error("Invalid input: {msg}")]
-    InvalidInput { msg: String },
+    InvalidInput { msg: String, code: u32 },
 
     #[error("Network error: {msg}")]
-    NetworkError { msg: String },
+    NetworkError { msg: String, code: u32 },
 
     #[error("Internal error: {msg}")]
-    InternalError { msg: String },
+    InternalError { msg: String, code: u32 },
+
+    #[error("Operation disabled: {msg}")]
+    OperationDisabled { msg: String, code: u32 },
+
+    #[error("Timeout: {msg}")]
+    Timeout { msg: String, code: u32 },
+
+    #[error("Insufficient funds: {} available, {} required", .available.value, .required.value)]
+    InsufficientFunds {
+        available: FFIAmount,
+        required: FFIAmount,
+        code: u32,
+    },
+
+    #[error("Transfer failed at the {leg:?} leg: {msg}")]
+    TransferFailed {
+        leg: FFITransferLeg,
+        msg: String,
+        code: u32,
+    },
+
+    #[error("Fee reserve {} exceeds the maximum fee {}", .fee_reserve.value, .max_fee.value)]
+    FeeTooHigh {
+        fee_reserve: FFIAmount,
+        max_fee: FFIAmount,
+        code: u32,
+    },
+
+    #[error(
+        "Cannot send {} offline, the closest amount stored proofs add up to is {}",
+        .requested.value,
+        .nearest.value
+    )]
+    OfflineSendImpossible {
+        requested: FFIAmount,
+        nearest: FFIAmount,
+        code: u32,
+    },
+
+    #[error("DLEQ verification failed: {msg}")]
+    DleqVerificationFailed { msg: String, code: u32 },
+}
+
+impl FFIError {
+    /// NUT-00 error code carried by any variant, 0 when there is none
+    fn code(&self) -> u32 {
+        match self {
+            FFIError::WalletError { code, .. }
+            | FFIError::InvalidInput { code, .. }
+            | FFIError::NetworkError { code, .. }
+            | FFIError::InternalError { code, .. }
+            | FFIError::OperationDisabled { code, .. }
+            | FFIError::Timeout { code, .. }
+            | FFIError::InsufficientFunds { code, .. }
+            | FFIError::TransferFailed { code, .. }
+            | FFIError::FeeTooHigh { code, .. }
+            | FFIError::OfflineSendImpossible { code, .. }
+            | FFIError::DleqVerificationFailed { code, .. } => *code,
+        }
+    }
 }
 
 impl From<cdk::error::Error> for FFIError {
     fn from(err: cdk::error::Error) -> Self {
         FFIError::WalletError {
             msg: err.to_string(),
+            code: nut_error_code(err),
         }
     }
 }
 
+/// NUT-00 error code of a CDK error as a mint would report it, 0 for errors without one
+fn nut_error_code(err: cdk::error::Error) -> u32 {
+    match ErrorResponse::from(err).code {
+        ErrorCode::Unknown(_) => NO_ERROR_CODE,
+        code => code.to_code().into(),
+    }
+}
+
 impl From<cdk_common::database::Error> for FFIError {
     fn from(err: cdk_common::database::Error) -> Self {
         FFIError::WalletError {
             msg: err.to_string(),
+            code: NO_ERROR_CODE,
         }
     }
 }
@@ -70,6 +687,7 @@ impl From<cdk::nuts::nut00::Error> for FFIError {
     fn from(err: cdk::nuts::nut00::Error) -> Self {
         FFIError::WalletError {
             msg: err.to_string(),
+            code: NO_ERROR_CODE,
         }
     }
 }
@@ -78,12 +696,80 @@ impl From<cdk_sqlite::wallet::error::Error> for FFIError {
     fn from(err: cdk_sqlite::wallet::error::Error) -> Self {
         FFIError::WalletError {
             msg: err.to_string(),
+            code: NO_ERROR_CODE,
+        }
+    }
+}
+
+impl From<rusqlite::Error> for FFIError {
+    fn from(err: rusqlite::Error) -> Self {
+        FFIError::WalletError {
+            msg: err.to_string(),
+            code: NO_ERROR_CODE,
         }
     }
 }
 
 type Result<T> = std::result::Result<T, FFIError>;
 
+// `FFIError` code of errors NUT-00 has no code for
+const NO_ERROR_CODE: u32 = 0;
+
+// NUT-00 code for proofs that are already spent
+const ERROR_CODE_TOKEN_ALREADY_SPENT: u32 = 11001;
+
+// NUT-00 code of a mint that has minting disabled
+const ERROR_CODE_MINTING_DISABLED: u32 = 20003;
+
+// Key of the `set_fee_reserve_percent` rate in the wallet settings table
+const FEE_RESERVE_PERCENT_SETTING: &str = "fee_reserve_percent";
+
+// Number of error messages kept per wallet for diagnostic reports
+const MAX_RECENT_ERRORS: usize = 10;
+
+// Version of the cdk crate this library is built against, read from Cargo.lock by build.rs
+const CDK_VERSION: &str = env!("CDK_VERSION");
+
+// Number of deterministic secrets requested from the mint per restore batch
+const RESTORE_BATCH_SIZE: u32 = 100;
+
+// Consecutive empty batches after which a keyset is considered fully restored
+const RESTORE_EMPTY_BATCHES: u32 = 3;
+
+// Lightning fee reserve a CDK mint asks for by default, used when no melt quote
+// from this store can show the mint's actual rate
+const DEFAULT_FEE_RESERVE_PERCENT: u64 = 2;
+const DEFAULT_FEE_RESERVE_MIN: u64 = 2;
+
+// How long a transfer waits for the destination mint to see the invoice paid
+const TRANSFER_MINT_TIMEOUT_SECS: u64 = 60;
+
+// How long delivering a payment to a payment request's HTTP endpoint may take
+const PAYMENT_POST_TIMEOUT_SECS: u64 = 30;
+
+// How long proofs reserved by `prepare_send` wait for `confirm_send` before they are released
+const PREPARED_SEND_TTL_SECS: u64 = 600;
+
+// How long `ping_mint` and `update_mint_url` wait for a mint's info
+const PING_TIMEOUT_SECS: u64 = 10;
+
+// How long a wallet constructor waits to reach the proxy it is configured with
+const PROXY_CONNECT_TIMEOUT_SECS: u64 = 10;
+
+// Longest wait between two retries of a mint request, whatever the retry policy
+const RETRY_MAX_DELAY_MS: u64 = 30_000;
+
+// Derivation path of the wallet's P2PK receiving key. No NUT defines one, so this path is
+// specific to this library: other Cashu wallets restored from the same mnemonic do not derive
+// this key and cannot spend tokens locked to it
+const P2PK_KEY_PATH: &str = "m/129372'/10'/0'/0'/0'";
+
+// Layout of a proof backup: magic, format version, KDF salt, AEAD nonce, encrypted token
+const BACKUP_MAGIC: &[u8; 4] = b"cdkb";
+const BACKUP_VERSION: u8 = 1;
+const BACKUP_SALT_LEN: usize = 16;
+const BACKUP_NONCE_LEN: usize = 12;
+
 // Helper to create a tokio runtime
 fn runtime() -> Runtime {
     Runtime::new().expect("Failed to create tokio runtime")
@@ -91,7 +777,7 @@ fn runtime() -> Runtime {
 
 // Records (pass by value) - simple data structures
 
-#[derive(uniffi::Record)]
+#[derive(Debug, uniffi::Record)]
 pub struct FFIAmount {
     pub value: u64,
 }
@@ -121,6 +807,23 @@ pub struct FFIMintQuote {
     pub expiry: u64,
 }
 
+#[derive(uniffi::Record)]
+pub struct FFIMintAndWaitResult {
+    // The quote as issued, its invoice is the one that was paid
+    pub quote: FFIMintQuote,
+    pub amount: FFIAmount,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIMintQuoteOptions {
+    pub description: Option<String>,
+    // Unix time in seconds after which the wallet treats the quote as expired, only
+    // applied when it is earlier than the expiry the mint set
+    pub expiry_secs: Option<u64>,
+    // Quotes are always single use (NUT-04), asking for a reusable one is rejected
+    pub single_use: Option<bool>,
+}
+
 impl From<MintQuote> for FFIMintQuote {
     fn from(quote: MintQuote) -> Self {
         Self {
@@ -163,10 +866,16 @@ pub struct FFIMeltQuote {
     pub fee_reserve: FFIAmount,
     pub expiry: u64,
     pub payment_preimage: Option<String>,
+    // Hex payment hash of the bolt11 request, empty if the request is not a bolt11 invoice
+    pub payment_hash: String,
 }
 
 impl From<MeltQuote> for FFIMeltQuote {
     fn from(quote: MeltQuote) -> Self {
+        let payment_hash = Bolt11Invoice::from_str(&quote.request)
+            .map(|invoice| invoice.payment_hash().to_string())
+            .unwrap_or_default();
+
         Self {
             id: quote.id,
             unit: quote.unit.to_string(),
@@ -175,16 +884,27 @@ impl From<MeltQuote> for FFIMeltQuote {
             fee_reserve: quote.fee_reserve.into(),
             expiry: quote.expiry,
             payment_preimage: quote.payment_preimage,
+            payment_hash,
         }
     }
 }
 
+#[derive(uniffi::Record)]
+pub struct FFIMintResult {
+    pub total: FFIAmount,
+    // Denomination of every minted proof, in the order the mint signed them
+    pub amounts: Vec<FFIAmount>,
+}
+
 #[derive(uniffi::Record)]
 pub struct FFIMelted {
     pub state: String,
     pub preimage: Option<String>,
     pub amount: FFIAmount,
+    // Lightning fee actually spent, the returned change is already taken off
     pub fee_paid: FFIAmount,
+    // Unused fee reserve the mint returned as new proofs (NUT-08)
+    pub change: FFIAmount,
 }
 
 impl From<Melted> for FFIMelted {
@@ -194,6 +914,11 @@ impl From<Melted> for FFIMelted {
             preimage: melted.preimage,
             amount: melted.amount.into(),
             fee_paid: melted.fee_paid.into(),
+            change: melted
+                .change
+                .and_then(|change| change.total_amount().ok())
+                .unwrap_or(Amount::ZERO)
+                .into(),
         }
     }
 }
@@ -204,6 +929,9 @@ pub struct FFIToken {
     pub mint: String,
     pub memo: Option<String>,
     pub unit: String,
+    pub amount: FFIAmount,
+    // Spending condition locking the proofs, so a receiver can check it before redeeming
+    pub conditions: FFITokenConditions,
 }
 
 impl TryFrom<cdk::nuts::Token> for FFIToken {
@@ -212,16 +940,67 @@ impl TryFrom<cdk::nuts::Token> for FFIToken {
     fn try_from(token: cdk::nuts::Token) -> Result<Self> {
         let mint_url = token
             .mint_url()
-            .map_err(|e| FFIError::WalletError { msg: e.to_string() })?
+            .map_err(|e| FFIError::WalletError {
+                msg: e.to_string(),
+                code: NO_ERROR_CODE,
+            })?
             .to_string();
 
         let token_str = token.to_string();
+        let amount = token.value()?;
 
         Ok(Self {
             token_string: token_str,
             mint: mint_url,
             memo: token.memo().clone(),
             unit: token.unit().map(|u| u.to_string()).unwrap_or_default(),
+            amount: amount.into(),
+            conditions: FFITokenConditions::try_from(&token)?,
+        })
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFITokenConditions {
+    pub spending_condition: FFISpendingCondition,
+    // Hex pubkey a P2PK token is locked to
+    pub pubkey: Option<String>,
+    // Hex SHA-256 hash whose preimage unlocks an HTLC token
+    pub hash: Option<String>,
+    // Unix time after which the refund keys, or anyone if there are none, can spend the proofs
+    pub locktime: Option<u64>,
+}
+
+impl TryFrom<&cdk::nuts::Token> for FFITokenConditions {
+    type Error = FFIError;
+
+    /// When proofs carry different conditions, a P2PK one is reported before an HTLC one
+    fn try_from(token: &cdk::nuts::Token) -> Result<Self> {
+        let conditions = token.spending_conditions()?;
+        let p2pk = conditions
+            .iter()
+            .find(|c| matches!(c, SpendingConditions::P2PKConditions { .. }));
+        let condition = p2pk.or_else(|| conditions.iter().next());
+
+        Ok(match condition {
+            Some(SpendingConditions::P2PKConditions { data, conditions }) => Self {
+                spending_condition: FFISpendingCondition::P2pk,
+                pubkey: Some(data.to_hex()),
+                hash: None,
+                locktime: conditions.as_ref().and_then(|c| c.locktime),
+            },
+            Some(SpendingConditions::HTLCConditions { data, conditions }) => Self {
+                spending_condition: FFISpendingCondition::Htlc,
+                pubkey: None,
+                hash: Some(data.to_string()),
+                locktime: conditions.as_ref().and_then(|c| c.locktime),
+            },
+            None => Self {
+                spending_condition: FFISpendingCondition::None,
+                pubkey: None,
+                hash: None,
+                locktime: None,
+            },
         })
     }
 }
@@ -234,19 +1013,101 @@ pub struct FFISendOptions {
     pub include_fee: bool,
     pub metadata: HashMap<String, String>,
     pub max_proofs: Option<u64>,
+    pub proof_selection: FFIProofSelection,
+    // Lock the token to this hex public key (NUT-11 P2PK)
+    pub pubkey: Option<String>,
+    // Seconds from now after which `refund_pubkey` can also spend a locked token
+    pub locktime_secs: Option<u64>,
+    pub refund_pubkey: Option<String>,
+    pub token_version: FFITokenVersion,
+    // Only compute the fees `prepare_send` would report, without reserving proofs
+    pub dry_run: bool,
+    // Lock the token to the preimage of this hex SHA-256 hash (NUT-14 HTLC)
+    pub htlc_hash: Option<String>,
+    // Unix time after which `refund_pubkey` can also spend an HTLC-locked token
+    pub htlc_locktime: Option<u64>,
+    // Denominations the token is made of, e.g. for a vending machine expecting certain coins
+    // Powers of two adding up to the amount sent, overriding `amount_split_target`. Empty
+    // leaves the split to `amount_split_target`
+    pub preferred_denominations: Vec<u64>,
 }
 
-impl From<FFISendOptions> for SendOptions {
-    fn from(options: FFISendOptions) -> Self {
-        Self {
+impl TryFrom<FFISendOptions> for SendOptions {
+    type Error = FFIError;
+
+    fn try_from(options: FFISendOptions) -> Result<Self> {
+        let invalid = |msg: &str| FFIError::InvalidInput {
+            msg: msg.to_string(),
+            code: NO_ERROR_CODE,
+        };
+        if options.htlc_hash.is_none() && options.htlc_locktime.is_some() {
+            return Err(invalid("HTLC locktime requires an HTLC hash"));
+        }
+        if options.pubkey.is_none() && options.locktime_secs.is_some() {
+            return Err(invalid("Locktime requires a P2PK pubkey, HTLCs use htlc_locktime"));
+        }
+        let refund_keys = options
+            .refund_pubkey
+            .map(|refund_pubkey| parse_pubkey(&refund_pubkey).map(|key| vec![key]))
+            .transpose()?;
+
+        let conditions = match (options.pubkey, options.htlc_hash) {
+            (Some(_), Some(_)) => {
+                return Err(invalid("A token is locked to a pubkey or to a hash, not both"))
+            }
+            (Some(pubkey), None) => {
+                let locktime = options.locktime_secs.map(|secs| unix_time() + secs);
+                let conditions =
+                    Conditions::new(locktime, None, refund_keys, None, None, None).map_err(|e| {
+                        FFIError::InvalidInput {
+                            msg: format!("Invalid P2PK conditions: {}", e),
+                            code: NO_ERROR_CODE,
+                        }
+                    })?;
+                Some(SpendingConditions::new_p2pk(
+                    parse_pubkey(&pubkey)?,
+                    Some(conditions),
+                ))
+            }
+            (None, Some(hash)) => {
+                let hash = Sha256Hash::from_str(&hash).map_err(|e| FFIError::InvalidInput {
+                    msg: format!("Invalid HTLC hash: {}", e),
+                    code: NO_ERROR_CODE,
+                })?;
+                let conditions =
+                    Conditions::new(options.htlc_locktime, None, refund_keys, None, None, None)
+                        .map_err(|e| FFIError::InvalidInput {
+                            msg: format!("Invalid HTLC conditions: {}", e),
+                            code: NO_ERROR_CODE,
+                        })?;
+                Some(SpendingConditions::HTLCConditions {
+                    data: hash,
+                    conditions: Some(conditions),
+                })
+            }
+            (None, None) if refund_keys.is_some() => {
+                return Err(invalid("Refund key requires a P2PK pubkey or an HTLC hash"))
+            }
+            (None, None) => None,
+        };
+
+        let amount_split_target = if options.preferred_denominations.is_empty() {
+            options.amount_split_target.into()
+        } else {
+            SplitTarget::Values(
+                options.preferred_denominations.iter().map(|d| Amount::from(*d)).collect(),
+            )
+        };
+
+        Ok(Self {
             memo: options.memo.map(|m| m.into()),
-            conditions: None, // TODO: Add support for spending conditions
-            amount_split_target: options.amount_split_target.into(),
+            conditions,
+            amount_split_target,
             send_kind: options.send_kind.into(),
             include_fee: options.include_fee,
             metadata: options.metadata,
             max_proofs: options.max_proofs.map(|p| p as usize),
-        }
+        })
     }
 }
 
@@ -271,336 +1132,4453 @@ pub struct FFIPreparedSend {
     pub swap_fee: FFIAmount,
     pub send_fee: FFIAmount,
     pub total_fee: FFIAmount,
+    pub proof_count: u32,
+    pub requires_swap: bool,
+    // NUT-02 fee for spending every selected proof, already part of the swap and send fees
+    pub input_fee: FFIAmount,
+    // Identifies the reserved proofs for `confirm_send`, empty for a dry run
+    pub reservation_id: String,
 }
 
-impl From<PreparedSend> for FFIPreparedSend {
-    fn from(send: PreparedSend) -> Self {
+impl FFIPreparedSend {
+    fn new(send: &PendingSend, input_fee: Amount, reservation_id: String) -> Self {
+        let (amount, swap_fee, send_fee, proof_count, requires_swap) = match send {
+            PendingSend::Cdk(send) => (
+                send.amount(),
+                send.swap_fee(),
+                send.send_fee(),
+                send.proofs_to_swap().len() + send.proofs_to_send().len(),
+                !send.proofs_to_swap().is_empty(),
+            ),
+            PendingSend::Selected(send) => (
+                send.amount,
+                send.swap_fee,
+                send.send_fee,
+                send.proofs.len(),
+                send.requires_swap,
+            ),
+        };
         Self {
-            amount: send.amount().into(),
-            swap_fee: send.swap_fee().into(),
-            send_fee: send.send_fee().into(),
-            total_fee: send.fee().into(),
+            amount: amount.into(),
+            swap_fee: swap_fee.into(),
+            send_fee: send_fee.into(),
+            total_fee: (swap_fee + send_fee).into(),
+            proof_count: proof_count as u32,
+            requires_swap,
+            input_fee: input_fee.into(),
+            reservation_id,
         }
     }
 }
 
-// Enums
+#[derive(uniffi::Record)]
+pub struct FFIReceiveBatchResult {
+    // Total received from the tokens not listed in `failed`
+    pub amount: FFIAmount,
+    pub failed: Vec<FFIReceiveError>,
+}
 
-#[derive(uniffi::Enum)]
-pub enum FFIMintQuoteState {
-    Unpaid,
-    Paid,
-    Issued,
+#[derive(uniffi::Record)]
+pub struct FFIReceiveError {
+    // Position of the token in the batch
+    pub index: u32,
+    pub error: String,
 }
 
-impl From<MintQuoteState> for FFIMintQuoteState {
-    fn from(state: MintQuoteState) -> Self {
-        match state {
-            MintQuoteState::Unpaid => Self::Unpaid,
-            MintQuoteState::Paid => Self::Paid,
-            MintQuoteState::Issued => Self::Issued,
-            _ => Self::Unpaid, // Handle any other states
-        }
-    }
+#[derive(uniffi::Record)]
+pub struct FFIMeltBatchEntry {
+    // Position of the invoice in the batch
+    pub index: u32,
+    // Set once the invoice is paid
+    pub melted: Option<FFIMelted>,
+    // Why the invoice was not paid, none when it was
+    pub error: Option<String>,
 }
 
-#[derive(uniffi::Enum)]
-pub enum FFISplitTarget {
-    None,
-    Default,
+#[derive(uniffi::Record)]
+pub struct FFIReceiveResult {
+    pub amount: FFIAmount,
+    // Memo the sender attached to the token
+    pub memo: Option<String>,
+    pub unit: String,
+    // Hex pubkey the proofs were locked to (P2PK), none for unlocked tokens
+    pub locking_pubkey: Option<String>,
 }
 
-impl From<FFISplitTarget> for SplitTarget {
-    fn from(target: FFISplitTarget) -> Self {
-        match target {
-            FFISplitTarget::None => SplitTarget::None,
-            FFISplitTarget::Default => SplitTarget::default(),
-        }
+impl FFIReceiveResult {
+    fn new(token: &Token, amount: Amount) -> Result<Self> {
+        let locking_pubkey = token.spending_conditions()?.into_iter().find_map(|conditions| {
+            match conditions {
+                SpendingConditions::P2PKConditions { data, .. } => Some(data.to_hex()),
+                _ => None,
+            }
+        });
+        Ok(Self {
+            amount: amount.into(),
+            memo: token.memo().clone(),
+            unit: token.unit().map(|u| u.to_string()).unwrap_or_default(),
+            locking_pubkey,
+        })
     }
 }
 
-#[derive(uniffi::Enum)]
-pub enum FFISendKind {
-    OnlineExact,
-    OnlineTolerance { tolerance: FFIAmount },
-    OfflineExact,
-    OfflineTolerance { tolerance: FFIAmount },
+#[derive(Clone, uniffi::Record)]
+pub struct FFIReceiveOptions {
+    pub amount_split_target: FFISplitTarget,
+    pub idempotent: bool,
+    pub trust_unswapped: bool,
+    // Hex secret keys used to sign P2PK-locked proofs
+    pub p2pk_signing_keys: Vec<String>,
+    // Hex preimages that unlock HTLC-locked proofs
+    pub preimages: Vec<String>,
+    // Reject tokens whose proofs do not all carry a valid DLEQ proof (NUT-12)
+    pub require_dleq: bool,
 }
 
-impl From<FFISendKind> for SendKind {
-    fn from(kind: FFISendKind) -> Self {
-        match kind {
-            FFISendKind::OnlineExact => SendKind::OnlineExact,
-            FFISendKind::OnlineTolerance { tolerance } => {
-                SendKind::OnlineTolerance(tolerance.into())
-            }
-            FFISendKind::OfflineExact => SendKind::OfflineExact,
+impl TryFrom<FFIReceiveOptions> for ReceiveOptions {
+    type Error = FFIError;
+
+    fn try_from(options: FFIReceiveOptions) -> Result<Self> {
+        let p2pk_signing_keys = options
+            .p2pk_signing_keys
+            .iter()
+            .map(|key| {
+                SecretKey::from_hex(key).map_err(|e| FFIError::InvalidInput {
+                    msg: format!("Invalid signing key: {}", e),
+                    code: NO_ERROR_CODE,
+                })
+            })
+            .collect::<Result<Vec<_>>>()?;
+
+        Ok(Self {
+            amount_split_target: options.amount_split_target.into(),
+            p2pk_signing_keys,
+            preimages: options.preimages,
+            ..Default::default()
+        })
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIQuoteExpiry {
+    pub quote_id: String,
+    pub kind: FFIQuoteKind,
+    pub expires_in: u64,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIOperationAvailability {
+    pub mint: bool,
+    pub melt: bool,
+    pub swap: bool,
+}
+
+impl From<&MintInfo> for FFIOperationAvailability {
+    fn from(info: &MintInfo) -> Self {
+        Self {
+            mint: !info.nuts.nut04.disabled,
+            melt: !info.nuts.nut05.disabled,
+            // NUT-03 swaps cannot be disabled by a mint
+            swap: true,
+        }
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIContact {
+    pub method: String,
+    pub info: String,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIMintInfo {
+    pub name: Option<String>,
+    pub pubkey: Option<String>,
+    pub version: Option<String>,
+    pub description: Option<String>,
+    pub contact_methods: Vec<FFIContact>,
+    // Optional NUTs the mint advertises as supported, the mandatory NUT-00 to 03 and 06 are implied
+    pub nuts: Vec<u16>,
+}
+
+impl From<MintInfo> for FFIMintInfo {
+    fn from(info: MintInfo) -> Self {
+        let n = &info.nuts;
+        let nuts = [
+            (4, !n.nut04.disabled),
+            (5, !n.nut05.disabled),
+            (7, n.nut07.supported),
+            (8, n.nut08.supported),
+            (9, n.nut09.supported),
+            (10, n.nut10.supported),
+            (11, n.nut11.supported),
+            (12, n.nut12.supported),
+            (14, n.nut14.supported),
+            (15, !n.nut15.methods.is_empty()),
+            (17, !n.nut17.supported.is_empty()),
+            (19, !n.nut19.cached_endpoints.is_empty()),
+            (20, n.nut20.supported),
+        ]
+        .into_iter()
+        .filter(|(_, supported)| *supported)
+        .map(|(nut, _)| nut)
+        .collect();
+
+        Self {
+            name: info.name,
+            pubkey: info.pubkey.map(|pubkey| pubkey.to_string()),
+            version: info.version.map(|version| version.to_string()),
+            description: info.description,
+            contact_methods: info
+                .contact
+                .unwrap_or_default()
+                .into_iter()
+                .map(|contact| FFIContact {
+                    method: contact.method,
+                    info: contact.info,
+                })
+                .collect(),
+            nuts,
+        }
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIKeysetInfo {
+    pub id: String,
+    pub unit: String,
+    pub active: bool,
+    pub input_fee_ppk: u64,
+}
+
+impl From<KeySetInfo> for FFIKeysetInfo {
+    fn from(keyset: KeySetInfo) -> Self {
+        Self {
+            id: keyset.id.to_string(),
+            unit: keyset.unit.to_string(),
+            active: keyset.active,
+            input_fee_ppk: keyset.input_fee_ppk,
+        }
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIBalanceSnapshot {
+    // Sum of the three figures below
+    pub total: FFIAmount,
+    // Unspent proofs, the same figure as `balance`
+    pub spendable: FFIAmount,
+    // Proofs in flight, e.g. inputs of a melt the mint has not settled
+    pub pending: FFIAmount,
+    // Proofs held by a prepared send that is not redeemed or reclaimed yet
+    pub reserved: FFIAmount,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIKeysetRefreshResult {
+    // Keysets the mint announced that this store had not seen before
+    pub new_keysets: u32,
+    // Proofs swapped from inactive keysets into the active one
+    pub proofs_migrated: u32,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIVacuumResult {
+    pub proofs_removed: u64,
+    pub quotes_removed: u64,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIReconcileResult {
+    pub balance_before: FFIAmount,
+    pub balance_after: FFIAmount,
+    // Proofs the mint reported spent, deleted from the store
+    pub proofs_removed: u64,
+    // Pending proofs the mint reported unspent, e.g. of a melt interrupted before it reached
+    // the mint, made spendable again
+    pub proofs_restored: u64,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFINetFlow {
+    pub total_in: FFIAmount,
+    pub total_out: FFIAmount,
+    pub total_fees: FFIAmount,
+    pub net: i64,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIDiagnostics {
+    pub mint_url: String,
+    pub unit: String,
+    pub balance: FFIAmount,
+    pub proof_count: u64,
+    pub keyset_ids: Vec<String>,
+    pub library_version: String,
+    pub cdk_version: String,
+    pub recent_errors: Vec<String>,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIProofState {
+    pub y: String,
+    pub state: FFIState,
+    pub witness: Option<String>,
+}
+
+impl From<ProofState> for FFIProofState {
+    fn from(proof_state: ProofState) -> Self {
+        Self {
+            y: proof_state.y.to_hex(),
+            state: proof_state.state.into(),
+            witness: proof_state
+                .witness
+                .and_then(|witness| serde_json::to_string(&witness).ok()),
+        }
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIProof {
+    pub amount: FFIAmount,
+    pub keyset_id: String,
+    pub secret: String,
+    // Hex encoded unblinded signature point
+    pub c: String,
+    pub state: String,
+}
+
+impl From<ProofInfo> for FFIProof {
+    fn from(proof_info: ProofInfo) -> Self {
+        Self {
+            amount: proof_info.proof.amount.into(),
+            keyset_id: proof_info.proof.keyset_id.to_string(),
+            secret: proof_info.proof.secret.to_string(),
+            c: proof_info.proof.c.to_hex(),
+            state: proof_info.state.to_string(),
+        }
+    }
+}
+
+impl TryFrom<FFIProof> for Proof {
+    type Error = FFIError;
+
+    fn try_from(proof: FFIProof) -> Result<Self> {
+        let invalid = |field: &str, e: String| FFIError::InvalidInput {
+            msg: format!("Invalid proof {}: {}", field, e),
+            code: NO_ERROR_CODE,
+        };
+        Ok(Proof::new(
+            proof.amount.into(),
+            Id::from_str(&proof.keyset_id).map_err(|e| invalid("keyset id", e.to_string()))?,
+            Secret::from_str(&proof.secret).map_err(|e| invalid("secret", e.to_string()))?,
+            PublicKey::from_hex(&proof.c).map_err(|e| invalid("signature", e.to_string()))?,
+        ))
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFITransaction {
+    pub id: String,
+    pub direction: FFITransactionDirection,
+    pub amount: FFIAmount,
+    pub unit: String,
+    pub timestamp: u64,
+    pub memo: Option<String>,
+    pub mint_url: String,
+}
+
+impl From<Transaction> for FFITransaction {
+    fn from(transaction: Transaction) -> Self {
+        Self {
+            id: transaction.id().to_string(),
+            direction: transaction.direction.into(),
+            amount: transaction.amount.into(),
+            unit: transaction.unit.to_string(),
+            timestamp: transaction.timestamp,
+            memo: transaction.memo,
+            mint_url: transaction.mint_url.to_string(),
+        }
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFITransactionFilter {
+    pub direction: Option<FFITransactionDirection>,
+    pub unit: Option<String>,
+}
+
+#[derive(uniffi::Record)]
+pub struct FFIPaymentRequest {
+    pub payment_id: Option<String>,
+    // Unset lets the payer choose the amount
+    pub amount: Option<FFIAmount>,
+    pub unit: Option<String>,
+    pub single_use: Option<bool>,
+    // Mints the payee accepts tokens from, empty for any mint
+    pub mints: Vec<String>,
+    pub description: Option<String>,
+    // Where to deliver the token, empty when the payer hands it over directly
+    pub transports: Vec<FFITransport>,
+}
+
+impl From<PaymentRequest> for FFIPaymentRequest {
+    fn from(request: PaymentRequest) -> Self {
+        Self {
+            payment_id: request.payment_id,
+            amount: request.amount.map(Into::into),
+            unit: request.unit.map(|unit| unit.to_string()),
+            single_use: request.single_use,
+            mints: request
+                .mints
+                .unwrap_or_default()
+                .iter()
+                .map(|mint| mint.to_string())
+                .collect(),
+            description: request.description,
+            transports: request.transports.into_iter().map(Into::into).collect(),
+        }
+    }
+}
+
+impl TryFrom<FFIPaymentRequest> for PaymentRequest {
+    type Error = FFIError;
+
+    fn try_from(request: FFIPaymentRequest) -> Result<Self> {
+        let unit = match request.unit {
+            Some(unit) => Some(FFICurrencyUnit::try_from(unit)?.into()),
+            None => None,
+        };
+        let mints = request
+            .mints
+            .iter()
+            .map(|mint| {
+                MintUrl::from_str(mint).map_err(|e| FFIError::InvalidInput {
+                    msg: format!("Invalid mint URL: {}", e),
+                    code: NO_ERROR_CODE,
+                })
+            })
+            .collect::<Result<Vec<_>>>()?;
+
+        Ok(Self {
+            payment_id: request.payment_id,
+            amount: request.amount.map(Into::into),
+            unit,
+            single_use: request.single_use,
+            mints: (!mints.is_empty()).then_some(mints),
+            description: request.description,
+            transports: request.transports.into_iter().map(Into::into).collect(),
+            nut10: None,
+        })
+    }
+}
+
+#[derive(uniffi::Record)]
+pub struct FFITransport {
+    pub transport_type: FFITransportType,
+    // nprofile for Nostr, URL for HTTP POST
+    pub target: String,
+    pub tags: Vec<Vec<String>>,
+}
+
+impl From<Transport> for FFITransport {
+    fn from(transport: Transport) -> Self {
+        Self {
+            transport_type: transport._type.into(),
+            target: transport.target,
+            tags: transport.tags.unwrap_or_default(),
+        }
+    }
+}
+
+impl From<FFITransport> for Transport {
+    fn from(transport: FFITransport) -> Self {
+        Self {
+            _type: transport.transport_type.into(),
+            target: transport.target,
+            tags: (!transport.tags.is_empty()).then_some(transport.tags),
+        }
+    }
+}
+
+// Enums
+
+#[derive(uniffi::Enum)]
+pub enum FFIMintQuoteState {
+    Unpaid,
+    Paid,
+    Issued,
+}
+
+impl From<MintQuoteState> for FFIMintQuoteState {
+    fn from(state: MintQuoteState) -> Self {
+        match state {
+            MintQuoteState::Unpaid => Self::Unpaid,
+            MintQuoteState::Paid => Self::Paid,
+            MintQuoteState::Issued => Self::Issued,
+            _ => Self::Unpaid, // Handle any other states
+        }
+    }
+}
+
+#[derive(uniffi::Enum)]
+pub enum FFISplitTarget {
+    None,
+    Default,
+}
+
+impl From<FFISplitTarget> for SplitTarget {
+    fn from(target: FFISplitTarget) -> Self {
+        match target {
+            FFISplitTarget::None => SplitTarget::None,
+            FFISplitTarget::Default => SplitTarget::default(),
+        }
+    }
+}
+
+/// Order in which stored proofs are considered when selecting proofs to send
+#[derive(Clone, Copy, uniffi::Enum)]
+pub enum FFIProofSelection {
+    LargestFirst,
+    SmallestFirst,
+    /// CDK's default selection, which prefers proofs that need no change
+    MinimizeChange,
+}
+
+/// Token serialization format produced by a send
+#[derive(Clone, Copy, uniffi::Enum)]
+pub enum FFITokenVersion {
+    /// Legacy JSON "cashuA" tokens, still the only format some wallets read
+    V3,
+    /// CBOR "cashuB" tokens
+    V4,
+}
+
+#[derive(uniffi::Enum)]
+pub enum FFISendKind {
+    OnlineExact,
+    OnlineTolerance { tolerance: FFIAmount },
+    OfflineExact,
+    OfflineTolerance { tolerance: FFIAmount },
+}
+
+impl From<FFISendKind> for SendKind {
+    fn from(kind: FFISendKind) -> Self {
+        match kind {
+            FFISendKind::OnlineExact => SendKind::OnlineExact,
+            FFISendKind::OnlineTolerance { tolerance } => {
+                SendKind::OnlineTolerance(tolerance.into())
+            }
+            FFISendKind::OfflineExact => SendKind::OfflineExact,
             FFISendKind::OfflineTolerance { tolerance } => {
                 SendKind::OfflineTolerance(tolerance.into())
             }
         }
     }
-}
+}
+
+#[derive(uniffi::Enum)]
+pub enum FFIState {
+    Unspent,
+    Pending,
+    Spent,
+}
+
+impl From<State> for FFIState {
+    fn from(state: State) -> Self {
+        match state {
+            State::Unspent => Self::Unspent,
+            State::Spent => Self::Spent,
+            _ => Self::Pending, // Pending, reserved and pending spent are all in flight
+        }
+    }
+}
+
+/// Stored proof states that `list_proofs` can filter on
+#[derive(uniffi::Enum)]
+pub enum FFIProofStateFilter {
+    Unspent,
+    /// Proofs in an unfinished swap or melt, including those pending spent
+    Pending,
+    /// Proofs set aside for a prepared send
+    Reserved,
+    Spent,
+}
+
+impl From<FFIProofStateFilter> for Vec<State> {
+    fn from(filter: FFIProofStateFilter) -> Self {
+        match filter {
+            FFIProofStateFilter::Unspent => vec![State::Unspent],
+            FFIProofStateFilter::Pending => vec![State::Pending, State::PendingSpent],
+            FFIProofStateFilter::Reserved => vec![State::Reserved],
+            FFIProofStateFilter::Spent => vec![State::Spent],
+        }
+    }
+}
+
+/// Spending condition (NUT-10) that locks the proofs of a token
+#[derive(uniffi::Enum)]
+pub enum FFISpendingCondition {
+    None,
+    /// Locked to a public key (NUT-11)
+    P2pk,
+    /// Locked to the preimage of a hash (NUT-14)
+    Htlc,
+}
+
+/// Whether the proofs of a token can still be redeemed, from a NUT-07 check at its mint
+#[derive(uniffi::Enum)]
+pub enum FFITokenState {
+    Spendable,
+    PartiallySpent,
+    Spent,
+}
+
+/// Step of a transfer between mints, the melt at the source or the mint at the destination
+#[derive(Debug, uniffi::Enum)]
+pub enum FFITransferLeg {
+    Melt,
+    Mint,
+}
+
+#[derive(uniffi::Enum)]
+pub enum FFITransactionDirection {
+    Incoming,
+    Outgoing,
+}
+
+impl From<TransactionDirection> for FFITransactionDirection {
+    fn from(direction: TransactionDirection) -> Self {
+        match direction {
+            TransactionDirection::Incoming => Self::Incoming,
+            TransactionDirection::Outgoing => Self::Outgoing,
+        }
+    }
+}
+
+impl From<FFITransactionDirection> for TransactionDirection {
+    fn from(direction: FFITransactionDirection) -> Self {
+        match direction {
+            FFITransactionDirection::Incoming => Self::Incoming,
+            FFITransactionDirection::Outgoing => Self::Outgoing,
+        }
+    }
+}
+
+/// How a payment request (NUT-18) wants the token delivered
+#[derive(uniffi::Enum)]
+pub enum FFITransportType {
+    Nostr,
+    HttpPost,
+}
+
+impl From<TransportType> for FFITransportType {
+    fn from(transport_type: TransportType) -> Self {
+        match transport_type {
+            TransportType::Nostr => Self::Nostr,
+            TransportType::HttpPost => Self::HttpPost,
+        }
+    }
+}
+
+impl From<FFITransportType> for TransportType {
+    fn from(transport_type: FFITransportType) -> Self {
+        match transport_type {
+            FFITransportType::Nostr => Self::Nostr,
+            FFITransportType::HttpPost => Self::HttpPost,
+        }
+    }
+}
+
+#[derive(uniffi::Enum)]
+pub enum FFIQuoteKind {
+    Mint,
+    Melt,
+}
+
+#[derive(Clone, uniffi::Enum)]
+pub enum FFICurrencyUnit {
+    Sat,
+    Msat,
+    Usd,
+    Eur,
+    Custom { value: String },
+}
+
+impl TryFrom<String> for FFICurrencyUnit {
+    type Error = FFIError;
+
+    fn try_from(unit: String) -> Result<Self> {
+        match unit.to_lowercase().as_str() {
+            "sat" => Ok(Self::Sat),
+            "msat" => Ok(Self::Msat),
+            "usd" => Ok(Self::Usd),
+            "eur" => Ok(Self::Eur),
+            "" => Err(FFIError::InvalidInput {
+                msg: "Currency unit cannot be empty".to_string(),
+                code: NO_ERROR_CODE,
+            }),
+            custom => Ok(Self::Custom {
+                value: custom.to_string(),
+            }),
+        }
+    }
+}
+
+impl From<FFICurrencyUnit> for CurrencyUnit {
+    fn from(unit: FFICurrencyUnit) -> Self {
+        match unit {
+            FFICurrencyUnit::Sat => CurrencyUnit::Sat,
+            FFICurrencyUnit::Msat => CurrencyUnit::Msat,
+            FFICurrencyUnit::Usd => CurrencyUnit::Usd,
+            FFICurrencyUnit::Eur => CurrencyUnit::Eur,
+            FFICurrencyUnit::Custom { value } => CurrencyUnit::Custom(value),
+        }
+    }
+}
+
+impl From<CurrencyUnit> for FFICurrencyUnit {
+    fn from(unit: CurrencyUnit) -> Self {
+        match unit {
+            CurrencyUnit::Sat => Self::Sat,
+            CurrencyUnit::Msat => Self::Msat,
+            CurrencyUnit::Usd => Self::Usd,
+            CurrencyUnit::Eur => Self::Eur,
+            other => Self::Custom {
+                value: other.to_string(),
+            },
+        }
+    }
+}
+
+// Callback interfaces - implemented by the foreign language
+
+/// Receives progress updates while a wallet is restored from its seed
+#[uniffi::export(callback_interface)]
+pub trait RestoreProgress: Send + Sync {
+    /// Called after every restore batch with the keyset being scanned,
+    /// the number of keysets finished so far and the number of keysets to scan
+    fn on_batch(&self, keyset_id: String, restored: u32, total: u32);
+}
+
+/// Receives state changes of a subscribed mint quote
+#[uniffi::export(callback_interface)]
+pub trait MintQuoteObserver: Send + Sync {
+    /// Called with the current state once subscribed and after every change
+    fn on_update(&self, state: FFIMintQuoteState);
+}
+
+/// Receives the balance of a wallet after an operation changed it, see `observe_balance`
+#[uniffi::export(callback_interface)]
+pub trait BalanceObserver: Send + Sync {
+    /// Called with the new spendable balance
+    fn on_change(&self, balance: FFIAmount);
+}
+
+/// Receives the outcome of `auto_mint_on_payment`, exactly one method is called
+#[uniffi::export(callback_interface)]
+pub trait MintedObserver: Send + Sync {
+    /// Called with the minted amount once the quote was paid and its proofs are stored
+    fn on_minted(&self, amount: FFIAmount);
+    /// Called instead if minting failed or the quote was already issued
+    fn on_error(&self, err: String);
+}
+
+/// Receives the log events of this library and CDK, see `set_log_callback`
+#[uniffi::export(callback_interface)]
+pub trait LogObserver: Send + Sync {
+    /// Called for every event, `level` is 1 for error, 2 warn, 3 info, 4 debug and 5 trace
+    fn log(&self, level: u8, target: String, message: String);
+}
+
+// Forwards tracing events to the registered LogObserver
+struct LogObserverLayer {
+    observer: Box<dyn LogObserver>,
+}
+
+impl<S: tracing::Subscriber> Layer<S> for LogObserverLayer {
+    fn on_event(&self, event: &tracing::Event<'_>, _ctx: Context<'_, S>) {
+        let metadata = event.metadata();
+        let level = match *metadata.level() {
+            tracing::Level::ERROR => 1,
+            tracing::Level::WARN => 2,
+            tracing::Level::INFO => 3,
+            tracing::Level::DEBUG => 4,
+            tracing::Level::TRACE => 5,
+        };
+        let mut message = LogMessage::default();
+        event.record(&mut message);
+        self.observer.log(level, metadata.target().to_string(), message.0);
+    }
+}
+
+// The event's message followed by its other fields as `name=value`
+#[derive(Default)]
+struct LogMessage(String);
+
+impl tracing::field::Visit for LogMessage {
+    fn record_debug(&mut self, field: &tracing::field::Field, value: &dyn std::fmt::Debug) {
+        if !self.0.is_empty() {
+            self.0.push(' ');
+        }
+        if field.name() == "message" {
+            self.0.push_str(&format!("{:?}", value));
+        } else {
+            self.0.push_str(&format!("{}={:?}", field.name(), value));
+        }
+    }
+}
+
+/// Deliver a payment to the HTTP POST transport of a payment request (NUT-18)
+async fn post_payment(
+    client: &reqwest::Client,
+    timeout: Duration,
+    target: &str,
+    payload: &PaymentRequestPayload,
+) -> std::result::Result<(), reqwest::Error> {
+    client
+        .post(target)
+        .json(payload)
+        .timeout(timeout)
+        .send()
+        .await?
+        .error_for_status()?;
+    Ok(())
+}
+
+/// Same steps as `Wallet::restore`, reporting to `progress` after every batch
+/// CDK has no progress hook, so this mirrors the restore loop of cdk 0.11.0 and must be
+/// checked against `Wallet::restore` whenever the cdk dependency is upgraded
+async fn restore_with_progress(
+    wallet: &CdkWallet,
+    seed: &[u8; 64],
+    progress: &dyn RestoreProgress,
+) -> Result<()> {
+    if wallet.localstore.get_mint(wallet.mint_url.clone()).await?.is_none() {
+        wallet.get_mint_info().await?;
+    }
+
+    let keysets: Vec<_> = wallet
+        .get_mint_keysets()
+        .await?
+        .into_iter()
+        .filter(|keyset| keyset.unit == wallet.unit)
+        .collect();
+    let total = keysets.len() as u32;
+
+    for (index, keyset) in keysets.iter().enumerate() {
+        let keys = wallet.fetch_keyset_keys(keyset.id).await?;
+        let mut empty_batches = 0;
+        let mut start_counter = 0;
+
+        while empty_batches < RESTORE_EMPTY_BATCHES {
+            let premint_secrets = PreMintSecrets::restore_batch(
+                keyset.id,
+                seed,
+                start_counter,
+                start_counter + RESTORE_BATCH_SIZE,
+            )
+            .map_err(|e| FFIError::InternalError {
+                msg: e.to_string(),
+                code: NO_ERROR_CODE,
+            })?;
+            start_counter += RESTORE_BATCH_SIZE;
+
+            // The wallet's own connector, so a proxy or auth set on it applies here too
+            let response = wallet
+                .client
+                .post_restore(RestoreRequest {
+                    outputs: premint_secrets.blinded_messages(),
+                })
+                .await?;
+
+            if response.signatures.is_empty() {
+                empty_batches += 1;
+            } else {
+                // Only the blinded messages the mint has signatures for are restored
+                let secrets: Vec<_> = premint_secrets
+                    .secrets
+                    .iter()
+                    .filter(|p| response.outputs.contains(&p.blinded_message))
+                    .collect();
+                let proofs = construct_proofs(
+                    response.signatures,
+                    secrets.iter().map(|p| p.r.clone()).collect(),
+                    secrets.iter().map(|p| p.secret.clone()).collect(),
+                    &keys,
+                )
+                .map_err(|e| FFIError::WalletError {
+                    msg: e.to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+
+                wallet
+                    .localstore
+                    .increment_keyset_counter(&keyset.id, proofs.len() as u32)
+                    .await?;
+
+                let states = wallet.check_proofs_spent(proofs.clone()).await?;
+                let unspent_proofs = proofs
+                    .into_iter()
+                    .zip(states)
+                    .filter(|(_, proof_state)| proof_state.state != State::Spent)
+                    .map(|(proof, _)| {
+                        ProofInfo::new(
+                            proof,
+                            wallet.mint_url.clone(),
+                            State::Unspent,
+                            wallet.unit.clone(),
+                        )
+                    })
+                    .collect::<std::result::Result<Vec<_>, _>>()?;
+                wallet.localstore.update_proofs(unspent_proofs, vec![]).await?;
+
+                empty_batches = 0;
+            }
+
+            let finished = if empty_batches < RESTORE_EMPTY_BATCHES {
+                index
+            } else {
+                index + 1
+            };
+            progress.on_batch(keyset.id.to_string(), finished as u32, total);
+        }
+    }
+
+    Ok(())
+}
+
+// Objects (pass by reference) - stateful objects
+
+#[derive(uniffi::Object)]
+pub struct FFILocalStore {
+    inner: Arc<dyn WalletDatabase<Err = cdk_common::database::Error> + Send + Sync>,
+    // Connection to the same database for the app metadata table, which CDK's schema lacks
+    metadata: Mutex<rusqlite::Connection>,
+}
+
+#[uniffi::export]
+impl FFILocalStore {
+    #[uniffi::constructor]
+    pub fn new() -> Result<Arc<Self>> {
+        Self::new_with_path(None)
+    }
+
+    #[uniffi::constructor]
+    pub fn new_with_path(db_path: Option<String>) -> Result<Arc<Self>> {
+        let rt = runtime();
+        let final_db_path = match db_path {
+            Some(custom_path) => {
+                // Use the provided path directly
+                custom_path
+            }
+            None => {
+                // Fallback to temp directory (original behavior)
+                let temp_path =
+                    std::env::temp_dir().join(format!("cdk_wallet_{}.db", uuid::Uuid::new_v4()));
+                temp_path.to_string_lossy().to_string()
+            }
+        };
+        let store = rt.block_on(cdk_sqlite::WalletSqliteDatabase::new(&final_db_path))?;
+        let metadata = rusqlite::Connection::open(&final_db_path)?;
+        Ok(Arc::new(Self {
+            inner: Arc::new(store),
+            metadata: open_metadata(metadata)?,
+        }))
+    }
+
+    /// Create a store that lives only in memory and is lost when dropped, meant for tests
+    #[uniffi::constructor]
+    pub fn new_in_memory() -> Result<Arc<Self>> {
+        let store = runtime().block_on(cdk_sqlite::wallet::memory::empty())?;
+        Ok(Arc::new(Self {
+            inner: Arc::new(store),
+            metadata: open_metadata(rusqlite::Connection::open_in_memory()?)?,
+        }))
+    }
+
+    /// Remove spent proofs and expired quotes for every mint in the store
+    /// Paid mint quotes and pending melt quotes are kept even once expired, since
+    /// they can still be completed. Freed pages are reused but the file is not shrunk
+    pub fn vacuum(&self) -> Result<FFIVacuumResult> {
+        runtime().block_on(async {
+            let spent_ys = self
+                .inner
+                .get_proofs(None, None, Some(vec![State::Spent]), None)
+                .await?
+                .into_iter()
+                .map(|proof| proof.y)
+                .collect::<Vec<_>>();
+            let proofs_removed = spent_ys.len() as u64;
+            if !spent_ys.is_empty() {
+                self.inner.update_proofs(vec![], spent_ys).await?;
+            }
+
+            let now = unix_time();
+            let mut quotes_removed = 0;
+            for quote in self.inner.get_mint_quotes().await? {
+                if quote.expiry <= now && quote.state != MintQuoteState::Paid {
+                    self.inner.remove_mint_quote(&quote.id).await?;
+                    quotes_removed += 1;
+                }
+            }
+            for quote in self.inner.get_melt_quotes().await? {
+                if quote.expiry <= now && quote.state != MeltQuoteState::Pending {
+                    self.inner.remove_melt_quote(&quote.id).await?;
+                    quotes_removed += 1;
+                }
+            }
+
+            Ok(FFIVacuumResult {
+                proofs_removed,
+                quotes_removed,
+            })
+        })
+    }
+}
+
+// Prepare a connection for the app metadata, settings, proof lock and reservation tables,
+// creating them on first use
+fn open_metadata(connection: rusqlite::Connection) -> Result<Mutex<rusqlite::Connection>> {
+    connection.busy_timeout(Duration::from_secs(5))?;
+    connection.execute(
+        "CREATE TABLE IF NOT EXISTS ffi_wallet_metadata (
+            mint_url TEXT NOT NULL,
+            unit TEXT NOT NULL,
+            key TEXT NOT NULL,
+            value TEXT NOT NULL,
+            PRIMARY KEY (mint_url, unit, key)
+        )",
+        [],
+    )?;
+    connection.execute(
+        "CREATE TABLE IF NOT EXISTS ffi_locked_proofs (
+            mint_url TEXT NOT NULL,
+            unit TEXT NOT NULL,
+            y TEXT NOT NULL,
+            PRIMARY KEY (mint_url, unit, y)
+        )",
+        [],
+    )?;
+    connection.execute(
+        "CREATE TABLE IF NOT EXISTS ffi_wallet_settings (
+            mint_url TEXT NOT NULL,
+            unit TEXT NOT NULL,
+            key TEXT NOT NULL,
+            value TEXT NOT NULL,
+            PRIMARY KEY (mint_url, unit, key)
+        )",
+        [],
+    )?;
+    connection.execute(
+        "CREATE TABLE IF NOT EXISTS ffi_reserved_sends (
+            mint_url TEXT NOT NULL,
+            unit TEXT NOT NULL,
+            y TEXT NOT NULL,
+            reservation_id TEXT NOT NULL,
+            expires_at INTEGER NOT NULL,
+            PRIMARY KEY (mint_url, unit, y)
+        )",
+        [],
+    )?;
+    Ok(Mutex::new(connection))
+}
+
+/// An active NUT-17 or balance subscription, kept alive until `unsubscribe` is called
+/// or the wallet that created it is dropped
+#[derive(uniffi::Object)]
+pub struct FFISubscription {
+    task: Mutex<Option<JoinHandle<()>>>,
+}
+
+#[uniffi::export]
+impl FFISubscription {
+    /// Stop receiving updates, calling it again has no effect
+    pub fn unsubscribe(&self) {
+        let task = self.task.lock().unwrap_or_else(|e| e.into_inner()).take();
+        if let Some(task) = task {
+            task.abort();
+        }
+    }
+}
+
+#[derive(uniffi::Object)]
+pub struct FFIWallet {
+    inner: CdkWallet,
+    // Client for requests CDK does not make, such as NUT-18 posts, behind the wallet's proxy
+    http: reqwest::Client,
+    runtime: Runtime,
+    recent_errors: Mutex<VecDeque<String>>,
+    p2pk_key: SecretKey,
+    // Overrides the learned fee rate in `estimate_melt_fee` once set, never sent to the mint
+    // Loaded from the settings table when the wallet is built
+    fee_reserve_percent: Mutex<Option<f64>>,
+    // Sends prepared by `prepare_send`, by reservation id, until confirmed or released
+    reserved_sends: Mutex<HashMap<String, ReservedSend>>,
+    // How long a prepared send holds its proofs, set with `set_prepared_send_ttl`
+    prepared_send_ttl: Mutex<Duration>,
+    localstore: Arc<FFILocalStore>,
+    // Bound on calls that move no proofs, set with `set_request_timeout`
+    request_timeout: Mutex<Option<Duration>>,
+    retry_policy: Mutex<RetryPolicy>,
+    // Marked after every wallet call so balance observers re-read the balance
+    balance_changed: watch::Sender<()>,
+    // Set by `update_mint_url`, after which the wallet must be reopened at the new URL
+    moved_to: Mutex<Option<MintUrl>>,
+    // Seed of the coin selection order, set with `set_deterministic` in tests
+    deterministic_seed: Mutex<Option<u64>>,
+}
+
+// How idempotent mint requests are retried, set with `set_retry_policy`
+#[derive(Clone, Copy, Default)]
+struct RetryPolicy {
+    max_retries: u8,
+    base_delay_ms: u64,
+}
+
+// A send ready to finish, prepared by CDK from every unspent proof or from proofs this wallet
+// selected itself, e.g. for a proof selection order or preferred denominations
+enum PendingSend {
+    Cdk(PreparedSend),
+    Selected(SelectedSend),
+}
+
+impl PendingSend {
+    /// Every stored proof the send spends, whether sent as-is or swapped first
+    fn proofs(&self) -> Vec<Proof> {
+        match self {
+            PendingSend::Cdk(send) => {
+                let mut proofs = send.proofs_to_swap().clone();
+                proofs.extend(send.proofs_to_send().iter().cloned());
+                proofs
+            }
+            PendingSend::Selected(send) => send.proofs.clone(),
+        }
+    }
+}
+
+// Proofs this wallet selected for a send. Only they are reserved, CDK never sees the others
+struct SelectedSend {
+    amount: Amount,
+    proofs: Vec<Proof>,
+    // Swap `proofs` into the amount sent, otherwise they are sent as they are
+    requires_swap: bool,
+    swap_fee: Amount,
+    send_fee: Amount,
+    options: SendOptions,
+}
+
+// Proofs `prepare_send` reserved, with what `confirm_send` needs to finish the send
+struct ReservedSend {
+    prepared: PendingSend,
+    token_version: FFITokenVersion,
+    expires_at: Instant,
+}
+
+#[uniffi::export]
+impl FFIWallet {
+    #[uniffi::constructor]
+    pub fn from_mnemonic(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        mnemonic_words: String,
+    ) -> Result<Arc<Self>> {
+        let seed = mnemonic_to_seed(mnemonic_words.clone())?;
+
+        let wallet = CdkWallet::new(
+            &normalize_mint_url(mint_url)?,
+            unit.into(),
+            localstore.inner.clone(),
+            &seed,
+            None,
+        )?;
+
+        Self::build(wallet, &seed, localstore, reqwest::Client::new())
+    }
+
+    /// Create a wallet from a mnemonic whose requests, to the mint and to NUT-18 payment
+    /// targets, all go through a proxy
+    /// `proxy_url` is a socks5, socks5h or http URL, e.g. `socks5h://127.0.0.1:9050` for Tor
+    /// Returns NetworkError if nothing answers on the proxy address
+    #[uniffi::constructor]
+    pub fn from_mnemonic_with_proxy(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        mnemonic_words: String,
+        proxy_url: String,
+    ) -> Result<Arc<Self>> {
+        let seed = mnemonic_to_seed(mnemonic_words.clone())?;
+
+        let mut wallet = CdkWallet::new(
+            &normalize_mint_url(mint_url)?,
+            unit.into(),
+            localstore.inner.clone(),
+            &seed,
+            None,
+        )?;
+        let (client, http) = proxy_clients(wallet.mint_url.clone(), &proxy_url)?;
+        wallet.set_client(Arc::new(client));
+
+        Self::build(wallet, &seed, localstore, http)
+    }
+
+    /// Create a wallet from a mnemonic and store `proofs` in it as unspent, e.g. when moving
+    /// proofs kept outside any wallet. The keysets are fetched from the mint and every proof
+    /// must belong to one of its keysets for `unit`, otherwise InvalidInput is returned
+    /// Proofs are not checked against the mint, `reconcile` drops any that were spent
+    #[uniffi::constructor]
+    pub fn from_mnemonic_with_proofs(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        mnemonic_words: String,
+        proofs: Vec<FFIProof>,
+    ) -> Result<Arc<Self>> {
+        let proofs = proofs
+            .into_iter()
+            .map(Proof::try_from)
+            .collect::<Result<Vec<_>>>()?;
+
+        let wallet = Self::from_mnemonic(mint_url, unit, localstore, mnemonic_words)?;
+        wallet.block_on(wallet.insert_proofs(proofs))?;
+        Ok(wallet)
+    }
+
+    /// Create a wallet from a 64-byte BIP39 seed, or from 16 to 32 bytes of mnemonic entropy
+    #[uniffi::constructor]
+    pub fn from_seed(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        seed: Vec<u8>,
+    ) -> Result<Arc<Self>> {
+        let seed = seed_from_bytes(&seed)?;
+
+        let wallet = CdkWallet::new(
+            &normalize_mint_url(mint_url)?,
+            unit.into(),
+            localstore.inner.clone(),
+            &seed,
+            None,
+        )?;
+
+        Self::build(wallet, &seed, localstore, reqwest::Client::new())
+    }
+
+    #[uniffi::constructor]
+    pub fn restore_from_mnemonic(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        mnemonic_words: String,
+    ) -> Result<Arc<Self>> {
+        let seed = mnemonic_to_seed(mnemonic_words.clone())?;
+
+        let wallet = CdkWallet::new(
+            &normalize_mint_url(mint_url)?,
+            unit.into(),
+            localstore.inner.clone(),
+            &seed,
+            None,
+        )?;
+
+        let wallet = Self::build(wallet, &seed, localstore, reqwest::Client::new())?;
+        wallet.runtime.block_on(wallet.inner.restore())?;
+        Ok(wallet)
+    }
+
+    /// Restore a wallet like `restore_from_mnemonic`, reporting progress after every batch
+    #[uniffi::constructor]
+    pub fn restore_from_mnemonic_with_progress(
+        mint_url: String,
+        unit: FFICurrencyUnit,
+        localstore: Arc<FFILocalStore>,
+        mnemonic_words: String,
+        progress: Box<dyn RestoreProgress>,
+    ) -> Result<Arc<Self>> {
+        let seed = mnemonic_to_seed(mnemonic_words)?;
+
+        let wallet = CdkWallet::new(
+            &normalize_mint_url(mint_url)?,
+            unit.into(),
+            localstore.inner.clone(),
+            &seed,
+            None,
+        )?;
+
+        let wallet = Self::build(wallet, &seed, localstore, reqwest::Client::new())?;
+        wallet.runtime.block_on(restore_with_progress(
+            &wallet.inner,
+            &seed,
+            progress.as_ref(),
+        ))?;
+        Ok(wallet)
+    }
+
+    /// Hex public key of the wallet's P2PK receiving key, derived from its seed
+    /// Tokens locked to it are unlocked by `receive` without passing a signing key. The key is on a
+    /// path of this library's own, so other wallets restored from the same mnemonic cannot spend
+    /// them
+    pub fn pubkey(&self) -> Result<String> {
+        Ok(self.p2pk_key.public_key().to_hex())
+    }
+
+    pub fn mint_quote(
+        &self,
+        amount: FFIAmount,
+        description: Option<String>,
+    ) -> Result<FFIMintQuote> {
+        self.block_on_read(async {
+            if !self.cached_availability().await?.mint {
+                return Err(FFIError::OperationDisabled {
+                    msg: "Minting is disabled at this mint".to_string(),
+                    code: ERROR_CODE_MINTING_DISABLED,
+                });
+            }
+            let quote = self.inner.mint_quote(amount.into(), description).await?;
+            Ok(quote.into())
+        })
+    }
+
+    /// Create a mint quote with a description and a shorter lifetime than the mint's
+    /// The expiry is enforced by the wallet, NUT-04 has no way to ask the mint for it
+    pub fn mint_quote_with_options(
+        &self,
+        amount: FFIAmount,
+        options: FFIMintQuoteOptions,
+    ) -> Result<FFIMintQuote> {
+        if let Some(expiry) = options.expiry_secs {
+            if expiry <= unix_time() {
+                return Err(FFIError::InvalidInput {
+                    msg: format!("Quote expiry {} is in the past", expiry),
+                    code: NO_ERROR_CODE,
+                });
+            }
+        }
+        if options.single_use == Some(false) {
+            return Err(FFIError::InvalidInput {
+                msg: "Mint quotes can only be used once".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+
+        self.block_on_read(async {
+            if !self.cached_availability().await?.mint {
+                return Err(FFIError::OperationDisabled {
+                    msg: "Minting is disabled at this mint".to_string(),
+                    code: ERROR_CODE_MINTING_DISABLED,
+                });
+            }
+            let mut quote = self
+                .inner
+                .mint_quote(amount.into(), options.description)
+                .await?;
+            if let Some(expiry) = options.expiry_secs {
+                if quote.expiry == 0 || expiry < quote.expiry {
+                    quote.expiry = expiry;
+                    self.inner.localstore.add_mint_quote(quote.clone()).await?;
+                }
+            }
+            Ok(quote.into())
+        })
+    }
+
+    pub fn mint_quote_state(&self, quote_id: String) -> Result<FFIMintQuoteBolt11Response> {
+        self.block_on_read(async {
+            let state = self.with_retry(|| self.inner.mint_quote_state(&quote_id)).await?;
+            Ok(state.into())
+        })
+    }
+
+    /// Check several mint quotes at once, returning their states in the order of `quote_ids`
+    /// The requests to the mint run concurrently and the first failure fails the whole call
+    pub fn mint_quote_states(
+        &self,
+        quote_ids: Vec<String>,
+    ) -> Result<Vec<FFIMintQuoteBolt11Response>> {
+        self.block_on_read(async {
+            let states = futures::future::try_join_all(
+                quote_ids
+                    .iter()
+                    .map(|quote_id| self.with_retry(|| self.inner.mint_quote_state(quote_id))),
+            )
+            .await?;
+            Ok(states.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// Subscribe to state changes of a mint quote over the mint's WebSocket (NUT-17)
+    /// The mint is polled instead if it does not support WebSocket subscriptions
+    pub fn subscribe_mint_quote(
+        &self,
+        quote_id: String,
+        observer: Box<dyn MintQuoteObserver>,
+    ) -> Result<Arc<FFISubscription>> {
+        let mut subscription = self.block_on_read(async {
+            Ok(self
+                .inner
+                .subscribe(WalletSubscription::Bolt11MintQuoteState(vec![quote_id]))
+                .await)
+        })?;
+        let task = self.runtime.spawn(async move {
+            while let Some(notification) = subscription.recv().await {
+                if let NotificationPayload::MintQuoteBolt11Response(response) = notification {
+                    observer.on_update(response.state.into());
+                }
+            }
+        });
+        Ok(Arc::new(FFISubscription {
+            task: Mutex::new(Some(task)),
+        }))
+    }
+
+    /// Mint a quote as soon as the mint reports it paid, watching it like `subscribe_mint_quote`
+    /// The subscription ends after `observer` was called, or when `unsubscribe` is called first
+    /// Calls come from an internal thread and must not call back into the wallet synchronously
+    pub fn auto_mint_on_payment(
+        &self,
+        quote_id: String,
+        split_target: FFISplitTarget,
+        observer: Box<dyn MintedObserver>,
+    ) -> Result<Arc<FFISubscription>> {
+        let mut subscription = self.block_on_read(async {
+            Ok(self
+                .inner
+                .subscribe(WalletSubscription::Bolt11MintQuoteState(vec![
+                    quote_id.clone()
+                ]))
+                .await)
+        })?;
+        let wallet = self.inner.clone();
+        let balance_changed = self.balance_changed.clone();
+        let task = self.runtime.spawn(async move {
+            while let Some(notification) = subscription.recv().await {
+                let NotificationPayload::MintQuoteBolt11Response(response) = notification else {
+                    continue;
+                };
+                match response.state {
+                    MintQuoteState::Unpaid => continue,
+                    MintQuoteState::Paid => {
+                        let minted = async {
+                            let proofs = wallet.mint(&quote_id, split_target.into(), None).await?;
+                            Ok::<_, FFIError>(proofs.total_amount()?)
+                        }
+                        .await;
+                        balance_changed.send_replace(());
+                        match minted {
+                            Ok(amount) => observer.on_minted(amount.into()),
+                            Err(err) => observer.on_error(err.to_string()),
+                        }
+                    }
+                    MintQuoteState::Issued => {
+                        observer.on_error("Mint quote was already issued".to_string())
+                    }
+                }
+                return;
+            }
+            observer.on_error("Mint quote subscription closed before it was paid".to_string());
+        });
+        Ok(Arc::new(FFISubscription {
+            task: Mutex::new(Some(task)),
+        }))
+    }
+
+    /// Call `observer` with the balance whenever a call on this wallet changed it, such as a
+    /// mint, melt, send, receive or swap, until `unsubscribe` is called
+    /// Calls come from an internal thread and must not call back into the wallet synchronously
+    pub fn observe_balance(
+        &self,
+        observer: Box<dyn BalanceObserver>,
+    ) -> Result<Arc<FFISubscription>> {
+        let mut changed = self.balance_changed.subscribe();
+        let mut last = self.block_on(async { Ok(self.inner.total_balance().await?) })?;
+        let wallet = self.inner.clone();
+        let task = self.runtime.spawn(async move {
+            // Ends once the wallet, and with it the sender, is dropped
+            while changed.changed().await.is_ok() {
+                if let Ok(balance) = wallet.total_balance().await {
+                    if balance != last {
+                        last = balance;
+                        observer.on_change(balance.into());
+                    }
+                }
+            }
+        });
+        Ok(Arc::new(FFISubscription {
+            task: Mutex::new(Some(task)),
+        }))
+    }
+
+    /// Block until a mint quote is paid or `timeout_secs` elapse, returning its final state
+    /// Fails with `FFIError::Timeout` if the quote is still unpaid when the time is up
+    pub fn wait_for_mint_quote_paid(
+        &self,
+        quote_id: String,
+        timeout_secs: u64,
+    ) -> Result<FFIMintQuoteBolt11Response> {
+        self.block_on_within(None, async {
+            let mut subscription = self
+                .inner
+                .subscribe(WalletSubscription::Bolt11MintQuoteState(vec![
+                    quote_id.clone()
+                ]))
+                .await;
+            let paid = async {
+                while let Some(notification) = subscription.recv().await {
+                    if let NotificationPayload::MintQuoteBolt11Response(response) = notification {
+                        if response.state != MintQuoteState::Unpaid {
+                            return Ok(response);
+                        }
+                    }
+                }
+                Err(FFIError::NetworkError {
+                    msg: "Mint quote subscription closed".to_string(),
+                    code: NO_ERROR_CODE,
+                })
+            };
+
+            match tokio::time::timeout(Duration::from_secs(timeout_secs), paid).await {
+                Ok(response) => Ok(response?.into()),
+                Err(_) => Err(FFIError::Timeout {
+                    msg: format!("Mint quote {quote_id} not paid after {timeout_secs}s"),
+                    code: NO_ERROR_CODE,
+                }),
+            }
+        })
+    }
+
+    /// Create a mint quote, wait up to `timeout_secs` for it to be paid and mint it, for scripts
+    /// and test mints that pay their own invoices. The quote only comes back at the end, apps
+    /// showing the invoice call `mint_quote` and `wait_for_mint_quote_paid` themselves
+    /// Fails with `FFIError::Timeout`, naming the quote, if it is still unpaid when time is up
+    pub fn mint_and_wait(
+        &self,
+        amount: FFIAmount,
+        description: Option<String>,
+        timeout_secs: u64,
+        split_target: FFISplitTarget,
+    ) -> Result<FFIMintAndWaitResult> {
+        let mut quote = self.mint_quote(amount, description)?;
+        self.wait_for_mint_quote_paid(quote.id.clone(), timeout_secs)?;
+        let amount = self.mint(quote.id.clone(), split_target)?;
+        quote.state = FFIMintQuoteState::Issued;
+        Ok(FFIMintAndWaitResult { quote, amount })
+    }
+
+    pub fn mint(&self, quote_id: String, split_target: FFISplitTarget) -> Result<FFIAmount> {
+        self.block_on(async {
+            let proofs = self
+                .inner
+                .mint(&quote_id, split_target.into(), None)
+                .await?;
+            let amount = proofs.total_amount()?;
+            Ok(amount.into())
+        })
+    }
+
+    /// Mint like `mint`, also reporting the denomination of every proof issued
+    pub fn mint_detailed(
+        &self,
+        quote_id: String,
+        split_target: FFISplitTarget,
+    ) -> Result<FFIMintResult> {
+        self.block_on(async {
+            let proofs = self
+                .inner
+                .mint(&quote_id, split_target.into(), None)
+                .await?;
+            Ok(FFIMintResult {
+                total: proofs.total_amount()?.into(),
+                amounts: proofs.iter().map(|proof| proof.amount.into()).collect(),
+            })
+        })
+    }
+
+    /// Mint a paid quote into proofs of exactly the given denominations
+    /// The amounts must add up to the quote amount
+    pub fn mint_with_amounts(
+        &self,
+        quote_id: String,
+        amounts: Vec<FFIAmount>,
+    ) -> Result<FFIAmount> {
+        self.block_on(async {
+            let quote = self
+                .inner
+                .localstore
+                .get_mint_quote(&quote_id)
+                .await?
+                .ok_or_else(|| FFIError::InvalidInput {
+                    msg: format!("Unknown mint quote: {}", quote_id),
+                    code: NO_ERROR_CODE,
+                })?;
+            let amounts: Vec<Amount> = amounts.into_iter().map(Into::into).collect();
+            let requested = Amount::try_sum(amounts.iter().copied())
+                .map_err(|e| FFIError::InvalidInput {
+                    msg: e.to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            if requested != quote.amount {
+                return Err(FFIError::InvalidInput {
+                    msg: format!(
+                        "Requested amounts add up to {}, but the quote is for {}",
+                        requested, quote.amount
+                    ),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let proofs = self
+                .inner
+                .mint(&quote_id, SplitTarget::Values(amounts), None)
+                .await?;
+            let amount = proofs.total_amount()?;
+            Ok(amount.into())
+        })
+    }
+
+    /// Select and reserve the proofs for a send and report its fees
+    /// `confirm_send` sends exactly these proofs for the reported fee, within ten minutes unless
+    /// `set_prepared_send_ttl` changed it. Reservations are recorded in the store, so other
+    /// wallets on it leave the proofs alone until they expire. Proofs of an expired reservation
+    /// return to the balance the next time a wallet on the store is opened
+    /// With `dry_run` set nothing is reserved or stored: the result previews the fees only and
+    /// cannot be finalized, a later send selects its proofs again
+    pub fn prepare_send(
+        &self,
+        amount: FFIAmount,
+        options: FFISendOptions,
+    ) -> Result<FFIPreparedSend> {
+        self.block_on(async {
+            if options.dry_run {
+                return self.estimate_send(amount.into(), options).await;
+            }
+            self.release_expired_sends().await?;
+
+            let token_version = options.token_version;
+            let prepared = self.prepare_send_with(amount.into(), options).await?;
+            let input_fee = self.inner.get_proofs_fee(&prepared.proofs()).await?;
+
+            let reservation_id = uuid::Uuid::new_v4().to_string();
+            let result = FFIPreparedSend::new(&prepared, input_fee, reservation_id.clone());
+            let ttl = *self.prepared_send_ttl.lock().unwrap_or_else(|e| e.into_inner());
+            let expires_at = unix_time().saturating_add(ttl.as_secs());
+            let recorded = prepared
+                .proofs()
+                .ys()
+                .map_err(FFIError::from)
+                .and_then(|ys| self.record_reservation(&reservation_id, &ys, expires_at));
+            if let Err(err) = recorded {
+                self.cancel_pending_send(prepared).await?;
+                return Err(err);
+            }
+            self.reserved_sends
+                .lock()
+                .unwrap_or_else(|e| e.into_inner())
+                .insert(
+                    reservation_id,
+                    ReservedSend {
+                        prepared,
+                        token_version,
+                        expires_at: Instant::now() + ttl,
+                    },
+                );
+            Ok(result)
+        })
+    }
+
+    /// Finish a send from `prepare_send` with the proofs it reserved, so the fee charged is the
+    /// one it reported. Unknown, already finished and expired reservations are InvalidInput
+    pub fn confirm_send(&self, prepared: FFIPreparedSend) -> Result<FFIToken> {
+        self.block_on(async {
+            let reserved = self
+                .reserved_sends
+                .lock()
+                .unwrap_or_else(|e| e.into_inner())
+                .remove(&prepared.reservation_id)
+                .ok_or_else(|| FFIError::InvalidInput {
+                    msg: "Unknown or already finished prepared send".to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            // Another wallet on the store releases the proofs once the reservation expired
+            let owned = self.take_reservation(&prepared.reservation_id)?;
+            if !owned || reserved.expires_at <= Instant::now() {
+                if owned {
+                    self.cancel_pending_send(reserved.prepared).await?;
+                }
+                return Err(FFIError::InvalidInput {
+                    msg: "Prepared send expired, its proofs were released".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let token = self.finish_send(reserved.prepared, None).await?;
+            let token = self.with_token_version(token, reserved.token_version).await?;
+            Ok(token.try_into()?)
+        })
+    }
+
+    /// Release the proofs a `prepare_send` reserved, returning them to the balance without a
+    /// swap. Unknown reservations, including finished or expired ones, are InvalidInput
+    pub fn cancel_prepared_send(&self, prepared: FFIPreparedSend) -> Result<()> {
+        self.block_on(async {
+            let reserved = self
+                .reserved_sends
+                .lock()
+                .unwrap_or_else(|e| e.into_inner())
+                .remove(&prepared.reservation_id)
+                .ok_or_else(|| FFIError::InvalidInput {
+                    msg: "Unknown or already finished prepared send".to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            if self.take_reservation(&prepared.reservation_id)? {
+                self.cancel_pending_send(reserved.prepared).await?;
+            }
+            Ok(())
+        })
+    }
+
+    pub fn send(
+        &self,
+        amount: FFIAmount,
+        options: FFISendOptions,
+        memo: Option<FFISendMemo>,
+    ) -> Result<FFIToken> {
+        self.block_on(async {
+            if options.dry_run {
+                return Err(FFIError::InvalidInput {
+                    msg: "Dry run only applies to prepare_send".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            let token_version = options.token_version;
+
+            // First prepare the send
+            let prepared = self.prepare_send_with(amount.into(), options).await?;
+
+            // Then send it
+            let token = self.finish_send(prepared, memo.map(|m| m.into())).await?;
+            let token = self.with_token_version(token, token_version).await?;
+            Ok(token.try_into()?)
+        })
+    }
+
+    /// Pay a `creqA` payment request (NUT-18) with a token for the requested amount
+    /// With an HTTP POST transport the token is also delivered to the payee, and taken back if
+    /// that fails. Nostr transports are not supported, the caller hands over the returned token
+    /// Proofs are selected as `send` selects them with `options`
+    pub fn pay_payment_request(
+        &self,
+        request: String,
+        options: FFISendOptions,
+    ) -> Result<FFIToken> {
+        self.block_on(async {
+            if options.dry_run {
+                return Err(FFIError::InvalidInput {
+                    msg: "Dry run only applies to prepare_send".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            let request = PaymentRequest::from_str(&request).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid payment request: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            let amount = request.amount.ok_or_else(|| FFIError::InvalidInput {
+                msg: "Payment request has no amount".to_string(),
+                code: NO_ERROR_CODE,
+            })?;
+            if let Some(mints) = &request.mints {
+                if !mints.is_empty() && !mints.contains(&self.inner.mint_url) {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!(
+                            "Payment request does not accept tokens from {}",
+                            self.inner.mint_url
+                        ),
+                        code: NO_ERROR_CODE,
+                    });
+                }
+            }
+            if let Some(unit) = &request.unit {
+                if *unit != self.inner.unit {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!(
+                            "Payment request is in {}, the wallet in {}",
+                            unit, self.inner.unit
+                        ),
+                        code: NO_ERROR_CODE,
+                    });
+                }
+            }
+
+            let token_version = options.token_version;
+            let prepared = self.prepare_send_with(amount, options).await?;
+            let token = self.finish_send(prepared, None).await?;
+
+            let transport = request
+                .transports
+                .iter()
+                .find(|transport| transport._type == TransportType::HttpPost);
+            if let Some(transport) = transport {
+                let keysets = self.inner.get_mint_keysets().await?;
+                let proofs = token.proofs(&keysets)?;
+                let payload = PaymentRequestPayload {
+                    id: request.payment_id.clone(),
+                    memo: None,
+                    mint: self.inner.mint_url.clone(),
+                    unit: self.inner.unit.clone(),
+                    proofs: proofs.clone(),
+                };
+                let timeout = self
+                    .request_timeout
+                    .lock()
+                    .unwrap_or_else(|e| e.into_inner())
+                    .unwrap_or(Duration::from_secs(PAYMENT_POST_TIMEOUT_SECS));
+                if let Err(e) =
+                    post_payment(&self.http, timeout, &transport.target, &payload).await
+                {
+                    self.inner
+                        .swap(None, SplitTarget::default(), proofs, None, false)
+                        .await?;
+                    return Err(FFIError::NetworkError {
+                        msg: format!("Payment not delivered, the token was reclaimed: {}", e),
+                        code: NO_ERROR_CODE,
+                    });
+                }
+            }
+
+            let token = self.with_token_version(token, token_version).await?;
+            Ok(token.try_into()?)
+        })
+    }
+
+    /// Receive an encoded token into the wallet
+    /// The result carries the token's memo and unit for the wallet's history
+    /// With `idempotent` set, a token that was already received returns its original amount
+    /// With `trust_unswapped` set, the proofs are stored as-is after a NUT-07 unspent check,
+    /// saving the swap fee but leaving the sender able to spend them too. Tokens in another
+    /// unit or locked with P2PK or HTLC are InvalidInput, they need a swap
+    /// With `require_dleq` set, a token with proofs lacking a valid DLEQ proof fails with
+    /// DleqVerificationFailed before it is swapped
+    pub fn receive(&self, token: String, options: FFIReceiveOptions) -> Result<FFIReceiveResult> {
+        self.block_on(self.receive_token(&token, options))
+    }
+
+    /// Receive several tokens, e.g. pasted at once, with as few swaps as possible
+    /// Tokens of this wallet's mint and unit are swapped together in one request. If that swap
+    /// fails, e.g. because one of them was already spent, each token is received on its own so
+    /// the others still go through. `idempotent`, `trust_unswapped` and `require_dleq` always
+    /// receive one by one
+    /// Tokens that could not be received are listed in `failed` and left out of `amount`
+    pub fn receive_batch(
+        &self,
+        tokens: Vec<String>,
+        options: FFIReceiveOptions,
+    ) -> Result<FFIReceiveBatchResult> {
+        self.block_on(async {
+            let mut amount = Amount::ZERO;
+            let mut single: Vec<usize> = (0..tokens.len()).collect();
+
+            if !options.idempotent && !options.trust_unswapped && !options.require_dleq {
+                let keysets = self.inner.get_mint_keysets().await?;
+                let mut batched = Vec::new();
+                let mut proofs = Vec::new();
+                for (index, token) in tokens.iter().enumerate() {
+                    // Anything unusual is left to the single path, which reports the error
+                    let Ok(token) = Token::from_str(token) else {
+                        continue;
+                    };
+                    if !matches!(token.mint_url(), Ok(mint_url) if mint_url == self.inner.mint_url)
+                        || token.unit() != Some(self.inner.unit.clone())
+                    {
+                        continue;
+                    }
+                    if let Ok(token_proofs) = token.proofs(&keysets) {
+                        batched.push(index);
+                        proofs.extend(token_proofs);
+                    }
+                }
+
+                if batched.len() > 1 {
+                    let mut receive_options: ReceiveOptions = options.clone().try_into()?;
+                    receive_options.p2pk_signing_keys.push(self.p2pk_key.clone());
+                    if let Ok(received) =
+                        self.inner.receive_proofs(proofs, receive_options, None).await
+                    {
+                        amount = received;
+                        single.retain(|index| !batched.contains(index));
+                    }
+                }
+            }
+
+            let mut failed = Vec::new();
+            for index in single {
+                match self.receive_token(&tokens[index], options.clone()).await {
+                    Ok(result) => {
+                        amount = amount
+                            .checked_add(result.amount.into())
+                            .ok_or_else(|| FFIError::InternalError {
+                                msg: "Received amount overflows".to_string(),
+                                code: NO_ERROR_CODE,
+                            })?;
+                    }
+                    Err(err) => failed.push(FFIReceiveError {
+                        index: index as u32,
+                        error: err.to_string(),
+                    }),
+                }
+            }
+
+            Ok(FFIReceiveBatchResult {
+                amount: amount.into(),
+                failed,
+            })
+        })
+    }
+
+    /// Store a token's proofs as they are, without contacting the mint at all
+    /// Only for transfers between wallets of the same owner: the sender keeps the secrets and
+    /// the proofs may already be spent, which shows only when they are spent from here
+    /// Tokens from another mint or unit, locked with P2PK or HTLC, or with a keyset missing from
+    /// the store are InvalidInput
+    pub fn receive_offline(&self, token: String) -> Result<FFIAmount> {
+        self.block_on(async {
+            let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid token: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            let amount = self.store_unswapped(&token, false).await?;
+            Ok(amount.into())
+        })
+    }
+
+    /// Swap stored proofs with the mint to consolidate them into the given split
+    /// Without an amount every unspent proof is swapped, returns the amount after fees
+    pub fn swap(
+        &self,
+        amount: Option<FFIAmount>,
+        split_target: FFISplitTarget,
+    ) -> Result<FFIAmount> {
+        self.block_on(async {
+            let proofs = self.inner.get_unspent_proofs().await?;
+            let input_proofs = match amount {
+                Some(amount) => {
+                    let active_keyset_ids = self
+                        .inner
+                        .get_active_mint_keysets()
+                        .await?
+                        .into_iter()
+                        .map(|keyset| keyset.id)
+                        .collect();
+                    let keyset_fees = self.inner.get_keyset_fees().await?;
+                    CdkWallet::select_proofs(
+                        amount.into(),
+                        proofs,
+                        &active_keyset_ids,
+                        &keyset_fees,
+                        true,
+                    )?
+                }
+                None => proofs,
+            };
+
+            if input_proofs.is_empty() {
+                return Ok(Amount::ZERO.into());
+            }
+
+            let input_amount = input_proofs.total_amount()?;
+            let fee = self.inner.get_proofs_fee(&input_proofs).await?;
+            self.inner
+                .swap(None, split_target.into(), input_proofs, None, false)
+                .await
+                .map_err(|e| FFIError::NetworkError {
+                    msg: e.to_string(),
+                    code: nut_error_code(e),
+                })?;
+
+            Ok(input_amount.checked_sub(fee).unwrap_or(Amount::ZERO).into())
+        })
+    }
+
+    /// Take back the proofs of a sent token the recipient has not redeemed yet
+    /// The proofs are swapped for fresh ones, returns the amount reclaimed after fees
+    pub fn reclaim_send(&self, token: String) -> Result<FFIAmount> {
+        self.block_on(async {
+            let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid token: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            if token.mint_url()? != self.inner.mint_url {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token is from a different mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let keysets = self.inner.get_mint_keysets().await?;
+            let proofs = token.proofs(&keysets)?;
+            let states = self.inner.check_proofs_spent(proofs.clone()).await?;
+            if states.iter().any(|proof_state| proof_state.state != State::Unspent) {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token was already redeemed by the recipient".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let input_amount = proofs.total_amount()?;
+            let fee = self.inner.get_proofs_fee(&proofs).await?;
+            self.inner
+                .swap(None, SplitTarget::default(), proofs, None, false)
+                .await
+                .map_err(|e| FFIError::NetworkError {
+                    msg: e.to_string(),
+                    code: nut_error_code(e),
+                })?;
+
+            Ok(input_amount.checked_sub(fee).unwrap_or(Amount::ZERO).into())
+        })
+    }
+
+    /// Check with the mint whether a received token was already redeemed, before receiving it
+    /// Proofs pending at the mint count as spent since they cannot be redeemed either
+    pub fn token_state(&self, token: String) -> Result<FFITokenState> {
+        self.block_on_read(async {
+            let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid token: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            if token.mint_url()? != self.inner.mint_url {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token is from a different mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let keysets = self.inner.get_mint_keysets().await?;
+            let proofs = token.proofs(&keysets)?;
+            let states = self.inner.check_proofs_spent(proofs).await?;
+            let unspent = states
+                .iter()
+                .filter(|proof_state| proof_state.state == State::Unspent)
+                .count();
+
+            Ok(match unspent {
+                0 => FFITokenState::Spent,
+                n if n == states.len() => FFITokenState::Spendable,
+                _ => FFITokenState::PartiallySpent,
+            })
+        })
+    }
+
+    /// Ask the mint for the state of every stored proof (NUT-07)
+    /// Proofs the mint reports as spent are marked spent in the database
+    pub fn check_proof_states(&self) -> Result<Vec<FFIProofState>> {
+        self.block_on_read(async {
+            let proofs: Vec<_> = self
+                .inner
+                .localstore
+                .get_proofs(
+                    Some(self.inner.mint_url.clone()),
+                    Some(self.inner.unit.clone()),
+                    None,
+                    None,
+                )
+                .await?
+                .into_iter()
+                .map(|proof_info| proof_info.proof)
+                .collect();
+
+            if proofs.is_empty() {
+                return Ok(vec![]);
+            }
+
+            let states = self.inner.check_proofs_spent(proofs).await?;
+            Ok(states.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// Check every stored proof against the mint (NUT-07) to repair the store after a crash:
+    /// proofs the mint reports spent are deleted, and pending ones it reports unspent become
+    /// spendable again. Reserved proofs are left to the send or lock that reserved them
+    pub fn reconcile(&self) -> Result<FFIReconcileResult> {
+        self.block_on(async {
+            let balance_before = self.inner.total_balance().await?;
+            let proofs = self
+                .inner
+                .localstore
+                .get_proofs(
+                    Some(self.inner.mint_url.clone()),
+                    Some(self.inner.unit.clone()),
+                    Some(vec![State::Unspent, State::Pending, State::Reserved]),
+                    None,
+                )
+                .await?;
+            if proofs.is_empty() {
+                return Ok(FFIReconcileResult {
+                    balance_before: balance_before.into(),
+                    balance_after: balance_before.into(),
+                    proofs_removed: 0,
+                    proofs_restored: 0,
+                });
+            }
+
+            let stored_states: HashMap<_, _> =
+                proofs.iter().map(|proof_info| (proof_info.y, proof_info.state)).collect();
+            let states = self
+                .inner
+                .check_proofs_spent(proofs.into_iter().map(|proof_info| proof_info.proof).collect())
+                .await?;
+
+            let mut spent = Vec::new();
+            let mut restored = Vec::new();
+            for proof_state in states {
+                match (proof_state.state, stored_states.get(&proof_state.y)) {
+                    (State::Spent, _) => spent.push(proof_state.y),
+                    (State::Unspent, Some(State::Pending)) => restored.push(proof_state.y),
+                    _ => {}
+                }
+            }
+            let proofs_removed = spent.len() as u64;
+            let proofs_restored = restored.len() as u64;
+            if !spent.is_empty() {
+                self.inner.localstore.update_proofs(vec![], spent).await?;
+            }
+            if !restored.is_empty() {
+                self.inner
+                    .localstore
+                    .update_proofs_state(restored, State::Unspent)
+                    .await?;
+            }
+
+            let balance_after = self.inner.total_balance().await?;
+            Ok(FFIReconcileResult {
+                balance_before: balance_before.into(),
+                balance_after: balance_after.into(),
+                proofs_removed,
+                proofs_restored,
+            })
+        })
+    }
+
+    /// List the stored proofs of this wallet, optionally only those in one state
+    /// The secrets allow spending the proofs, so the result must not leave the device
+    pub fn list_proofs(&self, state: Option<FFIProofStateFilter>) -> Result<Vec<FFIProof>> {
+        self.block_on(async {
+            let proofs = self
+                .inner
+                .localstore
+                .get_proofs(
+                    Some(self.inner.mint_url.clone()),
+                    Some(self.inner.unit.clone()),
+                    state.map(Into::into),
+                    None,
+                )
+                .await?;
+            Ok(proofs.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// Keep proofs out of coin selection, e.g. to hold them for a later offline payment
+    /// Locked proofs count as reserved until `unlock_proofs`. A secret that is not an unspent
+    /// proof of this wallet is InvalidInput, proofs that are already locked stay locked
+    pub fn lock_proofs(&self, secrets: Vec<String>) -> Result<()> {
+        self.block_on(async {
+            let locked = self.locked_ys()?;
+            let mut ys = Vec::new();
+            for proof_info in self.proofs_by_secret(&secrets).await? {
+                if locked.contains(&proof_info.y.to_string()) {
+                    continue;
+                }
+                if proof_info.state != State::Unspent {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!("Proof {} is reserved by a send", proof_info.y),
+                        code: NO_ERROR_CODE,
+                    });
+                }
+                ys.push(proof_info.y);
+            }
+            if ys.is_empty() {
+                return Ok(());
+            }
+
+            // The lock rows go first, so a proof is never reserved without one. Reserved proofs
+            // without a lock or a prepared send are released when the wallet is opened again
+            self.set_locked(&ys, true)?;
+            let result = self
+                .inner
+                .localstore
+                .update_proofs_state(ys.clone(), State::Reserved)
+                .await;
+            if let Err(e) = result {
+                self.set_locked(&ys, false)?;
+                return Err(e.into());
+            }
+            Ok(())
+        })
+    }
+
+    /// Return proofs locked with `lock_proofs` to coin selection
+    /// A secret of a proof that is not locked is InvalidInput
+    pub fn unlock_proofs(&self, secrets: Vec<String>) -> Result<()> {
+        self.block_on(async {
+            let locked = self.locked_ys()?;
+            let mut ys = Vec::new();
+            for proof_info in self.proofs_by_secret(&secrets).await? {
+                if !locked.contains(&proof_info.y.to_string()) {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!("Proof {} is not locked", proof_info.y),
+                        code: NO_ERROR_CODE,
+                    });
+                }
+                ys.push(proof_info.y);
+            }
+            if ys.is_empty() {
+                return Ok(());
+            }
+
+            // Without its lock row a proof left reserved is released when the wallet is opened
+            self.set_locked(&ys, false)?;
+            let result = self
+                .inner
+                .localstore
+                .update_proofs_state(ys.clone(), State::Unspent)
+                .await;
+            if let Err(e) = result {
+                self.set_locked(&ys, true)?;
+                return Err(e.into());
+            }
+            Ok(())
+        })
+    }
+
+    pub fn balance(&self) -> Result<FFIAmount> {
+        self.block_on(async {
+            let balance = self.inner.total_balance().await?;
+            Ok(balance.into())
+        })
+    }
+
+    /// Total of proofs in the pending state, e.g. inputs of a melt still in flight
+    pub fn pending_balance(&self) -> Result<FFIAmount> {
+        self.block_on(async {
+            let balance = self.inner.total_pending_balance().await?;
+            Ok(balance.into())
+        })
+    }
+
+    /// Total of proofs reserved by a prepared send that has not been redeemed or reclaimed
+    pub fn reserved_balance(&self) -> Result<FFIAmount> {
+        self.block_on(async {
+            let balance = self.inner.total_reserved_balance().await?;
+            Ok(balance.into())
+        })
+    }
+
+    /// Spendable, pending and reserved balance read in one pass over the store, so the figures
+    /// agree with each other even while other calls move proofs
+    pub fn balance_snapshot(&self) -> Result<FFIBalanceSnapshot> {
+        self.block_on(async {
+            let proofs = self
+                .inner
+                .localstore
+                .get_proofs(
+                    Some(self.inner.mint_url.clone()),
+                    Some(self.inner.unit.clone()),
+                    Some(vec![State::Unspent, State::Pending, State::Reserved]),
+                    None,
+                )
+                .await?;
+
+            let overflow = || FFIError::InternalError {
+                msg: "Balance overflows".to_string(),
+                code: NO_ERROR_CODE,
+            };
+            let mut spendable = Amount::ZERO;
+            let mut pending = Amount::ZERO;
+            let mut reserved = Amount::ZERO;
+            for proof_info in &proofs {
+                let figure = match proof_info.state {
+                    State::Unspent => &mut spendable,
+                    State::Pending => &mut pending,
+                    _ => &mut reserved,
+                };
+                *figure = figure.checked_add(proof_info.proof.amount).ok_or_else(overflow)?;
+            }
+            let total = spendable
+                .checked_add(pending)
+                .and_then(|total| total.checked_add(reserved))
+                .ok_or_else(overflow)?;
+
+            Ok(FFIBalanceSnapshot {
+                total: total.into(),
+                spendable: spendable.into(),
+                pending: pending.into(),
+                reserved: reserved.into(),
+            })
+        })
+    }
+
+    /// Number of unspent proofs held of each denomination, by amount
+    /// Many small proofs make sends swap more often, a sign the wallet could consolidate
+    pub fn denomination_breakdown(&self) -> Result<HashMap<u64, u32>> {
+        self.block_on(async {
+            let proofs = self
+                .inner
+                .localstore
+                .get_proofs(
+                    Some(self.inner.mint_url.clone()),
+                    Some(self.inner.unit.clone()),
+                    Some(vec![State::Unspent]),
+                    None,
+                )
+                .await?;
+
+            let mut breakdown = HashMap::new();
+            for proof_info in &proofs {
+                *breakdown.entry(u64::from(proof_info.proof.amount)).or_insert(0u32) += 1;
+            }
+            Ok(breakdown)
+        })
+    }
+
+    pub fn mint_url(&self) -> String {
+        self.inner.mint_url.to_string()
+    }
+
+    /// Move the wallet's stored proofs, quotes and mint info to the URL its mint migrated to
+    /// The mint answering there must have the pubkey stored for this mint, else InvalidInput
+    /// Every later call on this wallet fails, reopen it at the new URL to keep using it
+    pub fn update_mint_url(&self, new_url: String) -> Result<()> {
+        let new_url = MintUrl::from_str(&new_url).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid mint URL: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+        self.block_on(async {
+            let old_url = self.inner.mint_url.clone();
+            if new_url == old_url {
+                return Ok(());
+            }
+
+            let stored = self.inner.localstore.get_mint(old_url.clone()).await?;
+            let pubkey = stored
+                .and_then(|info| info.pubkey)
+                .ok_or_else(|| FFIError::InvalidInput {
+                    msg: format!("No pubkey stored for {}, cannot verify the new URL", old_url),
+                    code: NO_ERROR_CODE,
+                })?;
+            let info = fetch_mint_info(&self.http, &new_url).await?;
+            if info.pubkey != Some(pubkey) {
+                return Err(FFIError::InvalidInput {
+                    msg: format!("Mint at {} is not the mint at {}", new_url, old_url),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            self.inner.localstore.update_mint_url(old_url, new_url.clone()).await?;
+            *self.moved_to.lock().unwrap_or_else(|e| e.into_inner()) = Some(new_url);
+            Ok(())
+        })
+    }
+
+    pub fn unit(&self) -> String {
+        self.inner.unit.to_string()
+    }
+
+    /// Fetch and initialize mint information
+    /// This should be called after wallet creation to set up the mint in the database
+    pub fn get_mint_info(&self) -> Result<String> {
+        self.block_on_read(async {
+            // First try to get existing mint info from database
+            match self.with_retry(|| self.inner.get_mint_info()).await? {
+                Some(mint_info) => {
+                    let name = mint_info.name.unwrap_or_else(|| "Unknown Mint".to_string());
+                    Ok(format!("Mint info already initialized: {}", name))
+                },
+                None => {
+                    // Mint info not in database, try to fetch and initialize
+                    // First get the mint keysets which should also fetch mint info
+                    match self.with_retry(|| self.inner.get_mint_keysets()).await {
+                        Ok(keysets) => {
+                            // Check if mint info is now available
+                            match self.with_retry(|| self.inner.get_mint_info()).await? {
+                                Some(mint_info) => {
+                                    let name = mint_info.name.unwrap_or_else(|| "Unknown Mint".to_string());
+                                    Ok(format!("Mint info fetched and initialized: {} (keysets: {})", 
+                                              name, keysets.len()))
+                                },
+                                None => {
+                                    Ok(format!("Mint keysets loaded ({} keysets) but mint info still not available", 
+                                              keysets.len()))
+                                }
+                            }
+                        },
+                        Err(e) => {
+                            Ok(format!("Failed to get mint keysets: {}", e))
+                        }
+                    }
+                }
+            }
+        })
+    }
+
+    /// Verify the mint's DLEQ proofs (NUT-12) on a token using the keys stored for this mint
+    /// No request is made, so the mint's keysets must have been loaded before
+    pub fn verify_token_dleq(&self, token: String) -> Result<bool> {
+        self.block_on(async {
+            let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid token: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            if token.mint_url()? != self.inner.mint_url {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token is from a different mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let keysets = self
+                .inner
+                .localstore
+                .get_mint_keysets(self.inner.mint_url.clone())
+                .await?
+                .unwrap_or_default();
+            let mut keys = HashMap::new();
+            for keyset in &keysets {
+                if let Some(keyset_keys) = self.inner.localstore.get_keys(&keyset.id).await? {
+                    keys.insert(keyset.id, keyset_keys);
+                }
+            }
+
+            let proofs = token.proofs(&keysets)?;
+            verify_proofs_dleq(&proofs, &keys)
+        })
+    }
+
+    /// List every keyset the mint has announced, active or not, with its input fee
+    pub fn list_keysets(&self) -> Result<Vec<FFIKeysetInfo>> {
+        self.block_on_read(async {
+            let keysets = self.with_retry(|| self.inner.get_mint_keysets()).await?;
+            Ok(keysets.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// NUT-13 derivation counter of every stored keyset of this wallet's unit, by keyset id
+    /// The counter is the index of the next secret the wallet derives for that keyset
+    pub fn keyset_counters(&self) -> Result<HashMap<String, u32>> {
+        self.block_on(async {
+            let mut counters = HashMap::new();
+            for keyset in self.stored_keysets().await? {
+                let counter = self.inner.localstore.get_keyset_counter(&keyset.id).await?;
+                counters.insert(keyset.id.to_string(), counter.unwrap_or(0));
+            }
+            Ok(counters)
+        })
+    }
+
+    /// Move the NUT-13 derivation counter of a keyset forward, e.g. past secrets a restore
+    /// missed. Lowering it would reuse secrets the mint already signed and is InvalidInput,
+    /// as is a keyset this wallet has not stored
+    pub fn set_keyset_counter(&self, keyset_id: String, counter: u32) -> Result<()> {
+        let id = Id::from_str(&keyset_id).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid keyset id: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+        self.block_on(async {
+            if !self.stored_keysets().await?.iter().any(|keyset| keyset.id == id) {
+                return Err(FFIError::InvalidInput {
+                    msg: format!("Unknown keyset: {}", keyset_id),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let current = self.inner.localstore.get_keyset_counter(&id).await?.unwrap_or(0);
+            if counter < current {
+                return Err(FFIError::InvalidInput {
+                    msg: format!(
+                        "Counter of keyset {} is {}, lowering it to {} would reuse secrets",
+                        keyset_id, current, counter
+                    ),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            // The store only increments counters
+            if counter > current {
+                self.inner
+                    .localstore
+                    .increment_keyset_counter(&id, counter - current)
+                    .await?;
+            }
+            Ok(())
+        })
+    }
+
+    /// Fetch the mint's keysets and swap unspent proofs of keysets it no longer keeps active,
+    /// so they stay spendable after a key rotation. The swap pays the input fee of those proofs
+    pub fn refresh_keysets(&self) -> Result<FFIKeysetRefreshResult> {
+        self.block_on(async {
+            let known: HashSet<Id> = self
+                .inner
+                .localstore
+                .get_mint_keysets(self.inner.mint_url.clone())
+                .await?
+                .unwrap_or_default()
+                .into_iter()
+                .map(|keyset| keyset.id)
+                .collect();
+
+            let keysets = self.inner.get_mint_keysets().await?;
+            let new_keysets = keysets.iter().filter(|keyset| !known.contains(&keyset.id)).count();
+            let active: HashSet<Id> = keysets
+                .iter()
+                .filter(|keyset| keyset.active && keyset.unit == self.inner.unit)
+                .map(|keyset| keyset.id)
+                .collect();
+
+            let retired: Vec<Proof> = self
+                .inner
+                .get_unspent_proofs()
+                .await?
+                .into_iter()
+                .filter(|proof| !active.contains(&proof.keyset_id))
+                .collect();
+            let proofs_migrated = retired.len();
+            if !retired.is_empty() {
+                self.inner
+                    .swap(None, SplitTarget::default(), retired, None, false)
+                    .await?;
+            }
+
+            Ok(FFIKeysetRefreshResult {
+                new_keysets: new_keysets as u32,
+                proofs_migrated: proofs_migrated as u32,
+            })
+        })
+    }
+
+    /// Fetch the mint's NUT-06 info as a structured record
+    pub fn mint_info(&self) -> Result<FFIMintInfo> {
+        self.block_on_read(async {
+            let mint_info = self
+                .with_retry(|| self.inner.get_mint_info())
+                .await?
+                .ok_or_else(|| FFIError::NetworkError {
+                    msg: "Mint did not return its info".to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            Ok(mint_info.into())
+        })
+    }
+
+    /// List unpaid mint and melt quotes with the seconds left until they expire
+    /// Expired quotes are skipped and the soonest to expire comes first. CDK does not record the
+    /// mint of a melt quote, so with a store shared across mints the melt quotes of every mint in
+    /// this unit are listed
+    pub fn pending_quote_expiries(&self) -> Result<Vec<FFIQuoteExpiry>> {
+        self.block_on(async {
+            let now = unix_time();
+            let mut expiries = Vec::new();
+
+            for quote in self.inner.localstore.get_mint_quotes().await? {
+                if quote.mint_url != self.inner.mint_url
+                    || quote.unit != self.inner.unit
+                    || quote.state != MintQuoteState::Unpaid
+                    || quote.expiry <= now
+                {
+                    continue;
+                }
+                expiries.push(FFIQuoteExpiry {
+                    quote_id: quote.id,
+                    kind: FFIQuoteKind::Mint,
+                    expires_in: quote.expiry - now,
+                });
+            }
+
+            for quote in self.inner.localstore.get_melt_quotes().await? {
+                if quote.unit != self.inner.unit
+                    || quote.state != MeltQuoteState::Unpaid
+                    || quote.expiry <= now
+                {
+                    continue;
+                }
+                expiries.push(FFIQuoteExpiry {
+                    quote_id: quote.id,
+                    kind: FFIQuoteKind::Melt,
+                    expires_in: quote.expiry - now,
+                });
+            }
+
+            expiries.sort_by_key(|expiry| expiry.expires_in);
+            Ok(expiries)
+        })
+    }
+
+    /// Mint quotes in the store still to be finished, so an app can resume waiting for them
+    /// after a restart: unpaid ones that have not expired, and paid ones not yet minted
+    pub fn pending_mint_quotes(&self) -> Result<Vec<FFIMintQuote>> {
+        self.block_on(async {
+            let now = unix_time();
+            let mut quotes: Vec<_> = self
+                .inner
+                .localstore
+                .get_mint_quotes()
+                .await?
+                .into_iter()
+                .filter(|quote| {
+                    quote.mint_url == self.inner.mint_url
+                        && quote.unit == self.inner.unit
+                        && match quote.state {
+                            MintQuoteState::Unpaid => quote.expiry > now,
+                            MintQuoteState::Paid => true,
+                            _ => false,
+                        }
+                })
+                .collect();
+            quotes.sort_by_key(|quote| quote.expiry);
+            Ok(quotes.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// Melt quotes in the store still to be finished, so an app can show them after a restart:
+    /// unpaid ones that have not expired, and ones whose payment is still in flight
+    /// CDK does not record the mint of a melt quote, so with a store shared across mints this
+    /// lists the quotes of every mint in this unit
+    pub fn pending_melt_quotes(&self) -> Result<Vec<FFIMeltQuote>> {
+        self.block_on(async {
+            let now = unix_time();
+            let mut quotes: Vec<_> = self
+                .inner
+                .localstore
+                .get_melt_quotes()
+                .await?
+                .into_iter()
+                .filter(|quote| {
+                    quote.unit == self.inner.unit
+                        && match quote.state {
+                            MeltQuoteState::Unpaid => quote.expiry > now,
+                            MeltQuoteState::Pending => true,
+                            _ => false,
+                        }
+                })
+                .collect();
+            quotes.sort_by_key(|quote| quote.expiry);
+            Ok(quotes.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// Create a melt quote for paying a Lightning invoice
+    pub fn melt_quote(&self, request: String) -> Result<FFIMeltQuote> {
+        self.block_on_read(async {
+            if !self.cached_availability().await?.melt {
+                return Err(FFIError::OperationDisabled {
+                    msg: "Melting is disabled at this mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            let quote = self.inner.melt_quote(request, None).await?;
+            Ok(quote.into())
+        })
+    }
+
+    /// Create a NUT-15 multi-path melt quote paying only `partial_amount` of the invoice
+    /// The caller is responsible for quoting and paying the other parts at other mints,
+    /// the invoice only settles once every part is paid
+    pub fn melt_quote_mpp(
+        &self,
+        request: String,
+        partial_amount: FFIAmount,
+    ) -> Result<FFIMeltQuote> {
+        self.block_on_read(async {
+            let mint_info = self
+                .inner
+                .get_mint_info()
+                .await?
+                .ok_or_else(|| FFIError::NetworkError {
+                    msg: "Mint did not return its info".to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            if !mint_info
+                .nuts
+                .nut15
+                .methods
+                .iter()
+                .any(|method| method.unit == self.inner.unit)
+            {
+                return Err(FFIError::InvalidInput {
+                    msg: format!(
+                        "Mint does not support multi-path payments in {}",
+                        self.inner.unit
+                    ),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            // NUT-15 partial amounts are always in millisatoshi
+            let amount_msat = Amount::from(partial_amount)
+                .convert_unit(&self.inner.unit, &CurrencyUnit::Msat)
+                .map_err(|e| FFIError::InvalidInput {
+                    msg: e.to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+            let quote = self
+                .inner
+                .melt_quote(request, Some(MeltOptions::new_mpp(amount_msat)))
+                .await?;
+            Ok(quote.into())
+        })
+    }
+
+    /// Input fee (NUT-02) the mint charges for swapping a token's proofs when it is received,
+    /// computed from the stored keysets without contacting the mint
+    /// Tokens from another mint or with a keyset this wallet has not stored are InvalidInput
+    pub fn estimate_receive_fee(&self, token: String) -> Result<FFIAmount> {
+        self.block_on(async {
+            let token = Token::from_str(&token).map_err(|e| FFIError::InvalidInput {
+                msg: format!("Invalid token: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            if token.mint_url()? != self.inner.mint_url {
+                return Err(FFIError::InvalidInput {
+                    msg: "Token is from a different mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let keysets = self.stored_keysets().await?;
+            let unknown_keyset = || FFIError::InvalidInput {
+                msg: "Token uses a keyset this wallet does not know".to_string(),
+                code: NO_ERROR_CODE,
+            };
+            let proofs = token.proofs(&keysets).map_err(|_| unknown_keyset())?;
+            let mut fee_ppk = 0;
+            for proof in &proofs {
+                let keyset = keysets
+                    .iter()
+                    .find(|keyset| keyset.id == proof.keyset_id)
+                    .ok_or_else(unknown_keyset)?;
+                fee_ppk += keyset.input_fee_ppk;
+            }
+            Ok(Amount::from(fee_ppk.div_ceil(1000)).into())
+        })
+    }
+
+    /// Estimate the fee reserve a melt quote for this invoice would ask for, without creating one
+    /// The rate is the one set with `set_fee_reserve_percent`, otherwise it is taken from the
+    /// largest earlier melt quote in this unit, or CDK's mint default
+    pub fn estimate_melt_fee(&self, request: String) -> Result<FFIAmount> {
+        self.block_on_read(async {
+            let amount = u64::from(invoice_amount(&request, &self.inner.unit)?);
+
+            let reference = self
+                .inner
+                .localstore
+                .get_melt_quotes()
+                .await?
+                .into_iter()
+                .filter(|quote| quote.unit == self.inner.unit && quote.amount > Amount::ZERO)
+                .max_by_key(|quote| quote.amount);
+            let percent = *self.fee_reserve_percent.lock().unwrap_or_else(|e| e.into_inner());
+            let overflow = || FFIError::InvalidInput {
+                msg: "Invoice amount too large to estimate a fee for".to_string(),
+                code: NO_ERROR_CODE,
+            };
+            // A rate set by the app is taken as is, even 0, the mint's minimum only applies to
+            // the learned and default rates
+            let estimate = match (percent, reference) {
+                (Some(percent), _) => (amount as f64 * percent / 100.0).ceil() as u64,
+                (None, Some(quote)) => u64::try_from(
+                    (u128::from(amount) * u128::from(u64::from(quote.fee_reserve)))
+                        .div_ceil(u128::from(u64::from(quote.amount))),
+                )
+                .map_err(|_| overflow())?
+                .max(DEFAULT_FEE_RESERVE_MIN),
+                (None, None) => amount
+                    .checked_mul(DEFAULT_FEE_RESERVE_PERCENT)
+                    .ok_or_else(overflow)?
+                    .div_ceil(100)
+                    .max(DEFAULT_FEE_RESERVE_MIN),
+            };
+
+            Ok(Amount::from(estimate).into())
+        })
+    }
+
+    /// Set the Lightning fee reserve, in percent of the amount, that `estimate_melt_fee` uses
+    /// instead of the rate learned from earlier melt quotes. Only the local estimate changes:
+    /// melts still reserve what the mint quotes. It is kept in the local store for this
+    /// wallet's mint and unit, so it survives reopening the wallet
+    pub fn set_fee_reserve_percent(&self, percent: f64) -> Result<()> {
+        if !(0.0..=100.0).contains(&percent) {
+            return Err(FFIError::InvalidInput {
+                msg: format!("Fee reserve percent must be between 0 and 100, got {}", percent),
+                code: NO_ERROR_CODE,
+            });
+        }
+        self.store_setting(FEE_RESERVE_PERCENT_SETTING, &percent.to_string())?;
+        *self.fee_reserve_percent.lock().unwrap_or_else(|e| e.into_inner()) = Some(percent);
+        Ok(())
+    }
+
+    /// Estimate rate set with `set_fee_reserve_percent`, none while the learned rate is used
+    pub fn fee_reserve_percent(&self) -> Option<f64> {
+        *self.fee_reserve_percent.lock().unwrap_or_else(|e| e.into_inner())
+    }
+
+    /// Retry failed requests of `get_mint_info`, `mint_info`, `mint_quote_state`,
+    /// `mint_quote_states` and `list_keysets` up to `max_retries` times, waiting `base_delay_ms`
+    /// and then twice as long before each further retry. Only failures that never reached the
+    /// mint or got no mint error back, such as a timeout or a 502, are retried. Calls that move
+    /// funds are never retried. The request timeout bounds the whole call, retries included
+    pub fn set_retry_policy(&self, max_retries: u8, base_delay_ms: u64) {
+        *self.retry_policy.lock().unwrap_or_else(|e| e.into_inner()) = RetryPolicy {
+            max_retries,
+            base_delay_ms,
+        };
+    }
+
+    /// Fail quote, state and mint info calls that take longer than `timeout_secs` with
+    /// `FFIError::Timeout`, so an unreachable mint cannot hang the caller. 0 removes the limit,
+    /// which is the default. Calls that move proofs, such as send, receive, melt, swap and
+    /// mint, are never cut short, since that could lose signed outputs or leave proofs reserved
+    /// `wait_for_mint_quote_paid` keeps its own timeout
+    pub fn set_request_timeout(&self, timeout_secs: u64) {
+        let timeout = (timeout_secs > 0).then(|| Duration::from_secs(timeout_secs));
+        *self.request_timeout.lock().unwrap_or_else(|e| e.into_inner()) = timeout;
+    }
+
+    /// Hold the proofs of sends prepared from now on for `ttl_secs` before `confirm_send`
+    /// refuses them and they return to the balance. Ten minutes unless set
+    pub fn set_prepared_send_ttl(&self, ttl_secs: u64) {
+        *self.prepared_send_ttl.lock().unwrap_or_else(|e| e.into_inner()) =
+            Duration::from_secs(ttl_secs);
+    }
+
+    /// For tests only: order coin selection by `seed`, so the same proofs and seed always
+    /// select the same proofs and yield the same token. Secrets and blinding factors already
+    /// follow the wallet seed (NUT-13), P2PK and HTLC nonces stay random. Never enable this in
+    /// production, a predictable selection makes the wallet's payments easier to link
+    pub fn set_deterministic(&self, seed: u64) {
+        *self.deterministic_seed.lock().unwrap_or_else(|e| e.into_inner()) = Some(seed);
+    }
+
+    /// Set the clear auth token (NUT-21) for mints that protect endpoints
+    /// The wallet sends it on clear-auth endpoints and spends it to mint blind auth tokens
+    /// (NUT-22) for blind-auth ones. Mints without auth ignore it
+    pub fn set_auth_token(&self, token: String) -> Result<()> {
+        if token.trim().is_empty() {
+            return Err(FFIError::InvalidInput {
+                msg: "Auth token cannot be empty".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+        self.block_on(async {
+            // The auth wallet that holds the token is created once the mint info lists
+            // protected endpoints, so load it before handing the token over
+            self.inner.fetch_mint_info().await?;
+            self.inner.set_cat(token).await?;
+            Ok(())
+        })
+    }
+
+    /// Store an app defined value, such as a label, for this wallet's mint and unit
+    /// It is kept in the local store and replaces an earlier value under the same key
+    pub fn set_metadata(&self, key: String, value: String) -> Result<()> {
+        if key.is_empty() {
+            return Err(FFIError::InvalidInput {
+                msg: "Metadata key cannot be empty".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        connection.execute(
+            "INSERT INTO ffi_wallet_metadata (mint_url, unit, key, value) VALUES (?1, ?2, ?3, ?4)
+             ON CONFLICT (mint_url, unit, key) DO UPDATE SET value = excluded.value",
+            rusqlite::params![
+                self.inner.mint_url.to_string(),
+                self.inner.unit.to_string(),
+                key,
+                value
+            ],
+        )?;
+        Ok(())
+    }
+
+    /// Value stored with `set_metadata` under `key`, none if there is no such key
+    pub fn get_metadata(&self, key: String) -> Result<Option<String>> {
+        use rusqlite::OptionalExtension;
+
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let value = connection
+            .query_row(
+                "SELECT value FROM ffi_wallet_metadata
+                 WHERE mint_url = ?1 AND unit = ?2 AND key = ?3",
+                rusqlite::params![
+                    self.inner.mint_url.to_string(),
+                    self.inner.unit.to_string(),
+                    key
+                ],
+                |row| row.get(0),
+            )
+            .optional()?;
+        Ok(value)
+    }
+
+    /// Every value stored with `set_metadata` for this wallet's mint and unit
+    pub fn all_metadata(&self) -> Result<HashMap<String, String>> {
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let mut statement = connection.prepare(
+            "SELECT key, value FROM ffi_wallet_metadata WHERE mint_url = ?1 AND unit = ?2",
+        )?;
+        let rows = statement.query_map(
+            rusqlite::params![self.inner.mint_url.to_string(), self.inner.unit.to_string()],
+            |row| Ok((row.get(0)?, row.get(1)?)),
+        )?;
+        Ok(rows.collect::<std::result::Result<HashMap<_, _>, _>>()?)
+    }
+
+    /// Execute a melt operation (pay Lightning invoice)
+    pub fn melt(&self, quote_id: String) -> Result<FFIMelted> {
+        self.block_on(async {
+            match self.inner.melt(&quote_id).await {
+                Ok(result) => Ok(result.into()),
+                Err(cdk::error::Error::InsufficientFunds) => {
+                    let quote = self
+                        .inner
+                        .localstore
+                        .get_melt_quote(&quote_id)
+                        .await?
+                        .ok_or_else(|| FFIError::InvalidInput {
+                            msg: format!("Unknown melt quote: {}", quote_id),
+                            code: NO_ERROR_CODE,
+                        })?;
+                    Err(self.insufficient_funds(quote.amount + quote.fee_reserve).await)
+                }
+                Err(e) => Err(e.into()),
+            }
+        })
+    }
+
+    /// Execute a melt like `melt`, but only if the quote's fee reserve is at most `max_fee`
+    /// Fails with `FFIError::FeeTooHigh` before anything is paid otherwise
+    pub fn melt_with_max_fee(&self, quote_id: String, max_fee: FFIAmount) -> Result<FFIMelted> {
+        let fee_reserve = self.block_on(async {
+            let quote = self
+                .inner
+                .localstore
+                .get_melt_quote(&quote_id)
+                .await?
+                .ok_or_else(|| FFIError::InvalidInput {
+                    msg: format!("Unknown melt quote: {}", quote_id),
+                    code: NO_ERROR_CODE,
+                })?;
+            Ok(quote.fee_reserve)
+        })?;
+        check_max_fee(fee_reserve, max_fee.into())?;
+
+        self.melt(quote_id)
+    }
+
+    /// Export the unspent proofs of this wallet as a blob encrypted with `passphrase`
+    /// Reserved and pending proofs are not included
+    pub fn export_proofs(&self, passphrase: String) -> Result<Vec<u8>> {
+        if passphrase.is_empty() {
+            return Err(FFIError::InvalidInput {
+                msg: "Passphrase is empty".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+
+        self.block_on(async {
+            let proofs = self.inner.get_unspent_proofs().await?;
+            if proofs.is_empty() {
+                return Err(FFIError::InvalidInput {
+                    msg: "No unspent proofs to export".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            let token = Token::new(
+                self.inner.mint_url.clone(),
+                proofs,
+                None,
+                self.inner.unit.clone(),
+            );
+            encrypt_backup(token.to_string().as_bytes(), &passphrase)
+        })
+    }
+
+    /// Receive the proofs of a blob written by `export_proofs`, returning the amount recovered
+    /// The proofs are swapped like a received token so the blob cannot be replayed
+    pub fn import_proofs(&self, blob: Vec<u8>, passphrase: String) -> Result<FFIAmount> {
+        let token = String::from_utf8(decrypt_backup(&blob, &passphrase)?).map_err(|_| {
+            FFIError::InvalidInput {
+                msg: "Wrong passphrase or corrupt backup".to_string(),
+                code: NO_ERROR_CODE,
+            }
+        })?;
+
+        self.block_on(async {
+            let options = ReceiveOptions {
+                p2pk_signing_keys: vec![self.p2pk_key.clone()],
+                ..Default::default()
+            };
+            let amount = self.inner.receive(&token, options).await?;
+            Ok(amount.into())
+        })
+    }
+
+    /// List the transactions recorded for this wallet's mint, newest first
+    /// The optional filter limits the result to one direction and/or unit
+    pub fn list_transactions(
+        &self,
+        filter: Option<FFITransactionFilter>,
+    ) -> Result<Vec<FFITransaction>> {
+        self.block_on(async {
+            let (direction, unit) = match filter {
+                Some(filter) => (
+                    filter.direction.map(Into::into),
+                    filter.unit.map(|unit| CurrencyUnit::from_str(&unit)).transpose()?,
+                ),
+                None => (None, None),
+            };
+
+            let transactions = self.transactions_newest_first(direction, unit).await?;
+            Ok(transactions.into_iter().map(Into::into).collect())
+        })
+    }
+
+    /// One page of the unfiltered `list_transactions`, for paging through long histories
+    /// Transactions recorded while paging push the older ones back by as many places. A file
+    /// store reads only the page, an in-memory store sorts its whole history for every page
+    pub fn list_transactions_page(&self, offset: u32, limit: u32) -> Result<Vec<FFITransaction>> {
+        self.block_on(async {
+            let Some(ids) = self.transaction_page_ids(offset, limit)? else {
+                let transactions = self.transactions_newest_first(None, None).await?;
+                return Ok(transactions
+                    .into_iter()
+                    .skip(offset as usize)
+                    .take(limit as usize)
+                    .map(Into::into)
+                    .collect());
+            };
+            let mut page = Vec::with_capacity(ids.len());
+            for id in ids {
+                // A transaction removed since its id was read is left out
+                if let Some(transaction) = self.inner.localstore.get_transaction(id).await? {
+                    page.push(transaction.into());
+                }
+            }
+            Ok(page)
+        })
+    }
+
+    /// Sum the transaction history between two unix timestamps (inclusive)
+    /// Incoming amounts are already net of fees, so only outgoing fees reduce `net`. Fails
+    /// with `InternalError` if a total or `net` does not fit its type
+    pub fn net_flow(&self, since: u64, until: u64) -> Result<FFINetFlow> {
+        self.block_on(async {
+            let overflow = || FFIError::InternalError {
+                msg: "Net flow overflows".to_string(),
+                code: NO_ERROR_CODE,
+            };
+            let mut total_in = Amount::ZERO;
+            let mut total_out = Amount::ZERO;
+            let mut total_fees = Amount::ZERO;
+            let mut outgoing_fees = Amount::ZERO;
+
+            for transaction in self.inner.list_transactions(None).await? {
+                if transaction.timestamp < since || transaction.timestamp > until {
+                    continue;
+                }
+                total_fees = total_fees.checked_add(transaction.fee).ok_or_else(overflow)?;
+                match transaction.direction {
+                    TransactionDirection::Incoming => {
+                        total_in = total_in.checked_add(transaction.amount).ok_or_else(overflow)?;
+                    }
+                    TransactionDirection::Outgoing => {
+                        total_out =
+                            total_out.checked_add(transaction.amount).ok_or_else(overflow)?;
+                        outgoing_fees =
+                            outgoing_fees.checked_add(transaction.fee).ok_or_else(overflow)?;
+                    }
+                }
+            }
+
+            // Both sides fit an i128, only the difference can fall outside an i64
+            let net = i128::from(u64::from(total_in))
+                - i128::from(u64::from(total_out))
+                - i128::from(u64::from(outgoing_fees));
+            Ok(FFINetFlow {
+                total_in: total_in.into(),
+                total_out: total_out.into(),
+                total_fees: total_fees.into(),
+                net: i64::try_from(net).map_err(|_| overflow())?,
+            })
+        })
+    }
+
+    /// Report whether minting, melting and swapping are enabled in the mint's advertised settings
+    pub fn operation_availability(&self) -> Result<FFIOperationAvailability> {
+        self.block_on_read(async {
+            match self.inner.get_mint_info().await? {
+                Some(mint_info) => Ok((&mint_info).into()),
+                None => Err(FFIError::NetworkError {
+                    msg: "Mint info not available".to_string(),
+                    code: NO_ERROR_CODE,
+                }),
+            }
+        })
+    }
+
+    /// Collect a redacted snapshot of the wallet state for bug reports
+    /// Only counts and identifiers are included, never seeds, secrets or proofs. Tokens, auth
+    /// tokens and hex secrets are cut from the recent error messages
+    pub fn diagnostic_report(&self) -> Result<FFIDiagnostics> {
+        let recent_errors = self
+            .recent_errors
+            .lock()
+            .unwrap_or_else(|e| e.into_inner())
+            .iter()
+            .cloned()
+            .collect();
+
+        self.block_on(async {
+            let balance = self.inner.total_balance().await?;
+            let proof_count = self.inner.get_unspent_proofs().await?.len() as u64;
+            let keyset_ids = self
+                .inner
+                .localstore
+                .get_mint_keysets(self.inner.mint_url.clone())
+                .await?
+                .unwrap_or_default()
+                .into_iter()
+                .map(|keyset| keyset.id.to_string())
+                .collect();
+
+            Ok(FFIDiagnostics {
+                mint_url: self.inner.mint_url.to_string(),
+                unit: self.inner.unit.to_string(),
+                balance: balance.into(),
+                proof_count,
+                keyset_ids,
+                library_version: env!("CARGO_PKG_VERSION").to_string(),
+                cdk_version: CDK_VERSION.to_string(),
+                recent_errors,
+            })
+        })
+    }
+
+    /// Pay several Lightning invoices in order from the wallet balance, one entry per invoice
+    /// Fails with `OperationDisabled` if the mint disabled melting, and with
+    /// `InsufficientFunds` before anything is paid if the balance cannot cover the invoice
+    /// amounts and input fees or, once quoted, their fee reserves too, in which case the quotes
+    /// are removed again. An invoice that cannot be quoted or paid has its error in its entry
+    /// and the others are still paid, until one runs out of funds: later invoices are skipped
+    pub fn melt_batch(&self, requests: Vec<String>) -> Result<Vec<FFIMeltBatchEntry>> {
+        self.block_on(async {
+            if !self.cached_availability().await?.melt {
+                return Err(FFIError::OperationDisabled {
+                    msg: "Melting is disabled at this mint".to_string(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+            let overflow = || FFIError::InvalidInput {
+                msg: "Batch amount overflows".to_string(),
+                code: NO_ERROR_CODE,
+            };
+            let balance = self.inner.total_balance().await?;
+            let mut entries: Vec<_> = (0..requests.len())
+                .map(|index| FFIMeltBatchEntry {
+                    index: index as u32,
+                    melted: None,
+                    error: None,
+                })
+                .collect();
+
+            // Invoices carry their amount, so a batch the balance cannot cover is refused
+            // before the mint is asked for any quote
+            let mut amounts = Amount::ZERO;
+            let mut payable = Vec::with_capacity(requests.len());
+            for (index, request) in requests.iter().enumerate() {
+                match invoice_amount(request, &self.inner.unit) {
+                    Ok(amount) => {
+                        amounts = amounts.checked_add(amount).ok_or_else(overflow)?;
+                        payable.push(index);
+                    }
+                    Err(err) => entries[index].error = Some(err.to_string()),
+                }
+            }
+            let required = amounts
+                .checked_add(self.melt_input_fee(amounts).await?)
+                .ok_or_else(overflow)?;
+            if required > balance {
+                return Err(FFIError::InsufficientFunds {
+                    available: balance.into(),
+                    required: required.into(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            let mut quotes = Vec::with_capacity(payable.len());
+            let mut required = Amount::ZERO;
+            for index in payable {
+                match self.inner.melt_quote(requests[index].clone(), None).await {
+                    Ok(quote) => {
+                        required = required
+                            .checked_add(quote.amount)
+                            .and_then(|required| required.checked_add(quote.fee_reserve))
+                            .ok_or_else(overflow)?;
+                        quotes.push((index, quote));
+                    }
+                    Err(e) => entries[index].error = Some(FFIError::from(e).to_string()),
+                }
+            }
+            let required = required
+                .checked_add(self.melt_input_fee(required).await?)
+                .ok_or_else(overflow)?;
+            if required > balance {
+                for (_, quote) in &quotes {
+                    self.inner.localstore.remove_melt_quote(&quote.id).await?;
+                }
+                return Err(FFIError::InsufficientFunds {
+                    available: balance.into(),
+                    required: required.into(),
+                    code: NO_ERROR_CODE,
+                });
+            }
+
+            // Melts run one after another so each one selects from the proofs left by the last
+            let mut quotes = quotes.into_iter();
+            let mut ran_out = None;
+            for (index, quote) in quotes.by_ref() {
+                match self.inner.melt(&quote.id).await {
+                    Ok(melted) => entries[index].melted = Some(melted.into()),
+                    Err(cdk::error::Error::InsufficientFunds) => {
+                        let required = quote.amount + quote.fee_reserve;
+                        entries[index].error =
+                            Some(self.insufficient_funds(required).await.to_string());
+                        ran_out = Some(index);
+                        break;
+                    }
+                    Err(e) => entries[index].error = Some(FFIError::from(e).to_string()),
+                }
+            }
+            if let Some(ran_out) = ran_out {
+                for (index, quote) in quotes {
+                    self.inner.localstore.remove_melt_quote(&quote.id).await?;
+                    entries[index].error =
+                        Some(format!("Not paid, the balance ran out at invoice {ran_out}"));
+                }
+            }
+            Ok(entries)
+        })
+    }
+}
+
+impl FFIWallet {
+    /// Wrap a CDK wallet with the settings every constructor starts from
+    /// `http` makes the requests CDK does not, it must use the same proxy as `inner`
+    fn build(
+        inner: CdkWallet,
+        seed: &[u8; 64],
+        localstore: Arc<FFILocalStore>,
+        http: reqwest::Client,
+    ) -> Result<Arc<Self>> {
+        let wallet = Arc::new(Self {
+            inner,
+            http,
+            runtime: runtime(),
+            recent_errors: Mutex::new(VecDeque::new()),
+            p2pk_key: p2pk_key_from_seed(seed)?,
+            fee_reserve_percent: Mutex::new(None),
+            reserved_sends: Mutex::new(HashMap::new()),
+            prepared_send_ttl: Mutex::new(Duration::from_secs(PREPARED_SEND_TTL_SECS)),
+            localstore,
+            request_timeout: Mutex::new(None),
+            retry_policy: Mutex::new(RetryPolicy::default()),
+            balance_changed: watch::channel(()).0,
+            moved_to: Mutex::new(None),
+            deterministic_seed: Mutex::new(None),
+        });
+        wallet.runtime.block_on(wallet.release_orphaned_sends())?;
+        let percent = wallet.stored_setting(FEE_RESERVE_PERCENT_SETTING)?;
+        *wallet.fee_reserve_percent.lock().unwrap_or_else(|e| e.into_inner()) =
+            percent.and_then(|percent| percent.parse().ok());
+        Ok(wallet)
+    }
+
+    /// Run a future on the wallet runtime, remembering failures for diagnostic reports
+    /// It runs to completion: dropping a send, receive, melt, swap or mint partway could lose
+    /// outputs the mint already signed or leave proofs reserved
+    fn block_on<T>(&self, future: impl Future<Output = Result<T>>) -> Result<T> {
+        self.block_on_within(None, future)
+    }
+
+    /// `block_on` for calls that move no proofs, which fails with `FFIError::Timeout` after
+    /// the timeout set with `set_request_timeout`
+    fn block_on_read<T>(&self, future: impl Future<Output = Result<T>>) -> Result<T> {
+        let timeout = *self.request_timeout.lock().unwrap_or_else(|e| e.into_inner());
+        self.block_on_within(timeout, future)
+    }
+
+    /// `block_on` with an explicit timeout, none for calls that bound their own wait
+    fn block_on_within<T>(
+        &self,
+        timeout: Option<Duration>,
+        future: impl Future<Output = Result<T>>,
+    ) -> Result<T> {
+        if let Some(new_url) = self.moved_to.lock().unwrap_or_else(|e| e.into_inner()).as_ref() {
+            return Err(FFIError::InvalidInput {
+                msg: format!("Wallet moved to {}, reopen it at that URL", new_url),
+                code: NO_ERROR_CODE,
+            });
+        }
+        let result = self.runtime.block_on(async {
+            match timeout {
+                Some(timeout) => tokio::time::timeout(timeout, future).await.unwrap_or_else(|_| {
+                    Err(FFIError::Timeout {
+                        msg: format!("Mint did not answer within {}s", timeout.as_secs()),
+                        code: NO_ERROR_CODE,
+                    })
+                }),
+                None => future.await,
+            }
+        });
+        // Observers compare the balance themselves, so calls that changed nothing are harmless
+        self.balance_changed.send_replace(());
+        if let Err(err) = &result {
+            let mut recent_errors = self.recent_errors.lock().unwrap_or_else(|e| e.into_inner());
+            if recent_errors.len() == MAX_RECENT_ERRORS {
+                recent_errors.pop_front();
+            }
+            recent_errors.push_back(redact_error(&err.to_string()));
+        }
+        result
+    }
+
+    /// Run an idempotent mint request, retrying it as set with `set_retry_policy`
+    async fn with_retry<T, F, Fut>(&self, mut request: F) -> Result<T>
+    where
+        F: FnMut() -> Fut,
+        Fut: Future<Output = std::result::Result<T, cdk::Error>>,
+    {
+        let policy = *self.retry_policy.lock().unwrap_or_else(|e| e.into_inner());
+        let mut attempt = 0;
+        loop {
+            match request().await {
+                // Mint errors are decoded into their own variants, HttpError is left for
+                // transport failures and bodies that are not a mint error
+                Err(cdk::Error::HttpError(..)) if attempt < policy.max_retries => {
+                    let backoff = 1u64.checked_shl(attempt.into()).unwrap_or(u64::MAX);
+                    let delay = policy.base_delay_ms.saturating_mul(backoff);
+                    tokio::time::sleep(Duration::from_millis(delay.min(RETRY_MAX_DELAY_MS))).await;
+                    attempt += 1;
+                }
+                result => return Ok(result?),
+            }
+        }
+    }
+
+    /// Prepare a send. Proofs picked by `options.proof_selection` or for preferred
+    /// denominations are selected in memory and only they are reserved, other sends are left
+    /// to CDK's own selection
+    async fn prepare_send_with(
+        &self,
+        amount: Amount,
+        options: FFISendOptions,
+    ) -> Result<PendingSend> {
+        let proof_selection = options.proof_selection;
+        let include_fee = options.include_fee;
+        let denominations = preferred_denominations(amount, &options.preferred_denominations)?;
+        let send_options: SendOptions = options.try_into()?;
+        let offline = send_options.send_kind.is_offline();
+
+        let selected = self
+            .own_selection(amount, &denominations, proof_selection, include_fee)
+            .await?;
+        if let Some(selected) = selected {
+            let send = self.plan_selected(amount, selected, send_options, &denominations).await?;
+            self.inner
+                .localstore
+                .update_proofs_state(send.proofs.ys()?, State::Reserved)
+                .await?;
+            return Ok(PendingSend::Selected(send));
+        }
+
+        // An offline send must not need a swap, which would contact the mint
+        match self.inner.prepare_send(amount, send_options).await {
+            Ok(prepared) if offline && !prepared.proofs_to_swap().is_empty() => {
+                self.inner.cancel_send(prepared).await?;
+                Err(self.offline_send_impossible(amount).await)
+            }
+            Ok(prepared) => Ok(PendingSend::Cdk(prepared)),
+            Err(cdk::error::Error::InsufficientFunds) if offline => {
+                Err(self.offline_send_impossible(amount).await)
+            }
+            Err(cdk::error::Error::InsufficientFunds) => Err(self.insufficient_funds(amount).await),
+            Err(e) => Err(e.into()),
+        }
+    }
+
+    /// Proofs this wallet selects itself for a send, matching the preferred denominations or
+    /// in `proof_selection` order. None when the selection is left to CDK, which never
+    /// happens for preferred denominations since CDK cannot be told to swap into them
+    async fn own_selection(
+        &self,
+        amount: Amount,
+        denominations: &[Amount],
+        proof_selection: FFIProofSelection,
+        include_fee: bool,
+    ) -> Result<Option<Vec<Proof>>> {
+        if let Some(selected) = self.denomination_proofs(denominations, include_fee).await? {
+            return Ok(Some(selected));
+        }
+        let selected = self.preferred_proofs(amount, proof_selection, include_fee).await?;
+        if selected.is_some() || denominations.is_empty() {
+            return Ok(selected);
+        }
+        let proofs = self.inner.get_unspent_proofs().await?;
+        match self.minimize_change(amount, proofs.clone(), include_fee).await? {
+            Some(selected) => Ok(Some(selected)),
+            // All of them, for `plan_selected` to report the shortfall
+            None => Ok(Some(proofs)),
+        }
+    }
+
+    /// Work out how a send of proofs this wallet selected goes, without writing to the store:
+    /// as they are when they match the amount, the send's conditions and any preferred
+    /// `denominations`, else swapped first, into the denominations when given
+    async fn plan_selected(
+        &self,
+        amount: Amount,
+        selected: Vec<Proof>,
+        options: SendOptions,
+        denominations: &[Amount],
+    ) -> Result<SelectedSend> {
+        let include_fee = options.include_fee;
+        let total = selected.total_amount()?;
+        let input_fee = self.inner.get_proofs_fee(&selected).await?;
+
+        // The receiver swaps the proofs sent as they are, paying their input fee
+        let as_is_fee = if include_fee { input_fee } else { Amount::ZERO };
+        let as_is_needed = amount + as_is_fee;
+        let tolerance = match options.send_kind {
+            SendKind::OnlineTolerance(tolerance) | SendKind::OfflineTolerance(tolerance) => {
+                tolerance
+            }
+            SendKind::OnlineExact | SendKind::OfflineExact => Amount::ZERO,
+        };
+        let mut amounts: Vec<Amount> = selected.iter().map(|proof| proof.amount).collect();
+        amounts.sort();
+        let as_is = options.conditions.is_none()
+            && total >= as_is_needed
+            && total - as_is_needed <= tolerance
+            && options.max_proofs.map_or(true, |max| selected.len() <= max)
+            && (denominations.is_empty() || amounts == denominations);
+        if as_is {
+            return Ok(SelectedSend {
+                amount,
+                proofs: selected,
+                requires_swap: false,
+                swap_fee: Amount::ZERO,
+                send_fee: as_is_fee,
+                options,
+            });
+        }
+        if options.send_kind.is_offline() {
+            return Err(self.offline_send_impossible(amount).await);
+        }
+
+        let send_fee = if include_fee {
+            let active_keyset = self.inner.get_active_mint_keyset().await?;
+            let outputs = amount.split_targeted(&options.amount_split_target)?.len() as u64;
+            Amount::from((outputs * active_keyset.input_fee_ppk).div_ceil(1000))
+        } else {
+            Amount::ZERO
+        };
+        if total < amount + input_fee + send_fee {
+            return Err(self.insufficient_funds(amount + input_fee + send_fee).await);
+        }
+        Ok(SelectedSend {
+            amount,
+            proofs: selected,
+            requires_swap: true,
+            swap_fee: input_fee,
+            send_fee,
+            options,
+        })
+    }
+
+    /// Hand over the proofs of a prepared send as a token, swapping them first if needed
+    async fn finish_send(&self, send: PendingSend, memo: Option<SendMemo>) -> Result<Token> {
+        let send = match send {
+            PendingSend::Cdk(prepared) => return Ok(self.inner.send(prepared, memo).await?),
+            PendingSend::Selected(send) => send,
+        };
+        let fee = send.swap_fee + send.send_fee;
+        let memo = memo.or(send.options.memo);
+        let proofs = if send.requires_swap {
+            self.inner
+                .swap(
+                    Some(send.amount),
+                    send.options.amount_split_target,
+                    send.proofs,
+                    send.options.conditions,
+                    send.options.include_fee,
+                )
+                .await?
+                .ok_or_else(|| FFIError::InternalError {
+                    msg: "Swap returned no proofs to send".to_string(),
+                    code: NO_ERROR_CODE,
+                })?
+        } else {
+            send.proofs
+        };
+
+        let ys = proofs.ys()?;
+        self.inner
+            .localstore
+            .update_proofs_state(ys.clone(), State::PendingSpent)
+            .await?;
+        self.inner
+            .localstore
+            .add_transaction(Transaction {
+                mint_url: self.inner.mint_url.clone(),
+                direction: TransactionDirection::Outgoing,
+                amount: send.amount,
+                fee,
+                unit: self.inner.unit.clone(),
+                ys,
+                timestamp: unix_time(),
+                memo: memo.as_ref().map(|memo| memo.memo.clone()),
+                metadata: send.options.metadata,
+            })
+            .await?;
+
+        let token_memo = memo.and_then(|memo| memo.include_memo.then_some(memo.memo));
+        Ok(Token::new(
+            self.inner.mint_url.clone(),
+            proofs,
+            token_memo,
+            self.inner.unit.clone(),
+        ))
+    }
+
+    /// Return the proofs of a prepared send to the balance
+    async fn cancel_pending_send(&self, send: PendingSend) -> Result<()> {
+        match send {
+            PendingSend::Cdk(prepared) => self.inner.cancel_send(prepared).await?,
+            PendingSend::Selected(send) => {
+                self.inner
+                    .localstore
+                    .update_proofs_state(send.proofs.ys()?, State::Unspent)
+                    .await?
+            }
+        }
+        Ok(())
+    }
+
+    /// Receive one token as `receive` does
+    async fn receive_token(
+        &self,
+        token: &str,
+        options: FFIReceiveOptions,
+    ) -> Result<FFIReceiveResult> {
+        let parsed = Token::from_str(token).map_err(|e| FFIError::InvalidInput {
+            msg: format!("Invalid token: {}", e),
+            code: NO_ERROR_CODE,
+        })?;
+
+        if options.require_dleq {
+            self.check_token_dleq(&parsed).await?;
+        }
+
+        if options.idempotent {
+            // Incoming transactions are keyed by the Ys of the received proofs
+            let keysets = self.inner.get_mint_keysets().await?;
+            let transaction_id = TransactionId::from_proofs(parsed.proofs(&keysets)?)?;
+            if let Some(transaction) = self.inner.localstore.get_transaction(transaction_id).await?
+            {
+                return FFIReceiveResult::new(&parsed, transaction.amount);
+            }
+        }
+
+        if options.trust_unswapped {
+            let amount = self.store_unswapped(&parsed, true).await?;
+            return FFIReceiveResult::new(&parsed, amount);
+        }
+
+        let mut receive_options: ReceiveOptions = options.try_into()?;
+        receive_options.p2pk_signing_keys.push(self.p2pk_key.clone());
+        let amount = self.inner.receive(token, receive_options).await?;
+        FFIReceiveResult::new(&parsed, amount)
+    }
+
+    /// Fail with DleqVerificationFailed unless every proof of a token of this mint carries a
+    /// valid DLEQ proof, fetching the keys of its keysets if they are not stored yet
+    async fn check_token_dleq(&self, token: &Token) -> Result<()> {
+        if token.mint_url()? != self.inner.mint_url {
+            return Err(FFIError::InvalidInput {
+                msg: "Token is from a different mint".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+
+        let keysets = self.inner.get_mint_keysets().await?;
+        let proofs = token.proofs(&keysets)?;
+        let mut keys = HashMap::new();
+        for keyset_id in proofs.iter().map(|proof| proof.keyset_id).collect::<HashSet<_>>() {
+            keys.insert(keyset_id, self.inner.fetch_keyset_keys(keyset_id).await?);
+        }
+
+        match verify_proofs_dleq(&proofs, &keys) {
+            Ok(true) => Ok(()),
+            Ok(false) => Err(FFIError::DleqVerificationFailed {
+                msg: "Token proofs carry a DLEQ proof that does not match the mint key"
+                    .to_string(),
+                code: NO_ERROR_CODE,
+            }),
+            Err(FFIError::InvalidInput { msg, code }) => {
+                Err(FFIError::DleqVerificationFailed { msg, code })
+            }
+            Err(err) => Err(err),
+        }
+    }
+
+    /// Add or remove the `lock_proofs` rows of these proofs in one transaction
+    fn set_locked(&self, ys: &[PublicKey], locked: bool) -> Result<()> {
+        let statement = if locked {
+            "INSERT OR IGNORE INTO ffi_locked_proofs (mint_url, unit, y) VALUES (?1, ?2, ?3)"
+        } else {
+            "DELETE FROM ffi_locked_proofs WHERE mint_url = ?1 AND unit = ?2 AND y = ?3"
+        };
+        let mut connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let transaction = connection.transaction()?;
+        for y in ys {
+            transaction.execute(
+                statement,
+                rusqlite::params![
+                    self.inner.mint_url.to_string(),
+                    self.inner.unit.to_string(),
+                    y.to_string()
+                ],
+            )?;
+        }
+        transaction.commit()?;
+        Ok(())
+    }
+
+    /// Ys of the proofs of this wallet's mint and unit locked with `lock_proofs`
+    fn locked_ys(&self) -> Result<HashSet<String>> {
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let mut statement = connection
+            .prepare("SELECT y FROM ffi_locked_proofs WHERE mint_url = ?1 AND unit = ?2")?;
+        let rows = statement.query_map(
+            rusqlite::params![self.inner.mint_url.to_string(), self.inner.unit.to_string()],
+            |row| row.get(0),
+        )?;
+        Ok(rows.collect::<std::result::Result<HashSet<_>, _>>()?)
+    }
+
+    /// Ids of one page of this mint's history in the order of `transactions_newest_first`,
+    /// read with LIMIT and OFFSET from CDK's `transactions` table. None for an in-memory
+    /// store, whose tables this connection cannot see
+    fn transaction_page_ids(&self, offset: u32, limit: u32) -> Result<Option<Vec<TransactionId>>> {
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let has_history: bool = connection.query_row(
+            "SELECT EXISTS (
+                 SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'transactions'
+             )",
+            [],
+            |row| row.get(0),
+        )?;
+        if !has_history {
+            return Ok(None);
+        }
+        let mut statement = connection.prepare(
+            "SELECT hex_id FROM (
+                 SELECT timestamp,
+                        CASE typeof(id) WHEN 'blob' THEN lower(hex(id)) ELSE id END AS hex_id
+                 FROM transactions WHERE mint_url = ?1
+             )
+             ORDER BY timestamp DESC, hex_id LIMIT ?2 OFFSET ?3",
+        )?;
+        let rows = statement.query_map(
+            rusqlite::params![self.inner.mint_url.to_string(), limit, offset],
+            |row| row.get::<_, String>(0),
+        )?;
+        let mut ids = Vec::new();
+        for id in rows {
+            let id = TransactionId::from_str(&id?).map_err(|e| FFIError::InternalError {
+                msg: format!("Invalid transaction id in the store: {}", e),
+                code: NO_ERROR_CODE,
+            })?;
+            ids.push(id);
+        }
+        Ok(Some(ids))
+    }
+
+    /// Store a library setting for this wallet's mint and unit, replacing an earlier value
+    fn store_setting(&self, key: &str, value: &str) -> Result<()> {
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        connection.execute(
+            "INSERT INTO ffi_wallet_settings (mint_url, unit, key, value) VALUES (?1, ?2, ?3, ?4)
+             ON CONFLICT (mint_url, unit, key) DO UPDATE SET value = excluded.value",
+            rusqlite::params![
+                self.inner.mint_url.to_string(),
+                self.inner.unit.to_string(),
+                key,
+                value
+            ],
+        )?;
+        Ok(())
+    }
+
+    /// Library setting stored with `store_setting`, none if it was never set
+    fn stored_setting(&self, key: &str) -> Result<Option<String>> {
+        use rusqlite::OptionalExtension;
+
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        Ok(connection
+            .query_row(
+                "SELECT value FROM ffi_wallet_settings
+                 WHERE mint_url = ?1 AND unit = ?2 AND key = ?3",
+                rusqlite::params![
+                    self.inner.mint_url.to_string(),
+                    self.inner.unit.to_string(),
+                    key
+                ],
+                |row| row.get(0),
+            )
+            .optional()?)
+    }
+
+    /// Record that a prepared send holds these proofs until `expires_at`, in unix seconds
+    fn record_reservation(&self, id: &str, ys: &[PublicKey], expires_at: u64) -> Result<()> {
+        let mut connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let transaction = connection.transaction()?;
+        for y in ys {
+            transaction.execute(
+                "INSERT OR REPLACE INTO ffi_reserved_sends
+                 (mint_url, unit, y, reservation_id, expires_at) VALUES (?1, ?2, ?3, ?4, ?5)",
+                rusqlite::params![
+                    self.inner.mint_url.to_string(),
+                    self.inner.unit.to_string(),
+                    y.to_string(),
+                    id,
+                    i64::try_from(expires_at).unwrap_or(i64::MAX)
+                ],
+            )?;
+        }
+        transaction.commit()?;
+        Ok(())
+    }
+
+    /// Drop the rows of a reservation, false if none were left because another wallet on the
+    /// store released its proofs after it expired
+    fn take_reservation(&self, id: &str) -> Result<bool> {
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        let removed = connection.execute(
+            "DELETE FROM ffi_reserved_sends
+             WHERE mint_url = ?1 AND unit = ?2 AND reservation_id = ?3",
+            rusqlite::params![self.inner.mint_url.to_string(), self.inner.unit.to_string(), id],
+        )?;
+        Ok(removed > 0)
+    }
+
+    /// Drop expired reservation rows of this wallet's mint and unit and return the Ys still
+    /// held by reservations that have not expired
+    fn live_reservation_ys(&self) -> Result<HashSet<String>> {
+        let mint_url = self.inner.mint_url.to_string();
+        let unit = self.inner.unit.to_string();
+        let connection = self.localstore.metadata.lock().unwrap_or_else(|e| e.into_inner());
+        connection.execute(
+            "DELETE FROM ffi_reserved_sends WHERE mint_url = ?1 AND unit = ?2 AND expires_at <= ?3",
+            rusqlite::params![mint_url, unit, unix_time() as i64],
+        )?;
+        let mut statement = connection
+            .prepare("SELECT y FROM ffi_reserved_sends WHERE mint_url = ?1 AND unit = ?2")?;
+        let rows = statement.query_map(rusqlite::params![mint_url, unit], |row| row.get(0))?;
+        Ok(rows.collect::<std::result::Result<HashSet<_>, _>>()?)
+    }
+
+    /// Unspent or reserved proofs of this wallet with the given secrets, in the same order
+    /// A secret matching none of them is InvalidInput
+    async fn proofs_by_secret(&self, secrets: &[String]) -> Result<Vec<ProofInfo>> {
+        let mut by_secret: HashMap<String, ProofInfo> = self
+            .inner
+            .localstore
+            .get_proofs(
+                Some(self.inner.mint_url.clone()),
+                Some(self.inner.unit.clone()),
+                Some(vec![State::Unspent, State::Reserved]),
+                None,
+            )
+            .await?
+            .into_iter()
+            .map(|proof_info| (proof_info.proof.secret.to_string(), proof_info))
+            .collect();
+        secrets
+            .iter()
+            .map(|secret| {
+                by_secret.remove(secret).ok_or_else(|| FFIError::InvalidInput {
+                    msg: format!("No unspent proof with secret {}", secret),
+                    code: NO_ERROR_CODE,
+                })
+            })
+            .collect()
+    }
+
+    /// Transactions of this wallet's mint, newest first and ties broken by id so pages are stable
+    async fn transactions_newest_first(
+        &self,
+        direction: Option<TransactionDirection>,
+        unit: Option<CurrencyUnit>,
+    ) -> Result<Vec<Transaction>> {
+        let mut transactions = self
+            .inner
+            .localstore
+            .list_transactions(Some(self.inner.mint_url.clone()), direction, unit)
+            .await?;
+        transactions.sort_by_cached_key(|t| (Reverse(t.timestamp), t.id().to_string()));
+        Ok(transactions)
+    }
+
+    /// Store proofs as unspent after checking they belong to keysets of the wallet's unit
+    async fn insert_proofs(&self, proofs: Vec<Proof>) -> Result<()> {
+        if proofs.is_empty() {
+            return Ok(());
+        }
+        let keysets = self.inner.get_mint_keysets().await?;
+        for proof in &proofs {
+            let keyset = keysets.iter().find(|keyset| keyset.id == proof.keyset_id);
+            match keyset {
+                Some(keyset) if keyset.unit == self.inner.unit => {}
+                Some(keyset) => {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!(
+                            "Proof keyset {} is for unit {}, not {}",
+                            proof.keyset_id, keyset.unit, self.inner.unit
+                        ),
+                        code: NO_ERROR_CODE,
+                    })
+                }
+                None => {
+                    return Err(FFIError::InvalidInput {
+                        msg: format!(
+                            "Proof keyset {} is not a keyset of the mint",
+                            proof.keyset_id
+                        ),
+                        code: NO_ERROR_CODE,
+                    })
+                }
+            }
+        }
+
+        self.store_received(proofs, None).await?;
+        Ok(())
+    }
 
-#[derive(uniffi::Enum)]
-pub enum FFICurrencyUnit {
-    Sat,
-    Msat,
-    Usd,
-    Eur,
-}
+    /// Keysets of this wallet's mint and unit in the store, without asking the mint
+    async fn stored_keysets(&self) -> Result<Vec<KeySetInfo>> {
+        Ok(self
+            .inner
+            .localstore
+            .get_mint_keysets(self.inner.mint_url.clone())
+            .await?
+            .unwrap_or_default()
+            .into_iter()
+            .filter(|keyset| keyset.unit == self.inner.unit)
+            .collect())
+    }
 
-impl TryFrom<String> for FFICurrencyUnit {
-    type Error = FFIError;
+    /// Build an `OfflineSendImpossible` error with the largest amount up to `requested` that
+    /// stored proofs add up to, or `InsufficientFunds` when the balance is short anyway
+    async fn offline_send_impossible(&self, requested: Amount) -> FFIError {
+        let mut proofs = match self.inner.get_unspent_proofs().await {
+            Ok(proofs) => proofs,
+            Err(e) => return e.into(),
+        };
+        if proofs.total_amount().unwrap_or(Amount::ZERO) < requested {
+            return self.insufficient_funds(requested).await;
+        }
 
-    fn try_from(unit: String) -> Result<Self> {
-        match unit.to_lowercase().as_str() {
-            "sat" => Ok(Self::Sat),
-            "msat" => Ok(Self::Msat),
-            "usd" => Ok(Self::Usd),
-            "eur" => Ok(Self::Eur),
-            _ => Err(FFIError::InvalidInput {
-                msg: format!("Unknown currency unit: {}", unit),
-            }),
+        // Denominations are powers of two, where taking the largest proof that fits is optimal
+        proofs.sort_by(|a, b| b.amount.cmp(&a.amount));
+        let mut nearest = Amount::ZERO;
+        for proof in proofs {
+            if nearest + proof.amount <= requested {
+                nearest += proof.amount;
+            }
+        }
+        FFIError::OfflineSendImpossible {
+            requested: requested.into(),
+            nearest: nearest.into(),
+            code: NO_ERROR_CODE,
         }
     }
-}
 
-impl From<FFICurrencyUnit> for CurrencyUnit {
-    fn from(unit: FFICurrencyUnit) -> Self {
-        match unit {
-            FFICurrencyUnit::Sat => CurrencyUnit::Sat,
-            FFICurrencyUnit::Msat => CurrencyUnit::Msat,
-            FFICurrencyUnit::Usd => CurrencyUnit::Usd,
-            FFICurrencyUnit::Eur => CurrencyUnit::Eur,
+    /// Re-encode a token CDK produced (always V4) in the requested version
+    async fn with_token_version(&self, token: Token, version: FFITokenVersion) -> Result<Token> {
+        match (version, token) {
+            (FFITokenVersion::V3, Token::TokenV4(token)) => {
+                let keysets = self.inner.get_mint_keysets().await?;
+                let proofs = token.proofs(&keysets)?;
+                let v3 = TokenV3::new(token.mint_url, proofs, token.memo, Some(token.unit))?;
+                Ok(Token::TokenV3(v3))
+            }
+            (_, token) => Ok(token),
         }
     }
-}
 
-// Objects (pass by reference) - stateful objects
+    /// Return the proofs of prepared sends past their time to be confirmed to the balance
+    async fn release_expired_sends(&self) -> Result<()> {
+        let now = Instant::now();
+        let expired: Vec<(String, ReservedSend)> = {
+            let mut reserved = self.reserved_sends.lock().unwrap_or_else(|e| e.into_inner());
+            let ids: Vec<String> = reserved
+                .iter()
+                .filter(|(_, send)| send.expires_at <= now)
+                .map(|(id, _)| id.clone())
+                .collect();
+            ids.into_iter()
+                .filter_map(|id| reserved.remove(&id).map(|send| (id, send)))
+                .collect()
+        };
+        for (id, send) in expired {
+            // Skipped when another wallet on the store already released the proofs
+            if self.take_reservation(&id)? {
+                self.cancel_pending_send(send.prepared).await?;
+            }
+        }
+        Ok(())
+    }
 
-#[derive(uniffi::Object)]
-pub struct FFILocalStore {
-    inner: Arc<dyn WalletDatabase<Err = cdk_common::database::Error> + Send + Sync>,
-}
+    /// Return proofs left reserved by expired prepared sends, or by none at all, to the balance
+    /// Reservations that have not expired may belong to another wallet open on the store and
+    /// are kept, as are proofs locked with `lock_proofs`
+    async fn release_orphaned_sends(&self) -> Result<()> {
+        let mut kept = self.locked_ys()?;
+        kept.extend(self.live_reservation_ys()?);
+        let orphaned: Vec<PublicKey> = self
+            .inner
+            .localstore
+            .get_proofs(
+                Some(self.inner.mint_url.clone()),
+                Some(self.inner.unit.clone()),
+                Some(vec![State::Reserved]),
+                None,
+            )
+            .await?
+            .into_iter()
+            .map(|proof_info| proof_info.y)
+            .filter(|y| !kept.contains(&y.to_string()))
+            .collect();
+        if !orphaned.is_empty() {
+            self.inner
+                .localstore
+                .update_proofs_state(orphaned, State::Unspent)
+                .await?;
+        }
+        Ok(())
+    }
 
-#[uniffi::export]
-impl FFILocalStore {
-    #[uniffi::constructor]
-    pub fn new() -> Result<Arc<Self>> {
-        Self::new_with_path(None)
+    /// Unspent proofs matching `denominations` one for one, when the store holds them all
+    /// None otherwise, or when the send must also cover a fee
+    async fn denomination_proofs(
+        &self,
+        denominations: &[Amount],
+        include_fee: bool,
+    ) -> Result<Option<Vec<Proof>>> {
+        if denominations.is_empty() || include_fee {
+            return Ok(None);
+        }
+        let mut wanted = denominations.to_vec();
+        let mut selected = Vec::new();
+        for proof in self.inner.get_unspent_proofs().await? {
+            if let Some(index) = wanted.iter().position(|amount| *amount == proof.amount) {
+                wanted.swap_remove(index);
+                selected.push(proof);
+            }
+        }
+        Ok(wanted.is_empty().then_some(selected))
     }
 
-    #[uniffi::constructor]
-    pub fn new_with_path(db_path: Option<String>) -> Result<Arc<Self>> {
-        let rt = runtime();
-        let store = rt.block_on(async {
-            let final_db_path = match db_path {
-                Some(custom_path) => {
-                    // Use the provided path directly
-                    custom_path
-                }
-                None => {
-                    // Fallback to temp directory (original behavior)
-                    let temp_path = std::env::temp_dir()
-                        .join(format!("cdk_wallet_{}.db", uuid::Uuid::new_v4()));
-                    temp_path.to_string_lossy().to_string()
+    /// Select unspent proofs for a send in `proof_selection` order: the first ones covering
+    /// `amount`, or all of them if they fall short. None for `MinimizeChange`, which is left
+    /// to CDK's selection unless `set_deterministic` asks for a reproducible one
+    async fn preferred_proofs(
+        &self,
+        amount: Amount,
+        proof_selection: FFIProofSelection,
+        include_fee: bool,
+    ) -> Result<Option<Vec<Proof>>> {
+        let mut proofs = self.inner.get_unspent_proofs().await?;
+        let seed = *self.deterministic_seed.lock().unwrap_or_else(|e| e.into_inner());
+        if let Some(seed) = seed {
+            // The store does not promise an order, so equal amounts would tie differently
+            proofs.sort_by_cached_key(|proof| seeded_rank(seed, &proof.secret.to_string()));
+        }
+        match proof_selection {
+            FFIProofSelection::LargestFirst => proofs.sort_by(|a, b| b.amount.cmp(&a.amount)),
+            FFIProofSelection::SmallestFirst => proofs.sort_by(|a, b| a.amount.cmp(&b.amount)),
+            FFIProofSelection::MinimizeChange if seed.is_none() => return Ok(None),
+            // Select as CDK would, from the seeded order. A shortfall is left to CDK to report
+            FFIProofSelection::MinimizeChange => {
+                return self.minimize_change(amount, proofs, include_fee).await
+            }
+        }
+
+        let mut selected = Vec::new();
+        let mut remaining = proofs.into_iter();
+        loop {
+            let fee = if include_fee {
+                self.inner.get_proofs_fee(&selected).await?
+            } else {
+                Amount::ZERO
+            };
+            if selected.total_amount()? >= amount + fee {
+                break;
+            }
+            match remaining.next() {
+                Some(proof) => selected.push(proof),
+                None => break,
+            }
+        }
+        Ok(Some(selected))
+    }
+
+    /// Select from `proofs` as CDK's `prepare_send` would, None when they fall short
+    async fn minimize_change(
+        &self,
+        amount: Amount,
+        proofs: Vec<Proof>,
+        include_fee: bool,
+    ) -> Result<Option<Vec<Proof>>> {
+        let active_keyset = self.inner.get_active_mint_keyset().await?;
+        let keyset_fees = self.inner.get_keyset_fees().await?;
+        match CdkWallet::select_proofs(
+            amount,
+            proofs,
+            &vec![active_keyset.id],
+            &keyset_fees,
+            include_fee,
+        ) {
+            Ok(selected) => Ok(Some(selected)),
+            Err(cdk::error::Error::InsufficientFunds) => Ok(None),
+            Err(e) => Err(e.into()),
+        }
+    }
+
+    /// The fees `prepare_send_with` would report, following its proof selection, or CDK's and
+    /// its split into proofs sent as-is and proofs swapped first, without writing to the store
+    async fn estimate_send(
+        &self,
+        amount: Amount,
+        options: FFISendOptions,
+    ) -> Result<FFIPreparedSend> {
+        let proof_selection = options.proof_selection;
+        let denominations = preferred_denominations(amount, &options.preferred_denominations)?;
+        let send_options: SendOptions = options.try_into()?;
+        let include_fee = send_options.include_fee;
+
+        let selected = self
+            .own_selection(amount, &denominations, proof_selection, include_fee)
+            .await?;
+        if let Some(selected) = selected {
+            let send = self.plan_selected(amount, selected, send_options, &denominations).await?;
+            let input_fee = self.inner.get_proofs_fee(&send.proofs).await?;
+            let send = PendingSend::Selected(send);
+            return Ok(FFIPreparedSend::new(&send, input_fee, String::new()));
+        }
+
+        let proofs = self.inner.get_unspent_proofs().await?;
+        let active_keyset = self.inner.get_active_mint_keyset().await?;
+        let keyset_fees = self.inner.get_keyset_fees().await?;
+        let selected = match CdkWallet::select_proofs(
+            amount,
+            proofs,
+            &vec![active_keyset.id],
+            &keyset_fees,
+            include_fee,
+        ) {
+            Ok(selected) => selected,
+            Err(cdk::error::Error::InsufficientFunds) => {
+                return Err(self.insufficient_funds(amount).await)
+            }
+            Err(e) => return Err(e.into()),
+        };
+
+        let send_amounts = amount.split();
+        let send_fee = if include_fee {
+            let count = send_amounts.len() as u64;
+            Amount::from((count * active_keyset.input_fee_ppk).div_ceil(1000))
+        } else {
+            Amount::ZERO
+        };
+        let mut exact = selected.total_amount()? == amount + send_fee;
+        if let Some(max_proofs) = send_options.max_proofs {
+            exact &= selected.len() <= max_proofs;
+        }
+        if !exact && matches!(send_options.send_kind, SendKind::OfflineExact) {
+            return Err(self.offline_send_impossible(amount).await);
+        }
+
+        // Locked sends swap everything, otherwise proofs matching a send amount go as they are
+        let send_kind = &send_options.send_kind;
+        let mut to_swap = Vec::new();
+        if send_options.conditions.is_some() {
+            to_swap = selected.clone();
+        } else if !exact && !send_kind.is_offline() && !send_kind.has_tolerance() {
+            let mut remaining_amounts = send_amounts;
+            for proof in &selected {
+                match remaining_amounts.iter().position(|a| *a == proof.amount) {
+                    Some(index) => {
+                        remaining_amounts.remove(index);
+                    }
+                    None => to_swap.push(proof.clone()),
                 }
+            }
+        }
+        let swap_fee = self.inner.get_proofs_fee(&to_swap).await?;
+        let input_fee = self.inner.get_proofs_fee(&selected).await?;
+
+        Ok(FFIPreparedSend {
+            amount: amount.into(),
+            swap_fee: swap_fee.into(),
+            send_fee: send_fee.into(),
+            total_fee: (swap_fee + send_fee).into(),
+            proof_count: selected.len() as u32,
+            requires_swap: !to_swap.is_empty(),
+            input_fee: input_fee.into(),
+            reservation_id: String::new(),
+        })
+    }
+
+    /// Input fee of the proofs a melt of `amount` would spend, picking the largest first
+    /// Short of proofs it is the fee of all of them, the caller's balance check then fails
+    async fn melt_input_fee(&self, amount: Amount) -> Result<Amount> {
+        let mut proofs = self.inner.get_unspent_proofs().await?;
+        proofs.sort_by(|a, b| b.amount.cmp(&a.amount));
+        let mut selected = Vec::new();
+        for proof in proofs {
+            let fee = self.inner.get_proofs_fee(&selected).await?;
+            if selected.total_amount()? >= amount + fee {
+                break;
+            }
+            selected.push(proof);
+        }
+        Ok(self.inner.get_proofs_fee(&selected).await?)
+    }
+
+    /// Build an `InsufficientFunds` error from the current balance, falling back to the
+    /// balance lookup error if the store cannot be read
+    async fn insufficient_funds(&self, required: Amount) -> FFIError {
+        match self.inner.total_balance().await {
+            Ok(available) => FFIError::InsufficientFunds {
+                available: available.into(),
+                required: required.into(),
+                code: NO_ERROR_CODE,
+            },
+            Err(e) => e.into(),
+        }
+    }
+
+    /// Availability from the mint info stored in the database, so disabled
+    /// operations fail before a round trip. Unknown mints are assumed enabled.
+    async fn cached_availability(&self) -> Result<FFIOperationAvailability> {
+        let mint_info = self
+            .inner
+            .localstore
+            .get_mint(self.inner.mint_url.clone())
+            .await?;
+        Ok(match mint_info {
+            Some(mint_info) => (&mint_info).into(),
+            None => FFIOperationAvailability {
+                mint: true,
+                melt: true,
+                swap: true,
+            },
+        })
+    }
+
+    /// Store a token's proofs without swapping them. With `check_unspent` the mint is
+    /// asked first that none of them are spent or pending
+    async fn store_unswapped(&self, token: &Token, check_unspent: bool) -> Result<Amount> {
+        if token.mint_url()? != self.inner.mint_url {
+            return Err(FFIError::InvalidInput {
+                msg: "Token is from a different mint".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+        let unit = token.unit().unwrap_or_default();
+        if unit != self.inner.unit {
+            return Err(FFIError::InvalidInput {
+                msg: format!("Token is in {}, the wallet in {}", unit, self.inner.unit),
+                code: NO_ERROR_CODE,
+            });
+        }
+        // Locked proofs are only spendable by this wallet once a swap has met their conditions
+        if !token.spending_conditions()?.is_empty() {
+            return Err(FFIError::InvalidInput {
+                msg: "Token is locked with P2PK or HTLC conditions and must be swapped".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
+
+        let proofs = if check_unspent {
+            let keysets = self.inner.get_mint_keysets().await?;
+            let proofs = token.proofs(&keysets)?;
+            let states = self.inner.check_proofs_spent(proofs.clone()).await?;
+            if states.iter().any(|proof_state| proof_state.state != State::Unspent) {
+                return Err(FFIError::WalletError {
+                    msg: "Token contains spent or pending proofs".to_string(),
+                    code: ERROR_CODE_TOKEN_ALREADY_SPENT,
+                });
+            }
+            proofs
+        } else {
+            // Only keysets already in the store, so nothing is fetched from the mint
+            let keysets = self.stored_keysets().await?;
+            let unknown_keyset = || FFIError::InvalidInput {
+                msg: "Token uses a keyset this wallet does not know".to_string(),
+                code: NO_ERROR_CODE,
             };
-            cdk_sqlite::WalletSqliteDatabase::new(&final_db_path).await
-        })?;
-        Ok(Arc::new(Self {
-            inner: Arc::new(store),
-        }))
+            let proofs = token.proofs(&keysets).map_err(|_| unknown_keyset())?;
+            if proofs
+                .iter()
+                .any(|proof| !keysets.iter().any(|keyset| keyset.id == proof.keyset_id))
+            {
+                return Err(unknown_keyset());
+            }
+            proofs
+        };
+
+        self.store_received(proofs, token.memo().clone()).await
+    }
+
+    /// Store proofs received without a swap as unspent and record an incoming transaction,
+    /// like a swapped receive, so history and idempotent retries see them
+    async fn store_received(&self, proofs: Vec<Proof>, memo: Option<String>) -> Result<Amount> {
+        let amount = proofs.total_amount()?;
+        let ys = proofs.ys()?;
+        let proof_infos = proofs
+            .into_iter()
+            .map(|proof| {
+                ProofInfo::new(
+                    proof,
+                    self.inner.mint_url.clone(),
+                    State::Unspent,
+                    self.inner.unit.clone(),
+                )
+            })
+            .collect::<std::result::Result<Vec<_>, _>>()?;
+        self.inner.localstore.update_proofs(proof_infos, vec![]).await?;
+
+        self.inner
+            .localstore
+            .add_transaction(Transaction {
+                mint_url: self.inner.mint_url.clone(),
+                direction: TransactionDirection::Incoming,
+                amount,
+                fee: Amount::ZERO,
+                unit: self.inner.unit.clone(),
+                ys,
+                timestamp: unix_time(),
+                memo,
+                metadata: HashMap::new(),
+            })
+            .await?;
+
+        Ok(amount)
     }
 }
 
+/// One logical wallet spanning several mints, holding an `FFIWallet` per mint
+/// All of them share the unit, seed and local store given at construction
 #[derive(uniffi::Object)]
-pub struct FFIWallet {
-    inner: CdkWallet,
-    runtime: Runtime,
+pub struct FFIMultiMintWallet {
+    unit: FFICurrencyUnit,
+    localstore: Arc<FFILocalStore>,
+    seed: [u8; 64],
+    wallets: Mutex<BTreeMap<String, Arc<FFIWallet>>>,
 }
 
 #[uniffi::export]
-impl FFIWallet {
+impl FFIMultiMintWallet {
     #[uniffi::constructor]
-    pub fn from_mnemonic(
-        mint_url: String,
+    pub fn new(
         unit: FFICurrencyUnit,
         localstore: Arc<FFILocalStore>,
         mnemonic_words: String,
     ) -> Result<Arc<Self>> {
-        let seed = mnemonic_to_seed(mnemonic_words.clone())?;
-
-        let wallet = CdkWallet::new(
-            &mint_url,
-            unit.into(),
-            localstore.inner.clone(),
-            &seed,
-            None,
-        )?;
+        let seed = mnemonic_to_seed(mnemonic_words)?;
 
         Ok(Arc::new(Self {
-            inner: wallet,
-            runtime: runtime(),
+            unit,
+            localstore,
+            seed,
+            wallets: Mutex::new(BTreeMap::new()),
         }))
     }
 
-    #[uniffi::constructor]
-    pub fn restore_from_mnemonic(
-        mint_url: String,
-        unit: FFICurrencyUnit,
-        localstore: Arc<FFILocalStore>,
-        mnemonic_words: String,
-    ) -> Result<Arc<Self>> {
-        let seed = mnemonic_to_seed(mnemonic_words.clone())?;
+    /// Add a wallet for the mint, adding a mint that is already present has no effect
+    pub fn add_mint(&self, mint_url: String) -> Result<()> {
+        let mint_url = normalize_mint_url(mint_url)?;
+        let mut wallets = self.wallets.lock().unwrap_or_else(|e| e.into_inner());
+        if wallets.contains_key(&mint_url) {
+            return Ok(());
+        }
 
-        let wallet = CdkWallet::new(
-            &mint_url,
-            unit.into(),
-            localstore.inner.clone(),
-            &seed,
-            None,
+        let wallet = FFIWallet::from_seed(
+            mint_url.clone(),
+            self.unit.clone(),
+            self.localstore.clone(),
+            self.seed.to_vec(),
         )?;
-
-        let runtime = runtime();
-
-        // Call restore on the wallet
-        runtime.block_on(async { wallet.restore().await })?;
-
-        Ok(Arc::new(Self {
-            inner: wallet,
-            runtime,
-        }))
+        wallets.insert(mint_url, wallet);
+        Ok(())
     }
 
-    pub fn mint_quote(
-        &self,
-        amount: FFIAmount,
-        description: Option<String>,
-    ) -> Result<FFIMintQuote> {
-        self.runtime.block_on(async {
-            let quote = self.inner.mint_quote(amount.into(), description).await?;
-            Ok(quote.into())
-        })
+    /// Stop tracking the mint, returns false if it was not added
+    /// Its proofs stay in the local store and come back if the mint is added again
+    pub fn remove_mint(&self, mint_url: String) -> Result<bool> {
+        let mint_url = normalize_mint_url(mint_url)?;
+        let mut wallets = self.wallets.lock().unwrap_or_else(|e| e.into_inner());
+        Ok(wallets.remove(&mint_url).is_some())
     }
 
-    pub fn mint_quote_state(&self, quote_id: String) -> Result<FFIMintQuoteBolt11Response> {
-        self.runtime.block_on(async {
-            let state = self.inner.mint_quote_state(&quote_id).await?;
-            Ok(state.into())
-        })
+    /// The wallet for one of the added mints
+    pub fn wallet(&self, mint_url: String) -> Result<Option<Arc<FFIWallet>>> {
+        let mint_url = normalize_mint_url(mint_url)?;
+        let wallets = self.wallets.lock().unwrap_or_else(|e| e.into_inner());
+        Ok(wallets.get(&mint_url).cloned())
     }
 
-    pub fn mint(&self, quote_id: String, split_target: FFISplitTarget) -> Result<FFIAmount> {
-        self.runtime.block_on(async {
-            let proofs = self
-                .inner
-                .mint(&quote_id, split_target.into(), None)
-                .await?;
-            let amount = proofs.total_amount()?;
-            Ok(amount.into())
-        })
+    /// Mint URLs of the added mints, sorted
+    pub fn wallets(&self) -> Vec<String> {
+        let wallets = self.wallets.lock().unwrap_or_else(|e| e.into_inner());
+        wallets.keys().cloned().collect()
     }
 
-    pub fn prepare_send(
+    /// Move `amount` from one added mint to another by paying a mint quote of the
+    /// destination with a melt at the source, returning the amount minted there
+    /// The Lightning fee is paid on top by the source. If the destination fails to
+    /// mint after the melt, the paid quote stays stored and can be minted later
+    pub fn transfer(
         &self,
+        from_mint: String,
+        to_mint: String,
         amount: FFIAmount,
-        options: FFISendOptions,
-    ) -> Result<FFIPreparedSend> {
-        self.runtime.block_on(async {
-            let prepared = self
-                .inner
-                .prepare_send(amount.into(), options.into())
-                .await?;
-            Ok(prepared.into())
-        })
-    }
+    ) -> Result<FFIAmount> {
+        let source = self.added_wallet(&from_mint)?;
+        let destination = self.added_wallet(&to_mint)?;
+        if Arc::ptr_eq(&source, &destination) {
+            return Err(FFIError::InvalidInput {
+                msg: "Cannot transfer to the same mint".to_string(),
+                code: NO_ERROR_CODE,
+            });
+        }
 
-    pub fn send(
-        &self,
-        amount: FFIAmount,
-        options: FFISendOptions,
-        memo: Option<FFISendMemo>,
-    ) -> Result<FFIToken> {
-        self.runtime.block_on(async {
-            // First prepare the send
-            let prepared = self
-                .inner
-                .prepare_send(amount.into(), options.into())
-                .await?;
+        let mint_quote = destination
+            .mint_quote(amount, None)
+            .map_err(|e| transfer_failed(FFITransferLeg::Mint, e))?;
+        let melt_quote = source
+            .melt_quote(mint_quote.request)
+            .map_err(|e| transfer_failed(FFITransferLeg::Melt, e))?;
+        let melted = source
+            .melt(melt_quote.id)
+            .map_err(|e| transfer_failed(FFITransferLeg::Melt, e))?;
+        if melted.state != MeltQuoteState::Paid.to_string() {
+            return Err(FFIError::TransferFailed {
+                leg: FFITransferLeg::Melt,
+                msg: format!("Melt ended in state {}", melted.state),
+                code: NO_ERROR_CODE,
+            });
+        }
 
-            // Then send it
-            let token = self.inner.send(prepared, memo.map(|m| m.into())).await?;
-            Ok(token.try_into()?)
-        })
+        destination
+            .wait_for_mint_quote_paid(mint_quote.id.clone(), TRANSFER_MINT_TIMEOUT_SECS)
+            .and_then(|_| destination.mint(mint_quote.id.clone(), FFISplitTarget::Default))
+            .map_err(|e| FFIError::TransferFailed {
+                leg: FFITransferLeg::Mint,
+                msg: format!("Mint quote {} is paid but not minted: {}", mint_quote.id, e),
+                code: e.code(),
+            })
     }
 
-    pub fn balance(&self) -> Result<FFIAmount> {
-        self.runtime.block_on(async {
-            let balance = self.inner.total_balance().await?;
-            Ok(balance.into())
-        })
-    }
+    /// Sum of the balances of every added mint
+    pub fn total_balance(&self) -> Result<FFIAmount> {
+        let wallets: Vec<_> = self
+            .wallets
+            .lock()
+            .unwrap_or_else(|e| e.into_inner())
+            .values()
+            .cloned()
+            .collect();
 
-    pub fn mint_url(&self) -> String {
-        self.inner.mint_url.to_string()
+        let mut total = Amount::ZERO;
+        for wallet in wallets {
+            let balance: Amount = wallet.balance()?.into();
+            total = total
+                .checked_add(balance)
+                .ok_or_else(|| FFIError::InternalError {
+                    msg: "Total balance overflows".to_string(),
+                    code: NO_ERROR_CODE,
+                })?;
+        }
+        Ok(total.into())
     }
+}
 
-    pub fn unit(&self) -> String {
-        self.inner.unit.to_string()
+impl FFIMultiMintWallet {
+    fn added_wallet(&self, mint_url: &str) -> Result<Arc<FFIWallet>> {
+        let mint_url = normalize_mint_url(mint_url.to_string())?;
+        let wallets = self.wallets.lock().unwrap_or_else(|e| e.into_inner());
+        wallets
+            .get(&mint_url)
+            .cloned()
+            .ok_or_else(|| FFIError::InvalidInput {
+                msg: format!("Mint {} has not been added", mint_url),
+                code: NO_ERROR_CODE,
+            })
     }
+}
 
-    /// Fetch and initialize mint information
-    /// This should be called after wallet creation to set up the mint in the database
-    pub fn get_mint_info(&self) -> Result<String> {
-        self.runtime.block_on(async {
-            // First try to get existing mint info from database
-            match self.inner.get_mint_info().await? {
-                Some(mint_info) => {
-                    let name = mint_info.name.unwrap_or_else(|| "Unknown Mint".to_string());
-                    Ok(format!("Mint info already initialized: {}", name))
-                },
-                None => {
-                    // Mint info not in database, try to fetch and initialize
-                    // First get the mint keysets which should also fetch mint info
-                    match self.inner.get_mint_keysets().await {
-                        Ok(keysets) => {
-                            // Check if mint info is now available
-                            match self.inner.get_mint_info().await? {
-                                Some(mint_info) => {
-                                    let name = mint_info.name.unwrap_or_else(|| "Unknown Mint".to_string());
-                                    Ok(format!("Mint info fetched and initialized: {} (keysets: {})", 
-                                              name, keysets.len()))
-                                },
-                                None => {
-                                    Ok(format!("Mint keysets loaded ({} keysets) but mint info still not available", 
-                                              keysets.len()))
-                                }
-                            }
-                        },
-                        Err(e) => {
-                            Ok(format!("Failed to get mint keysets: {}", e))
-                        }
-                    }
-                }
-            }
-        })
+// Attribute an error to a transfer leg, insufficient funds are left as is since
+// nothing has moved yet and the caller can act on the amounts
+fn transfer_failed(leg: FFITransferLeg, err: FFIError) -> FFIError {
+    match err {
+        FFIError::InsufficientFunds { .. } => err,
+        err => FFIError::TransferFailed {
+            leg,
+            msg: err.to_string(),
+            code: err.code(),
+        },
     }
+}
 
-    /// Create a melt quote for paying a Lightning invoice
-    pub fn melt_quote(&self, request: String) -> Result<FFIMeltQuote> {
-        self.runtime.block_on(async {
-            let quote = self.inner.melt_quote(request, None).await?;
-            Ok(quote.into())
-        })
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn check_max_fee_caps_the_fee_reserve() {
+        assert!(check_max_fee(Amount::from(20), Amount::from(20)).is_ok());
+        assert!(check_max_fee(Amount::ZERO, Amount::ZERO).is_ok());
+        match check_max_fee(Amount::from(21), Amount::from(20)) {
+            Err(FFIError::FeeTooHigh {
+                fee_reserve,
+                max_fee,
+                ..
+            }) => assert_eq!((fee_reserve.value, max_fee.value), (21, 20)),
+            other => panic!("expected FeeTooHigh, got {:?}", other),
+        }
     }
 
-    /// Execute a melt operation (pay Lightning invoice)
-    pub fn melt(&self, quote_id: String) -> Result<FFIMelted> {
-        self.runtime.block_on(async {
-            let result = self.inner.melt(&quote_id).await?;
-            Ok(result.into())
-        })
+    #[test]
+    fn redact_error_drops_tokens_and_secrets() {
+        let secret = "ab".repeat(32);
+        let msg = format!("Cannot receive cashuBo2F0gaJhaUgA: secret '{}' spent", secret);
+        assert_eq!(redact_error(&msg), "Cannot receive [redacted]: secret '[redacted]' spent");
+        assert_eq!(redact_error("Mint did not answer within 5s"), "Mint did not answer within 5s");
     }
 }